@@ -0,0 +1,73 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// apiInfoProbeTimeout bounds the request APIInfo sends to probe the v2
+// endpoints.
+const apiInfoProbeTimeout = 10 * time.Second
+
+// APIInfo describes the Datadog API a client talks to.
+type APIInfo struct {
+	// BaseUrl is the base URL API requests are sent to.
+	BaseUrl string
+	// Site is the name of the Datadog site, e.g. "datadoghq.eu", or empty if
+	// the base URL isn't the one of a known site.
+	Site string
+	// ValidKeys tells whether the API and application keys are valid.
+	ValidKeys bool
+	// V2 tells whether the v2 endpoints are available. It is nil if that is
+	// unknown, e.g. because probing them failed or the keys were rejected.
+	V2 *bool
+}
+
+// APIInfo reports which Datadog API the client talks to and what it
+// supports, so code built on top of the client can adapt to it. The v2
+// endpoints are probed once, without retries and for at most
+// apiInfoProbeTimeout. They are considered available if the probe succeeds,
+// or is forbidden while the keys are valid, and unavailable if it gives a
+// 404. Any other outcome leaves V2 unknown.
+func (client *Client) APIInfo() (*APIInfo, error) {
+	info := &APIInfo{BaseUrl: client.GetAPIBaseUrl()}
+	for site, baseUrl := range siteBaseUrls {
+		if baseUrl == client.GetBaseUrl() {
+			info.Site = site
+		}
+	}
+
+	valid, err := client.Validate()
+	if err != nil {
+		return nil, err
+	}
+	info.ValidKeys = valid
+
+	probe := client.Clone()
+	probe.DisableRetries = true
+	ctx, cancel := context.WithTimeout(context.Background(), apiInfoProbeTimeout)
+	defer cancel()
+	resp, err := probe.doRequestWithContext(ctx, "GET", "/v2/permissions", nil)
+	if err != nil {
+		return info, nil
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		info.V2 = Bool(false)
+	case resp.StatusCode >= 200 && resp.StatusCode < 300,
+		resp.StatusCode == http.StatusForbidden && valid:
+		info.V2 = Bool(true)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,96 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIInfo(t *testing.T) {
+	valid := true
+	v2 := http.StatusForbidden
+	var probes int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/validate":
+			if !valid {
+				w.WriteHeader(http.StatusForbidden)
+			}
+			w.Write([]byte(`{"valid": true}`))
+		case "/api/v2/permissions":
+			probes++
+			w.WriteHeader(v2)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	info, err := c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &APIInfo{BaseUrl: ts.URL, ValidKeys: true, V2: Bool(true)}, info)
+
+	v2 = http.StatusOK
+	info, err = c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, info.GetV2())
+
+	v2 = http.StatusNotFound
+	info, err = c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, info.GetV2())
+	assert.True(t, info.HasV2())
+
+	probes = 0
+	v2 = http.StatusServiceUnavailable
+	info, err = c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, info.ValidKeys)
+	assert.False(t, info.HasV2())
+	assert.Equal(t, 1, probes, "the probe must not be retried")
+
+	v2 = http.StatusUnauthorized
+	info, err = c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, info.HasV2())
+
+	valid = false
+	v2 = http.StatusForbidden
+	info, err = c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, info.ValidKeys)
+	assert.False(t, info.HasV2())
+}
+
+func TestAPIInfoSite(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"valid": true}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	assert.Nil(t, c.SetSite("datadoghq.eu"))
+	c.SetAPIBaseUrl(ts.URL)
+
+	info, err := c.APIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "datadoghq.eu", info.Site)
+	assert.Equal(t, ts.URL, info.BaseUrl)
+}
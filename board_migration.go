@@ -0,0 +1,244 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TimeboardSchema is the schema of the boards of /v1/dash.
+	TimeboardSchema = "timeboard"
+	// ScreenboardSchema is the schema of the boards of /v1/screen.
+	ScreenboardSchema = "screenboard"
+	// DashboardSchema is the schema of the unified boards of /v1/dashboard,
+	// whose layout_type tells whether they're laid out like timeboards or
+	// like screenboards.
+	DashboardSchema = "dashboard"
+)
+
+// boardFields holds the fields telling the schemas of boards apart.
+type boardFields struct {
+	LayoutType *string         `json:"layout_type"`
+	BoardTitle *string         `json:"board_title"`
+	Graphs     json.RawMessage `json:"graphs"`
+	Dash       json.RawMessage `json:"dash"`
+}
+
+// DetectBoardSchema tells which schema the JSON of a board follows, e.g. to
+// find the legacy boards of an export. The layout type is "ordered" for
+// timeboards and "free" for screenboards, and the layout_type of unified
+// dashboards.
+func DetectBoardSchema(data []byte) (schema, layoutType string, err error) {
+	var fields boardFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", "", err
+	}
+	switch {
+	case fields.LayoutType != nil:
+		return DashboardSchema, *fields.LayoutType, nil
+	case fields.BoardTitle != nil:
+		return ScreenboardSchema, "free", nil
+	case fields.Graphs != nil || fields.Dash != nil:
+		return TimeboardSchema, "ordered", nil
+	}
+	return "", "", fmt.Errorf("unknown board schema")
+}
+
+// convertibleWidgets are the types of the screenboard widgets which have a
+// timeboard graph counterpart.
+var convertibleWidgets = map[string]bool{
+	"timeseries":   true,
+	"query_value":  true,
+	"toplist":      true,
+	"change":       true,
+	"hostmap":      true,
+	"heatmap":      true,
+	"distribution": true,
+}
+
+// ConvertScreenboard converts a screenboard into a timeboard, which lays its
+// graphs out on its own. Only the widgets drawing graphs, like timeseries or
+// toplists, can be converted: the others, like notes or images, have no
+// timeboard counterpart. They're reported as a *MultiError, along with the
+// timeboard holding the other widgets. Timeboards need a description, which
+// screenboards don't have, so it tells where the timeboard comes from.
+func ConvertScreenboard(board *Screenboard) (*Dashboard, error) {
+	dash := &Dashboard{
+		Title:             board.Title,
+		Description:       String(fmt.Sprintf("Converted from screenboard %d (%s)", board.GetId(), board.GetTitle())),
+		ReadOnly:          board.ReadOnly,
+		TemplateVariables: board.TemplateVariables,
+	}
+	errs := &MultiError{}
+	for i, widget := range board.Widgets {
+		graph, err := convertWidget(widget)
+		if err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("widget %d (%s): %s", i, widget.GetType(), err))
+			continue
+		}
+		dash.Graphs = append(dash.Graphs, *graph)
+	}
+	return dash, errs.errorOrNil()
+}
+
+// MigrateScreenboardToDashboard creates a timeboard from a screenboard, as
+// ConvertScreenboard does. The screenboard is left as is. Nothing is created
+// if some widgets can't be converted.
+func (client *Client) MigrateScreenboardToDashboard(id int) (*Dashboard, error) {
+	board, err := client.GetScreenboard(id)
+	if err != nil {
+		return nil, err
+	}
+	dash, err := ConvertScreenboard(board)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateDashboard(dash)
+}
+
+// convertWidget returns the timeboard graph drawing the same as a screenboard
+// widget.
+func convertWidget(widget Widget) (*Graph, error) {
+	if !convertibleWidgets[widget.GetType()] {
+		return nil, fmt.Errorf("unsupported widget type")
+	}
+	if widget.TileDef == nil {
+		return nil, fmt.Errorf("no tile definition")
+	}
+	tile := widget.TileDef
+
+	definition := &GraphDefinition{
+		Viz:        tile.Viz,
+		Autoscale:  tile.Autoscale,
+		TextAlign:  tile.TextAlign,
+		Precision:  tile.Precision,
+		CustomUnit: tile.CustomUnit,
+
+		NodeType:              tile.NodeType,
+		Scopes:                derefStrings(tile.Scope),
+		Groups:                derefStrings(tile.Group),
+		IncludeUngroupedHosts: tile.NoGroupHosts,
+		IncludeNoMetricHosts:  tile.NoMetricHosts,
+	}
+	if definition.Viz == nil {
+		definition.Viz = widget.Type
+	}
+	for _, event := range tile.Events {
+		definition.Events = append(definition.Events, GraphEvent{Query: event.Query})
+	}
+	for _, marker := range tile.Markers {
+		definition.Markers = append(definition.Markers, GraphDefinitionMarker{
+			Label: marker.Label,
+			Type:  marker.Type,
+			Value: marker.Value,
+		})
+	}
+	if tile.Style != nil {
+		style, err := convertTileStyle(tile.Style)
+		if err != nil {
+			return nil, err
+		}
+		definition.Style = style
+	}
+	for i, request := range tile.Requests {
+		if request.Query == nil {
+			return nil, fmt.Errorf("request %d has no query", i)
+		}
+		converted, err := convertTileRequest(request)
+		if err != nil {
+			return nil, fmt.Errorf("request %d: %s", i, err)
+		}
+		definition.Requests = append(definition.Requests, converted)
+	}
+
+	return &Graph{Title: widget.TitleText, Definition: definition}, nil
+}
+
+func convertTileRequest(request TileDefRequest) (GraphDefinitionRequest, error) {
+	out := GraphDefinitionRequest{
+		Query:          request.Query,
+		Aggregator:     request.Aggregator,
+		Type:           request.Type,
+		ChangeType:     request.ChangeType,
+		CompareTo:      request.CompareTo,
+		IncreaseGood:   request.IncreaseGood,
+		OrderBy:        request.OrderBy,
+		OrderDirection: request.OrderDir,
+		ExtraCol:       request.ExtraCol,
+	}
+	if request.Style != nil {
+		out.Style = &GraphDefinitionRequestStyle{
+			Palette: request.Style.Palette,
+			Type:    request.Style.Type,
+			Width:   request.Style.Width,
+		}
+	}
+	for _, format := range request.ConditionalFormats {
+		converted := DashboardConditionalFormat{
+			Palette:        format.Palette,
+			Comparator:     format.Comparator,
+			Inverted:       format.Invert,
+			CustomFgColor:  format.Color,
+			CustomImageUrl: format.ImageURL,
+		}
+		if format.Value != nil {
+			value, err := number(*format.Value)
+			if err != nil {
+				return out, err
+			}
+			converted.Value = value
+		}
+		out.ConditionalFormats = append(out.ConditionalFormats, converted)
+	}
+	return out, nil
+}
+
+// convertTileStyle converts the style of a hostmap, whose values are strings
+// in screenboards. Bounds which aren't numbers, like "auto", are left unset.
+func convertTileStyle(style *TileDefStyle) (*Style, error) {
+	out := &Style{Palette: style.Palette}
+	if style.PaletteFlip != nil {
+		flip, err := strconv.ParseBool(*style.PaletteFlip)
+		if err != nil {
+			return nil, fmt.Errorf("invalid paletteFlip %q", *style.PaletteFlip)
+		}
+		out.PaletteFlip = &flip
+	}
+	if style.FillMin != nil {
+		out.FillMin, _ = number(*style.FillMin)
+	}
+	if style.FillMax != nil {
+		out.FillMax, _ = number(*style.FillMax)
+	}
+	return out, nil
+}
+
+// number returns s as a JSON number, if it is one.
+func number(s string) (*json.Number, error) {
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	n := json.Number(s)
+	return &n, nil
+}
+
+// derefStrings returns the values of a slice of string pointers, skipping
+// nil ones.
+func derefStrings(values []*string) []string {
+	var out []string
+	for _, v := range values {
+		if v != nil {
+			out = append(out, *v)
+		}
+	}
+	return out
+}
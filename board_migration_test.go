@@ -0,0 +1,150 @@
+package datadog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectBoardSchema(t *testing.T) {
+	for _, tc := range []struct {
+		board      string
+		schema     string
+		layoutType string
+	}{
+		{`{"board_title": "board", "widgets": []}`, ScreenboardSchema, "free"},
+		{`{"title": "board", "graphs": []}`, TimeboardSchema, "ordered"},
+		{`{"dash": {"title": "board"}}`, TimeboardSchema, "ordered"},
+		{`{"title": "board", "layout_type": "free", "widgets": []}`, DashboardSchema, "free"},
+	} {
+		schema, layoutType, err := DetectBoardSchema([]byte(tc.board))
+		assert.Nil(t, err)
+		assert.Equal(t, tc.schema, schema, tc.board)
+		assert.Equal(t, tc.layoutType, layoutType, tc.board)
+	}
+
+	_, _, err := DetectBoardSchema([]byte(`{"title": "board"}`))
+	assert.NotNil(t, err)
+}
+
+func TestConvertScreenboard(t *testing.T) {
+	const fixture = `{
+		"board_title": "board",
+		"template_variables": [{"name": "env", "prefix": "env"}],
+		"widgets": [
+			{
+				"type": "timeseries",
+				"title_text": "CPU",
+				"tile_def": {
+					"viz": "timeseries",
+					"requests": [{"q": "avg:system.cpu.user{$env}", "style": {"palette": "warm"}}],
+					"events": [{"q": "tags:deploy"}]
+				}
+			},
+			{"type": "free_text", "text": "hello"},
+			{
+				"type": "query_value",
+				"tile_def": {
+					"viz": "query_value",
+					"requests": [{"q": "sum:app.errors{*}", "aggregator": "sum",
+						"conditional_formats": [{"comparator": ">", "value": "10", "palette": "white_on_red"}]}]
+				}
+			},
+			{
+				"type": "hostmap",
+				"tile_def": {
+					"viz": "hostmap",
+					"requests": [{"q": "avg:system.load.1{*} by {host}", "type": "fill"}],
+					"scope": ["env:prod"],
+					"style": {"palette": "green_to_orange", "paletteFlip": "true", "fillMin": "auto", "fillMax": "10"}
+				}
+			}
+		]
+	}`
+	var board Screenboard
+	if err := json.Unmarshal([]byte(fixture), &board); err != nil {
+		t.Fatal(err)
+	}
+
+	dash, err := ConvertScreenboard(&board)
+	if assert.IsType(t, &MultiError{}, err) {
+		assert.EqualError(t, err.(*MultiError).Errors[0], "widget 1 (free_text): unsupported widget type")
+	}
+	assert.Equal(t, "board", dash.GetTitle())
+	assert.Equal(t, board.TemplateVariables, dash.TemplateVariables)
+
+	b, err := json.Marshal(dash.Graphs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `[
+		{
+			"title": "CPU",
+			"definition": {
+				"viz": "timeseries",
+				"requests": [{"q": "avg:system.cpu.user{$env}", "style": {"palette": "warm"}}],
+				"events": [{"q": "tags:deploy"}],
+				"yaxis": {}
+			}
+		},
+		{
+			"definition": {
+				"viz": "query_value",
+				"requests": [{"q": "sum:app.errors{*}", "aggregator": "sum",
+					"conditional_formats": [{"comparator": ">", "value": 10, "palette": "white_on_red"}]}],
+				"yaxis": {}
+			}
+		},
+		{
+			"definition": {
+				"viz": "hostmap",
+				"requests": [{"q": "avg:system.load.1{*} by {host}", "type": "fill"}],
+				"scope": ["env:prod"],
+				"style": {"palette": "green_to_orange", "paletteFlip": true, "fillMax": 10},
+				"yaxis": {}
+			}
+		}
+	]`, string(b))
+}
+
+func TestMigrateScreenboardToDashboard(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/screen/1":
+			w.Write([]byte(`{"id": 1, "board_title": "board", "read_only": true, "widgets": [
+				{"type": "toplist", "tile_def": {"viz": "toplist", "requests": [{"q": "top(avg:app.hits{*} by {host}, 10, 'mean', 'desc')"}]}}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/screen/2":
+			w.Write([]byte(`{"id": 2, "board_title": "notes", "widgets": [{"type": "note", "html": "hello"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/dash":
+			body, _ := ioutil.ReadAll(r.Body)
+			var in Dashboard
+			assert.Nil(t, json.Unmarshal(body, &in))
+			assert.Equal(t, "board", in.GetTitle())
+			assert.Equal(t, "Converted from screenboard 1 (board)", in.GetDescription())
+			assert.True(t, in.GetReadOnly())
+			assert.Len(t, in.Graphs, 1)
+			w.Write([]byte(`{"dash": {"id": 10, "title": "board"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	dash, err := c.MigrateScreenboardToDashboard(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, dash.GetId())
+
+	_, err = c.MigrateScreenboardToDashboard(2)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "widget 0 (note): unsupported widget type")
+	}
+}
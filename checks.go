@@ -1,5 +1,9 @@
 package datadog
 
+import (
+	"fmt"
+)
+
 type Check struct {
 	Check     *string  `json:"check,omitempty"`
 	HostName  *string  `json:"host_name,omitempty"`
@@ -23,3 +27,38 @@ func (client *Client) PostCheck(check Check) error {
 	return client.doJsonRequest("POST", "/v1/check_run",
 		check, nil)
 }
+
+// PostChecks posts the results of many check runs to the server in a single
+// request. The checks are checked first, and nothing is sent if any is
+// invalid: the errors of the invalid checks are returned together as a
+// *MultiError.
+func (client *Client) PostChecks(checks []Check) error {
+	errs := &MultiError{}
+	for i, check := range checks {
+		if err := check.validate(); err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("check %d: %s", i, err))
+		}
+	}
+	if err := errs.errorOrNil(); err != nil {
+		return err
+	}
+	return client.doJsonRequest("POST", "/v1/check_run", checks, nil)
+}
+
+// validate checks the check has a name, a host and a known status.
+func (check *Check) validate() error {
+	if check.GetCheck() == "" {
+		return fmt.Errorf("check is required")
+	}
+	if check.GetHostName() == "" {
+		return fmt.Errorf("host_name is required")
+	}
+	status, ok := check.GetStatusOk()
+	if !ok {
+		return fmt.Errorf("status is required")
+	}
+	if status < OK || status > UNKNOWN {
+		return fmt.Errorf("invalid status %d", status)
+	}
+	return nil
+}
@@ -1,8 +1,12 @@
 package datadog_test
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/zorkian/go-datadog-api"
 )
 
@@ -20,3 +24,37 @@ func TestCheckStatus(T *testing.T) {
 		T.Error("status UNKNOWN must be 3 to satisfy Datadog's API")
 	}
 }
+
+func TestPostChecks(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/v1/check_run", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `[
+			{"check": "app.ok", "host_name": "web-1", "status": 0},
+			{"check": "app.ok", "host_name": "web-2", "status": 2}
+		]`, string(body))
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := datadog.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	check := func(host string, status datadog.Status) datadog.Check {
+		return datadog.Check{Check: datadog.String("app.ok"), HostName: datadog.String(host), Status: &status}
+	}
+	assert.Nil(t, c.PostChecks([]datadog.Check{check("web-1", datadog.OK), check("web-2", datadog.CRITICAL)}))
+	assert.Equal(t, 1, requests)
+
+	err := c.PostChecks([]datadog.Check{check("web-1", datadog.OK), check("web-2", 4), {Check: datadog.String("app.ok")}})
+	if assert.IsType(t, &datadog.MultiError{}, err) {
+		errs := err.(*datadog.MultiError).Errors
+		if assert.Len(t, errs, 2) {
+			assert.Equal(t, "check 1: invalid status 4", errs[0].Error())
+			assert.Equal(t, "check 2: host_name is required", errs[1].Error())
+		}
+	}
+	assert.Equal(t, 1, requests, "expect invalid checks not to be sent")
+}
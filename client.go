@@ -9,21 +9,179 @@
 package datadog
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"time"
 )
 
+// DefaultHttpTimeout is the time limit for requests made by clients returned
+// by NewClient, so a stalled connection can't hang forever.
+const DefaultHttpTimeout = 60 * time.Second
+
+// DefaultMaxErrorBodyBytes is the number of bytes of the body of error
+// responses read when Client.MaxErrorBodyBytes isn't set.
+const DefaultMaxErrorBodyBytes = 64 << 10
+
+// DefaultBatchConcurrency is the number of requests batch operations have in
+// flight at once when Client.BatchConcurrency isn't set.
+const DefaultBatchConcurrency = 10
+
+// siteBaseUrls maps the names of the Datadog sites to their base URL.
+var siteBaseUrls = map[string]string{
+	"datadoghq.com":     "https://app.datadoghq.com",
+	"us3.datadoghq.com": "https://us3.datadoghq.com",
+	"us5.datadoghq.com": "https://us5.datadoghq.com",
+	"datadoghq.eu":      "https://app.datadoghq.eu",
+	"ddog-gov.com":      "https://app.ddog-gov.com",
+}
+
 // Client is the object that handles talking to the Datadog API. This maintains
 // state information for a particular application connection.
 type Client struct {
 	apiKey, appKey, baseUrl string
 
-	//The Http Client that is used to make requests
+	// keysMu guards apiKey, appKey and createdKeys, so they can be rotated
+	// while requests are in flight.
+	keysMu sync.RWMutex
+
+	// createdKeys are the secret values of the keys created through the key
+	// management API, redacted like apiKey and appKey.
+	createdKeys []string
+
+	// rateLimits are the rate limits last reported by responses, guarded by
+	// rateLimitsMu.
+	rateLimits   map[string]RateLimit
+	rateLimitsMu sync.Mutex
+
+	// inFlight holds a value per request in flight when
+	// MaxConcurrentRequests is set, guarded by inFlightMu.
+	inFlight   chan struct{}
+	inFlightMu sync.Mutex
+
+	// middlewares are the middlewares registered with Use, outermost first.
+	middlewares []Middleware
+
+	// apiBaseUrl is the base URL of API requests. When empty, baseUrl is used.
+	apiBaseUrl string
+
+	// apiPathPrefix is the path API paths are under. When empty, "/api" is
+	// used.
+	apiPathPrefix string
+
+	// The Http Client that is used to make requests. NewClient gives it a
+	// timeout of DefaultHttpTimeout, set HttpClient.Timeout to override it.
 	HttpClient   *http.Client
 	RetryTimeout time.Duration
+
+	// The exponential backoff between retries. Zero values keep the defaults
+	// of github.com/cenkalti/backoff. The randomization factor adds jitter to
+	// the intervals, so many clients don't retry in lockstep.
+	RetryInitialInterval     time.Duration
+	RetryMaxInterval         time.Duration
+	RetryMultiplier          float64
+	RetryRandomizationFactor float64
+
+	// MaxRetries limits the number of retries of a request, in addition to
+	// RetryTimeout. Retries stop at whichever limit is reached first. Zero
+	// means no limit on the number of retries.
+	MaxRetries int
+
+	// ShouldRetry, when set, decides whether a request which isn't a POST,
+	// PUT or PATCH is retried, given its response or transport error. Retries
+	// remain bounded by RetryTimeout and MaxRetries. When it isn't set,
+	// transport errors and responses other than 2xx and 4xx are retried.
+	ShouldRetry func(resp *http.Response, err error) bool
+
+	// RetryPolicy, when set, is called with the method and API path of every
+	// request, e.g. "/v1/series", to pick its retry settings. A nil result
+	// keeps the default behavior, where only requests which aren't a POST,
+	// PUT or PATCH are retried. Otherwise the request is retried, whatever
+	// its method, unless the result is Disabled.
+	RetryPolicy func(method, api string) *RetryConfig
+
+	// DisableRetries makes every request be sent exactly once. This also
+	// disables OnRateLimited: rate limited requests fail right away.
+	DisableRetries bool
+
+	// IdempotencyKeys makes every POST request, like the ones of CreateMonitor
+	// or PostEvent, send a random key in the IdempotencyKeyHeader header. The
+	// key is the same for all the attempts of a request. A key set with
+	// WithIdempotencyKey takes precedence.
+	IdempotencyKeys bool
+
+	// NormalizeMonitorTags makes CreateMonitor and UpdateMonitor send the tags
+	// of monitors normalized with NormalizeTags.
+	NormalizeMonitorTags bool
+
+	// TruncateEventText makes PostEvent truncate the text of events longer
+	// than MaxEventTextLength at a line or word boundary, and mark it as
+	// truncated, rather than letting Datadog cut it anywhere.
+	TruncateEventText bool
+
+	// BatchConcurrency limits the number of requests batch operations, like
+	// BatchAddHostTags, have in flight at once. Zero means
+	// DefaultBatchConcurrency.
+	BatchConcurrency int
+
+	// OnRateLimited is called when a request is rejected because of the rate
+	// limit. If it returns nil, the client waits until the rate limit resets
+	// and sends the request again, otherwise the error is returned. When the
+	// response doesn't tell when the rate limit resets, the client waits with
+	// the backoff of retries instead. Requests rate limited more than 10 times
+	// in a row fail, as do rate limited requests when it isn't set.
+	OnRateLimited func(rl RateLimit) error
+
+	// MaxErrorBodyBytes limits how much of the body of an error response is
+	// read to build the error. Zero means DefaultMaxErrorBodyBytes.
+	MaxErrorBodyBytes int
+
+	// RequireResponseBody makes requests which expect a result fail with
+	// ErrEmptyResponse when the response has an empty body. By default an
+	// empty body gives a zero result.
+	RequireResponseBody bool
+
+	// DebugWriter, when set, gets a dump of every request the client sends,
+	// including retries, and of their responses. Keys are redacted from the
+	// dumps.
+	DebugWriter io.Writer
+
+	// Limiter, when set, is waited on before sending every request, including
+	// retries, e.g. to keep all the goroutines sharing the client under an
+	// organization wide rate limit.
+	Limiter Limiter
+
+	// MaxConcurrentRequests, when positive, caps the number of requests in
+	// flight at once. Requests above the cap wait, until their context is
+	// done, for another one to complete. A request is in flight until the
+	// body of its response is closed. Changing it applies to the requests
+	// sent from then on, while the requests already in flight count against
+	// the previous cap. A clone has a cap of its own: the requests of a
+	// client and of its clones don't count against each other's cap.
+	MaxConcurrentRequests int
+
+	// Metrics, when set, is told about every request the client sends,
+	// including retries.
+	Metrics Metrics
+
+	// Headers are added to every request made by the client. Headers set by
+	// the client itself, like Content-Type, take precedence.
+	Headers http.Header
+}
+
+// Limiter limits the rate of requests. It is satisfied by *rate.Limiter of
+// golang.org/x/time/rate.
+type Limiter interface {
+	// Wait blocks until a request may be sent, or returns an error if ctx is
+	// done first.
+	Wait(ctx context.Context) error
 }
 
 // valid is the struct to unmarshal validation endpoint responses into.
@@ -33,9 +191,17 @@ type valid struct {
 }
 
 // NewClient returns a new datadog.Client which can be used to access the API
-// methods. The expected argument is the API key.
+// methods. The expected argument is the API key. The client gets its own
+// http.Client, so changing it doesn't affect http.DefaultClient.
+//
+// The base URL is read from the DATADOG_HOST environment variable, or derived
+// from the site named by the DD_SITE environment variable. It defaults to
+// https://app.datadoghq.com.
 func NewClient(apiKey, appKey string) *Client {
 	baseUrl := os.Getenv("DATADOG_HOST")
+	if baseUrl == "" {
+		baseUrl = siteBaseUrls[os.Getenv("DD_SITE")]
+	}
 	if baseUrl == "" {
 		baseUrl = "https://app.datadoghq.com"
 	}
@@ -44,57 +210,315 @@ func NewClient(apiKey, appKey string) *Client {
 		apiKey:       apiKey,
 		appKey:       appKey,
 		baseUrl:      baseUrl,
-		HttpClient:   http.DefaultClient,
+		HttpClient:   &http.Client{Timeout: DefaultHttpTimeout},
 		RetryTimeout: time.Duration(60 * time.Second),
 	}
 }
 
-// SetKeys changes the value of apiKey and appKey.
+// NewClientFromEnv returns a new datadog.Client using the keys from the
+// DATADOG_API_KEY and DATADOG_APP_KEY environment variables. An error is
+// returned if either of them isn't set.
+func NewClientFromEnv() (*Client, error) {
+	apiKey := os.Getenv("DATADOG_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("DATADOG_API_KEY environment variable is not set")
+	}
+	appKey := os.Getenv("DATADOG_APP_KEY")
+	if appKey == "" {
+		return nil, fmt.Errorf("DATADOG_APP_KEY environment variable is not set")
+	}
+	return NewClient(apiKey, appKey), nil
+}
+
+// NewClientWithProxy returns a new datadog.Client which sends its requests
+// through the HTTP proxy at proxyURL.
+func NewClientWithProxy(apiKey, appKey, proxyURL string) (*Client, error) {
+	proxy, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClient(apiKey, appKey)
+	transport, err := client.transport()
+	if err != nil {
+		return nil, err
+	}
+	transport.Proxy = http.ProxyURL(proxy)
+	return client, nil
+}
+
+// SetTLSConfig changes the TLS configuration used to talk to the API, e.g.
+// to pin a CA. Other settings of the transport are kept.
+func (c *Client) SetTLSConfig(config *tls.Config) error {
+	transport, err := c.transport()
+	if err != nil {
+		return err
+	}
+	transport.TLSClientConfig = config
+	return nil
+}
+
+// transport returns the *http.Transport of the HttpClient so it can be
+// modified. The process-wide http.DefaultClient and http.DefaultTransport are
+// never modified, the client gets its own copies instead.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.HttpClient == nil {
+		c.HttpClient = &http.Client{}
+	} else if c.HttpClient == http.DefaultClient {
+		httpClient := *http.DefaultClient
+		c.HttpClient = &httpClient
+	}
+
+	switch t := c.HttpClient.Transport.(type) {
+	case nil:
+	case *http.Transport:
+		if t != http.DefaultTransport {
+			return t, nil
+		}
+	default:
+		return nil, fmt.Errorf("cannot configure transport of type %T", t)
+	}
+
+	transport := newTransport()
+	c.HttpClient.Transport = transport
+	return transport, nil
+}
+
+// newTransport returns a transport with the same settings as
+// http.DefaultTransport.
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// Clone returns a copy of the client, which can be customized, e.g. with
+// SetKeys or SetBaseUrl, without affecting the original client. The copy
+// shares the HttpClient of the original, assign a new one to the copy to use
+// different HTTP settings.
+func (c *Client) Clone() *Client {
+	c.keysMu.RLock()
+	clone := &Client{
+		apiKey:                   c.apiKey,
+		appKey:                   c.appKey,
+		createdKeys:              append([]string(nil), c.createdKeys...),
+		middlewares:              append([]Middleware(nil), c.middlewares...),
+		baseUrl:                  c.baseUrl,
+		apiBaseUrl:               c.apiBaseUrl,
+		apiPathPrefix:            c.apiPathPrefix,
+		HttpClient:               c.HttpClient,
+		RetryTimeout:             c.RetryTimeout,
+		RetryInitialInterval:     c.RetryInitialInterval,
+		RetryMaxInterval:         c.RetryMaxInterval,
+		RetryMultiplier:          c.RetryMultiplier,
+		RetryRandomizationFactor: c.RetryRandomizationFactor,
+		MaxRetries:               c.MaxRetries,
+		ShouldRetry:              c.ShouldRetry,
+		RetryPolicy:              c.RetryPolicy,
+		DisableRetries:           c.DisableRetries,
+		IdempotencyKeys:          c.IdempotencyKeys,
+		NormalizeMonitorTags:     c.NormalizeMonitorTags,
+		TruncateEventText:        c.TruncateEventText,
+		BatchConcurrency:         c.BatchConcurrency,
+		OnRateLimited:            c.OnRateLimited,
+		MaxErrorBodyBytes:        c.MaxErrorBodyBytes,
+		RequireResponseBody:      c.RequireResponseBody,
+		DebugWriter:              c.DebugWriter,
+		Limiter:                  c.Limiter,
+		MaxConcurrentRequests:    c.MaxConcurrentRequests,
+		Metrics:                  c.Metrics,
+	}
+	c.keysMu.RUnlock()
+	if c.Headers != nil {
+		clone.Headers = make(http.Header, len(c.Headers))
+		for name, values := range c.Headers {
+			clone.Headers[name] = append([]string(nil), values...)
+		}
+	}
+	return clone
+}
+
+// SetKeys changes the value of apiKey and appKey. It is safe to call while
+// requests are in flight.
 func (c *Client) SetKeys(apiKey, appKey string) {
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
 	c.apiKey = apiKey
 	c.appKey = appKey
 }
 
+// keys returns the current apiKey and appKey.
+func (c *Client) keys() (apiKey, appKey string) {
+	c.keysMu.RLock()
+	defer c.keysMu.RUnlock()
+	return c.apiKey, c.appKey
+}
+
+// secrets returns all the secrets known to the client, which must never be
+// surfaced.
+func (c *Client) secrets() []string {
+	c.keysMu.RLock()
+	defer c.keysMu.RUnlock()
+	return append([]string{c.apiKey, c.appKey}, c.createdKeys...)
+}
+
+// addCreatedKey records the secret value of a key created through the key
+// management API, so it gets redacted.
+func (c *Client) addCreatedKey(key string) {
+	if key == "" {
+		return
+	}
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
+	c.createdKeys = append(c.createdKeys, key)
+}
+
+// removeCreatedKey forgets the secret value of a key deleted through the key
+// management API, which no longer needs redacting.
+func (c *Client) removeCreatedKey(key string) {
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
+	for i, created := range c.createdKeys {
+		if created == key {
+			c.createdKeys = append(c.createdKeys[:i], c.createdKeys[i+1:]...)
+			return
+		}
+	}
+}
+
 // SetBaseUrl changes the value of baseUrl.
 func (c *Client) SetBaseUrl(baseUrl string) {
 	c.baseUrl = baseUrl
 }
 
+// SetSite changes the baseUrl to the one of the named Datadog site, e.g.
+// "datadoghq.com", "datadoghq.eu", "us3.datadoghq.com" or "ddog-gov.com".
+func (c *Client) SetSite(site string) error {
+	baseUrl, ok := siteBaseUrls[site]
+	if !ok {
+		return fmt.Errorf("unknown Datadog site %q", site)
+	}
+	c.baseUrl = baseUrl
+	return nil
+}
+
 // GetBaseUrl returns the baseUrl.
 func (c *Client) GetBaseUrl() string {
 	return c.baseUrl
 }
 
-// Validate checks if the API and application keys are valid.
-func (client *Client) Validate() (bool, error) {
-	var out valid
-	var resp *http.Response
+// SetAPIBaseUrl changes the base URL of API requests, for when the API is
+// served from a different host than the application, e.g.
+// https://api.datadoghq.com. By default API requests are sent to the baseUrl.
+func (c *Client) SetAPIBaseUrl(apiBaseUrl string) {
+	c.apiBaseUrl = apiBaseUrl
+}
 
-	uri, err := client.uriForAPI("/v1/validate")
-	if err != nil {
-		return false, err
+// GetAPIBaseUrl returns the base URL of API requests.
+func (c *Client) GetAPIBaseUrl() string {
+	if c.apiBaseUrl == "" {
+		return c.baseUrl
 	}
+	return c.apiBaseUrl
+}
 
-	req, err := http.NewRequest("GET", uri, nil)
+// Ping checks that the Datadog API is reachable, without authenticating, so
+// it neither needs valid keys nor counts against rate limits. Any response
+// but a 5xx means the API is up.
+func (client *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequest("HEAD", client.GetAPIBaseUrl(), nil)
 	if err != nil {
-		return false, err
+		return err
 	}
-
-	resp, err = client.doRequestWithRetries(req, client.RetryTimeout)
+	resp, err := client.do(req.WithContext(ctx))
 	if err != nil {
-		return false, err
+		return err
 	}
+	resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("API error %s", resp.Status)
+	}
+	return nil
+}
 
-	defer resp.Body.Close()
+// SetAPIPathPrefix changes the path API paths are under, for proxies which
+// serve the API under another path than /api. Use "/" to send API paths as
+// is.
+func (c *Client) SetAPIPathPrefix(prefix string) {
+	c.apiPathPrefix = prefix
+}
+
+// GetAPIPathPrefix returns the path API paths are under, without a trailing
+// slash.
+func (c *Client) GetAPIPathPrefix() string {
+	if c.apiPathPrefix == "" {
+		return "/api"
+	}
+	return strings.TrimSuffix(c.apiPathPrefix, "/")
+}
+
+// Validate checks if the API and application keys are valid.
+func (client *Client) Validate() (bool, error) {
+	return client.ValidateWithContext(context.Background())
+}
+
+// ValidateWithContext checks if the API and application keys are valid. The
+// request and its retries are abandoned once ctx is done.
+func (client *Client) ValidateWithContext(ctx context.Context) (bool, error) {
+	var out valid
 
-	body, err := ioutil.ReadAll(resp.Body)
+	resp, err := client.doRequestWithContext(ctx, "GET", "/v1/validate", nil)
 	if err != nil {
-		return false, err
+		return false, client.redactError(err)
+	}
+	defer resp.Body.Close()
+
+	// Invalid keys are reported with a 403 and a bare valid struct rather
+	// than as an error.
+	if resp.StatusCode == http.StatusForbidden {
+		return false, nil
 	}
 
-	if err = json.Unmarshal(body, &out); err != nil {
-		return false, err
+	if err := client.handleResponse(resp, &out); err != nil {
+		return false, client.redactError(err)
 	}
 
 	return out.IsValid, nil
 }
+
+// runBatch calls do for every index from 0 to n, at most BatchConcurrency at
+// a time, and returns the error of each call at its index.
+func (client *Client) runBatch(n int, do func(i int) error) []error {
+	concurrency := client.BatchConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	errs := make([]error, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = do(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,383 @@
+package datadog
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Valid keys", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/validate", r.URL.Path)
+			w.Write([]byte(`{"valid": true}`))
+		}))
+		defer ts.Close()
+
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl(ts.URL)
+
+		valid, err := c.Validate()
+		assert.Nil(t, err)
+		assert.True(t, valid)
+	})
+	t.Run("Invalid keys", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors": ["Forbidden"]}`))
+		}))
+		defer ts.Close()
+
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl(ts.URL)
+
+		valid, err := c.Validate()
+		assert.Nil(t, err)
+		assert.False(t, valid)
+	})
+	t.Run("Errors are redacted", func(t *testing.T) {
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl("http://127.0.0.1:0")
+		c.RetryTimeout = 1
+
+		_, err := c.Validate()
+		if assert.NotNil(t, err) {
+			assert.NotContains(t, err.Error(), "sample_api_key")
+			assert.NotContains(t, err.Error(), "sample_app_key")
+		}
+	})
+	t.Run("Retries stop at the context deadline", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl(ts.URL)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := c.ValidateWithContext(ctx)
+		assert.NotNil(t, err)
+		assert.True(t, time.Since(start) < time.Second, "expect Validate to give up at the deadline")
+	})
+	t.Run("Cancelled context", func(t *testing.T) {
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl("http://127.0.0.1:0")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := c.ValidateWithContext(ctx)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNewClientOwnsHttpClient(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	assert.True(t, c.HttpClient != http.DefaultClient)
+
+	assert.Equal(t, DefaultHttpTimeout, c.HttpClient.Timeout)
+
+	c.HttpClient.Timeout = 5 * time.Second
+	c.HttpClient.Transport = &http.Transport{}
+	assert.Equal(t, time.Duration(0), http.DefaultClient.Timeout)
+	assert.Nil(t, http.DefaultClient.Transport)
+}
+
+func TestSetSite(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+
+	assert.Nil(t, c.SetSite("datadoghq.eu"))
+	assert.Equal(t, "https://app.datadoghq.eu", c.GetBaseUrl())
+
+	assert.Nil(t, c.SetSite("us3.datadoghq.com"))
+	assert.Equal(t, "https://us3.datadoghq.com", c.GetBaseUrl())
+
+	assert.NotNil(t, c.SetSite("example.com"))
+	assert.Equal(t, "https://us3.datadoghq.com", c.GetBaseUrl())
+}
+
+func TestNewClientSiteFromEnv(t *testing.T) {
+	defer os.Setenv("DATADOG_HOST", os.Getenv("DATADOG_HOST"))
+	defer os.Setenv("DD_SITE", os.Getenv("DD_SITE"))
+	os.Setenv("DATADOG_HOST", "")
+
+	os.Setenv("DD_SITE", "datadoghq.eu")
+	assert.Equal(t, "https://app.datadoghq.eu", NewClient("abc", "def").GetBaseUrl())
+
+	os.Setenv("DD_SITE", "example.com")
+	assert.Equal(t, "https://app.datadoghq.com", NewClient("abc", "def").GetBaseUrl())
+
+	os.Setenv("DD_SITE", "datadoghq.eu")
+	os.Setenv("DATADOG_HOST", "https://custom.datadoghq.com")
+	assert.Equal(t, "https://custom.datadoghq.com", NewClient("abc", "def").GetBaseUrl())
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	defer os.Setenv("DATADOG_API_KEY", os.Getenv("DATADOG_API_KEY"))
+	defer os.Setenv("DATADOG_APP_KEY", os.Getenv("DATADOG_APP_KEY"))
+
+	t.Run("Keys are read from the environment", func(t *testing.T) {
+		os.Setenv("DATADOG_API_KEY", "env_api_key")
+		os.Setenv("DATADOG_APP_KEY", "env_app_key")
+
+		c, err := NewClientFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "env_api_key", c.apiKey)
+		assert.Equal(t, "env_app_key", c.appKey)
+	})
+	t.Run("Missing API key", func(t *testing.T) {
+		os.Setenv("DATADOG_API_KEY", "")
+		os.Setenv("DATADOG_APP_KEY", "env_app_key")
+
+		_, err := NewClientFromEnv()
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "DATADOG_API_KEY")
+		}
+	})
+	t.Run("Missing application key", func(t *testing.T) {
+		os.Setenv("DATADOG_API_KEY", "env_api_key")
+		os.Setenv("DATADOG_APP_KEY", "")
+
+		_, err := NewClientFromEnv()
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "DATADOG_APP_KEY")
+		}
+	})
+}
+
+func TestNewClientWithProxy(t *testing.T) {
+	c, err := NewClientWithProxy("sample_api_key", "sample_app_key", "http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, ok := c.HttpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expect an *http.Transport. Got %T", c.HttpClient.Transport)
+	}
+	req, _ := http.NewRequest("GET", "https://app.datadoghq.com/api/v1/validate", nil)
+	proxy, err := transport.Proxy(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxy.String())
+
+	assert.Nil(t, http.DefaultClient.Transport)
+
+	t.Run("Invalid proxy URLs are rejected", func(t *testing.T) {
+		_, err := NewClientWithProxy("sample_api_key", "sample_app_key", "://proxy")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSetTLSConfig(t *testing.T) {
+	t.Run("Keeps other transport settings", func(t *testing.T) {
+		transport := &http.Transport{MaxIdleConns: 7}
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.HttpClient = &http.Client{Transport: transport}
+
+		config := &tls.Config{ServerName: "datadog.example.com"}
+		assert.Nil(t, c.SetTLSConfig(config))
+		assert.Equal(t, transport, c.HttpClient.Transport)
+		assert.Equal(t, config, transport.TLSClientConfig)
+		assert.Equal(t, 7, transport.MaxIdleConns)
+	})
+	t.Run("Does not modify the default transport", func(t *testing.T) {
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.HttpClient = &http.Client{Transport: http.DefaultTransport}
+
+		config := &tls.Config{}
+		assert.Nil(t, c.SetTLSConfig(config))
+		assert.True(t, c.HttpClient.Transport != http.DefaultTransport)
+		assert.True(t, http.DefaultTransport.(*http.Transport).TLSClientConfig != config)
+	})
+	t.Run("Rejects custom round trippers", func(t *testing.T) {
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.HttpClient = &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+
+		assert.NotNil(t, c.SetTLSConfig(&tls.Config{}))
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClone(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetAPIBaseUrl("https://api.example.com")
+	c.RetryTimeout = time.Second
+	c.MaxRetries = 2
+	c.DisableRetries = true
+	c.Headers = http.Header{"X-Tenant-Id": []string{"tenant-1"}}
+
+	clone := c.Clone()
+	assert.Equal(t, c.GetBaseUrl(), clone.GetBaseUrl())
+	assert.Equal(t, "https://api.example.com", clone.GetAPIBaseUrl())
+	assert.True(t, c.HttpClient == clone.HttpClient, "expect the clone to share the http.Client")
+	assert.Equal(t, time.Second, clone.RetryTimeout)
+	assert.Equal(t, 2, clone.MaxRetries)
+	assert.True(t, clone.DisableRetries)
+
+	clone.SetKeys("other_api_key", "other_app_key")
+	clone.Headers.Set("X-Tenant-Id", "tenant-2")
+	apiKey, appKey := c.keys()
+	assert.Equal(t, "sample_api_key", apiKey)
+	assert.Equal(t, "sample_app_key", appKey)
+	assert.Equal(t, "tenant-1", c.Headers.Get("X-Tenant-Id"))
+}
+
+func TestPing(t *testing.T) {
+	status := http.StatusOK
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "HEAD", r.Method)
+		assert.Empty(t, r.URL.Query().Get("api_key"))
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	assert.Nil(t, c.Ping(context.Background()))
+
+	status = http.StatusForbidden
+	assert.Nil(t, c.Ping(context.Background()))
+
+	status = http.StatusServiceUnavailable
+	assert.NotNil(t, c.Ping(context.Background()))
+
+	c.SetBaseUrl("http://127.0.0.1:0")
+	assert.NotNil(t, c.Ping(context.Background()))
+}
+
+type countingLimiter struct {
+	waits int32
+	block bool
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.waits, 1)
+	if l.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func TestLimiter(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+
+	t.Run("Every request waits for the limiter", func(t *testing.T) {
+		limiter := &countingLimiter{}
+		c.Limiter = limiter
+
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Nil(t, c.doJsonRequest("POST", "/v1/something", nil, nil))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&limiter.waits))
+	})
+	t.Run("Changes of the cap apply to the next requests", func(t *testing.T) {
+		c.MaxConcurrentRequests = 1
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, 1, cap(c.inFlight))
+	})
+	t.Run("Clones have a cap of their own", func(t *testing.T) {
+		clone := c.Clone()
+		assert.Nil(t, clone.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, 1, cap(clone.inFlight))
+		assert.True(t, clone.inFlight != c.inFlight)
+	})
+	t.Run("Waits are abandoned with the context", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 1)
+		c.Limiter = &countingLimiter{block: true}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := c.doJsonRequestWithContext(ctx, "POST", "/v1/something", nil, nil)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "expect no request to be sent")
+	})
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	const max = 3
+	var inFlight, peak int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.MaxConcurrentRequests = max
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		}()
+	}
+	wg.Wait()
+	assert.True(t, atomic.LoadInt32(&peak) <= max, "expect at most %d requests in flight. Got %d", max, peak)
+
+	t.Run("Changes of the cap apply to the next requests", func(t *testing.T) {
+		c.MaxConcurrentRequests = 1
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, 1, cap(c.inFlight))
+	})
+	t.Run("Clones have a cap of their own", func(t *testing.T) {
+		clone := c.Clone()
+		assert.Nil(t, clone.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, 1, cap(clone.inFlight))
+		assert.True(t, clone.inFlight != c.inFlight)
+	})
+	t.Run("Waits are abandoned with the context", func(t *testing.T) {
+		c.MaxConcurrentRequests = 1
+		c.inFlight = make(chan struct{}, 1)
+		c.inFlight <- struct{}{}
+		defer func() { <-c.inFlight }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := c.doJsonRequestWithContext(ctx, "GET", "/v1/something", nil, nil)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
@@ -11,6 +11,9 @@ package datadog
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // GraphDefinitionRequestStyle represents the graph style attributes
@@ -187,9 +190,21 @@ type CreatedBy struct {
 	Icon       *string `json:"icon,omitempty"`
 }
 
+// dashboardsPageSize is the number of dashboards requested per page by
+// GetDashboards.
+const dashboardsPageSize = 100
+
 // reqGetDashboards from /api/v1/dash
 type reqGetDashboards struct {
 	Dashboards []DashboardLite `json:"dashes,omitempty"`
+	Total      *int            `json:"total,omitempty"`
+}
+
+// DashboardsPage is a page of the dashboards of an account. Total is the
+// number of dashboards of the account, when the API reports it.
+type DashboardsPage struct {
+	Dashboards []DashboardLite
+	Total      *int
 }
 
 // reqGetDashboard from /api/v1/dash/:dashboard_id
@@ -218,13 +233,67 @@ func (client *Client) GetDashboard(id int) (*Dashboard, error) {
 	return out.Dashboard, nil
 }
 
+// GetDashboardTemplateVariables returns the template variables of a
+// dashboard, e.g. to reuse them on another board.
+func (client *Client) GetDashboardTemplateVariables(id int) ([]TemplateVariable, error) {
+	dash, err := client.GetDashboard(id)
+	if err != nil {
+		return nil, err
+	}
+	if dash == nil {
+		return nil, fmt.Errorf("no dashboard returned")
+	}
+	return dash.TemplateVariables, nil
+}
+
+// DashboardURL returns the URL of a dashboard in the Datadog application,
+// e.g. to link to it. It is derived from the base URL of the client.
+func (client *Client) DashboardURL(id int) string {
+	return fmt.Sprintf("%s/dash/%d", strings.TrimSuffix(client.GetBaseUrl(), "/"), id)
+}
+
 // GetDashboards returns a list of all dashboards created on this account.
+// The dashboards are requested page by page.
 func (client *Client) GetDashboards() ([]DashboardLite, error) {
+	var dashboards []DashboardLite
+	seen := map[int]bool{}
+	start := 0
+	for {
+		page, err := client.GetDashboardsPage(start, dashboardsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, dash := range page.Dashboards {
+			if !seen[dash.GetId()] {
+				seen[dash.GetId()] = true
+				dashboards = append(dashboards, dash)
+				added++
+			}
+		}
+		start += len(page.Dashboards)
+
+		// A page without new dashboards means the paging parameters were
+		// ignored and every dashboard was returned at once.
+		if added == 0 || len(page.Dashboards) < dashboardsPageSize ||
+			(page.Total != nil && start >= *page.Total) {
+			return dashboards, nil
+		}
+	}
+}
+
+// GetDashboardsPage returns count dashboards at most, starting at the
+// dashboard at index start.
+func (client *Client) GetDashboardsPage(start, count int) (*DashboardsPage, error) {
+	v := url.Values{}
+	v.Add("start", strconv.Itoa(start))
+	v.Add("count", strconv.Itoa(count))
+
 	var out reqGetDashboards
-	if err := client.doJsonRequest("GET", "/v1/dash", nil, &out); err != nil {
+	if err := client.doJsonRequest("GET", "/v1/dash?"+v.Encode(), nil, &out); err != nil {
 		return nil, err
 	}
-	return out.Dashboards, nil
+	return &DashboardsPage{Dashboards: out.Dashboards, Total: out.Total}, nil
 }
 
 // DeleteDashboard deletes a dashboard by the identifier.
@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -235,3 +236,106 @@ func validateCreatedBy(t *testing.T, cb *CreatedBy) {
 		t.Fatalf("expect verify %v. Got %v", expectedVerified, verified)
 	}
 }
+
+func TestDashboardURL(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl("https://app.datadoghq.com/")
+	c.SetAPIBaseUrl("https://api.datadoghq.com")
+	assert.Equal(t, "https://app.datadoghq.com/dash/10880", c.DashboardURL(10880))
+	assert.Equal(t, "https://app.datadoghq.com/screen/42", c.ScreenboardURL(42))
+
+	assert.Nil(t, c.SetSite("datadoghq.eu"))
+	assert.Equal(t, "https://app.datadoghq.eu/dash/10880", c.DashboardURL(10880))
+}
+
+func TestDashboardTemplateVariablesJSON(t *testing.T) {
+	dash := Dashboard{Title: String("board")}
+	b, err := json.Marshal(dash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"title": "board"}`, string(b))
+
+	const fixture = `{
+		"title": "board",
+		"template_variables": [
+			{"name": "env", "prefix": "env", "default": "prod"},
+			{"name": "service"}
+		]
+	}`
+	var in Dashboard
+	if err := json.Unmarshal([]byte(fixture), &in); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []TemplateVariable{
+		{Name: String("env"), Prefix: String("env"), Default: String("prod")},
+		{Name: String("service")},
+	}, in.TemplateVariables)
+
+	b, err = json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, fixture, string(b))
+}
+
+func TestGetTemplateVariables(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/dash/1":
+			w.Write([]byte(`{"dash": {"id": 1, "template_variables": [{"name": "env", "prefix": "env"}]}}`))
+		case "/api/v1/screen/2":
+			w.Write([]byte(`{"id": 2, "template_variables": [{"name": "service", "default": "web"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	vars, err := c.GetDashboardTemplateVariables(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []TemplateVariable{{Name: String("env"), Prefix: String("env")}}, vars)
+
+	vars, err = c.GetScreenboardTemplateVariables(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []TemplateVariable{{Name: String("service"), Default: String("web")}}, vars)
+}
+
+func TestGetDashboardsPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/dash", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("count"))
+
+		var dashes []map[string]interface{}
+		start := r.URL.Query().Get("start")
+		switch start {
+		case "0", "100":
+			for i := 0; i < 100; i++ {
+				dashes = append(dashes, map[string]interface{}{"id": fmt.Sprint(len(start)*1000 + i)})
+			}
+		case "200":
+			dashes = append(dashes, map[string]interface{}{"id": "5000"})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"dashes": dashes, "total": 201})
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	page, err := c.GetDashboardsPage(200, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 201, page.GetTotal())
+	if assert.Len(t, page.Dashboards, 1) {
+		assert.Equal(t, 5000, page.Dashboards[0].GetId())
+	}
+
+	dashboards, err := c.GetDashboards()
+	assert.Nil(t, err)
+	assert.Len(t, dashboards, 201)
+}
@@ -4,7 +4,7 @@
  *
  * Please see the included LICENSE file for licensing information.
  *
- * Copyright 2018 by authors and contributors.
+ * Copyright 2026 by authors and contributors.
 */
 
 package datadog
@@ -261,938 +261,907 @@ func (a *Alert) SetState(v string) {
 	a.State = &v
 }
 
-// GetAccount returns the Account field if non-nil, zero value otherwise.
-func (c *ChannelSlackRequest) GetAccount() string {
-	if c == nil || c.Account == nil {
-		return ""
+// GetV2 returns the V2 field if non-nil, zero value otherwise.
+func (a *APIInfo) GetV2() bool {
+	if a == nil || a.V2 == nil {
+		return false
 	}
-	return *c.Account
+	return *a.V2
 }
 
-// GetAccountOk returns a tuple with the Account field if it's non-nil, zero value otherwise
+// GetV2Ok returns a tuple with the V2 field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ChannelSlackRequest) GetAccountOk() (string, bool) {
-	if c == nil || c.Account == nil {
-		return "", false
+func (a *APIInfo) GetV2Ok() (bool, bool) {
+	if a == nil || a.V2 == nil {
+		return false, false
 	}
-	return *c.Account, true
+	return *a.V2, true
 }
 
-// HasAccount returns a boolean if a field has been set.
-func (c *ChannelSlackRequest) HasAccount() bool {
-	if c != nil && c.Account != nil {
+// HasV2 returns a boolean if a field has been set.
+func (a *APIInfo) HasV2() bool {
+	if a != nil && a.V2 != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccount allocates a new c.Account and returns the pointer to it.
-func (c *ChannelSlackRequest) SetAccount(v string) {
-	c.Account = &v
+// SetV2 allocates a new a.V2 and returns the pointer to it.
+func (a *APIInfo) SetV2(v bool) {
+	a.V2 = &v
 }
 
-// GetChannelName returns the ChannelName field if non-nil, zero value otherwise.
-func (c *ChannelSlackRequest) GetChannelName() string {
-	if c == nil || c.ChannelName == nil {
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (a *APIKey) GetCreatedAt() string {
+	if a == nil || a.CreatedAt == nil {
 		return ""
 	}
-	return *c.ChannelName
+	return *a.CreatedAt
 }
 
-// GetChannelNameOk returns a tuple with the ChannelName field if it's non-nil, zero value otherwise
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ChannelSlackRequest) GetChannelNameOk() (string, bool) {
-	if c == nil || c.ChannelName == nil {
+func (a *APIKey) GetCreatedAtOk() (string, bool) {
+	if a == nil || a.CreatedAt == nil {
 		return "", false
 	}
-	return *c.ChannelName, true
+	return *a.CreatedAt, true
 }
 
-// HasChannelName returns a boolean if a field has been set.
-func (c *ChannelSlackRequest) HasChannelName() bool {
-	if c != nil && c.ChannelName != nil {
+// HasCreatedAt returns a boolean if a field has been set.
+func (a *APIKey) HasCreatedAt() bool {
+	if a != nil && a.CreatedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetChannelName allocates a new c.ChannelName and returns the pointer to it.
-func (c *ChannelSlackRequest) SetChannelName(v string) {
-	c.ChannelName = &v
+// SetCreatedAt allocates a new a.CreatedAt and returns the pointer to it.
+func (a *APIKey) SetCreatedAt(v string) {
+	a.CreatedAt = &v
 }
 
-// GetTransferAllUserComments returns the TransferAllUserComments field if non-nil, zero value otherwise.
-func (c *ChannelSlackRequest) GetTransferAllUserComments() bool {
-	if c == nil || c.TransferAllUserComments == nil {
-		return false
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (a *APIKey) GetId() string {
+	if a == nil || a.Id == nil {
+		return ""
 	}
-	return *c.TransferAllUserComments
+	return *a.Id
 }
 
-// GetTransferAllUserCommentsOk returns a tuple with the TransferAllUserComments field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ChannelSlackRequest) GetTransferAllUserCommentsOk() (bool, bool) {
-	if c == nil || c.TransferAllUserComments == nil {
-		return false, false
+func (a *APIKey) GetIdOk() (string, bool) {
+	if a == nil || a.Id == nil {
+		return "", false
 	}
-	return *c.TransferAllUserComments, true
+	return *a.Id, true
 }
 
-// HasTransferAllUserComments returns a boolean if a field has been set.
-func (c *ChannelSlackRequest) HasTransferAllUserComments() bool {
-	if c != nil && c.TransferAllUserComments != nil {
+// HasId returns a boolean if a field has been set.
+func (a *APIKey) HasId() bool {
+	if a != nil && a.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTransferAllUserComments allocates a new c.TransferAllUserComments and returns the pointer to it.
-func (c *ChannelSlackRequest) SetTransferAllUserComments(v bool) {
-	c.TransferAllUserComments = &v
+// SetId allocates a new a.Id and returns the pointer to it.
+func (a *APIKey) SetId(v string) {
+	a.Id = &v
 }
 
-// GetCheck returns the Check field if non-nil, zero value otherwise.
-func (c *Check) GetCheck() string {
-	if c == nil || c.Check == nil {
+// GetKey returns the Key field if non-nil, zero value otherwise.
+func (a *APIKey) GetKey() string {
+	if a == nil || a.Key == nil {
 		return ""
 	}
-	return *c.Check
+	return *a.Key
 }
 
-// GetCheckOk returns a tuple with the Check field if it's non-nil, zero value otherwise
+// GetKeyOk returns a tuple with the Key field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Check) GetCheckOk() (string, bool) {
-	if c == nil || c.Check == nil {
+func (a *APIKey) GetKeyOk() (string, bool) {
+	if a == nil || a.Key == nil {
 		return "", false
 	}
-	return *c.Check, true
+	return *a.Key, true
 }
 
-// HasCheck returns a boolean if a field has been set.
-func (c *Check) HasCheck() bool {
-	if c != nil && c.Check != nil {
+// HasKey returns a boolean if a field has been set.
+func (a *APIKey) HasKey() bool {
+	if a != nil && a.Key != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCheck allocates a new c.Check and returns the pointer to it.
-func (c *Check) SetCheck(v string) {
-	c.Check = &v
+// SetKey allocates a new a.Key and returns the pointer to it.
+func (a *APIKey) SetKey(v string) {
+	a.Key = &v
 }
 
-// GetHostName returns the HostName field if non-nil, zero value otherwise.
-func (c *Check) GetHostName() string {
-	if c == nil || c.HostName == nil {
+// GetLast4 returns the Last4 field if non-nil, zero value otherwise.
+func (a *APIKey) GetLast4() string {
+	if a == nil || a.Last4 == nil {
 		return ""
 	}
-	return *c.HostName
+	return *a.Last4
 }
 
-// GetHostNameOk returns a tuple with the HostName field if it's non-nil, zero value otherwise
+// GetLast4Ok returns a tuple with the Last4 field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Check) GetHostNameOk() (string, bool) {
-	if c == nil || c.HostName == nil {
+func (a *APIKey) GetLast4Ok() (string, bool) {
+	if a == nil || a.Last4 == nil {
 		return "", false
 	}
-	return *c.HostName, true
+	return *a.Last4, true
 }
 
-// HasHostName returns a boolean if a field has been set.
-func (c *Check) HasHostName() bool {
-	if c != nil && c.HostName != nil {
+// HasLast4 returns a boolean if a field has been set.
+func (a *APIKey) HasLast4() bool {
+	if a != nil && a.Last4 != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHostName allocates a new c.HostName and returns the pointer to it.
-func (c *Check) SetHostName(v string) {
-	c.HostName = &v
+// SetLast4 allocates a new a.Last4 and returns the pointer to it.
+func (a *APIKey) SetLast4(v string) {
+	a.Last4 = &v
 }
 
-// GetMessage returns the Message field if non-nil, zero value otherwise.
-func (c *Check) GetMessage() string {
-	if c == nil || c.Message == nil {
+// GetModifiedAt returns the ModifiedAt field if non-nil, zero value otherwise.
+func (a *APIKey) GetModifiedAt() string {
+	if a == nil || a.ModifiedAt == nil {
 		return ""
 	}
-	return *c.Message
+	return *a.ModifiedAt
 }
 
-// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// GetModifiedAtOk returns a tuple with the ModifiedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Check) GetMessageOk() (string, bool) {
-	if c == nil || c.Message == nil {
+func (a *APIKey) GetModifiedAtOk() (string, bool) {
+	if a == nil || a.ModifiedAt == nil {
 		return "", false
 	}
-	return *c.Message, true
+	return *a.ModifiedAt, true
 }
 
-// HasMessage returns a boolean if a field has been set.
-func (c *Check) HasMessage() bool {
-	if c != nil && c.Message != nil {
+// HasModifiedAt returns a boolean if a field has been set.
+func (a *APIKey) HasModifiedAt() bool {
+	if a != nil && a.ModifiedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMessage allocates a new c.Message and returns the pointer to it.
-func (c *Check) SetMessage(v string) {
-	c.Message = &v
+// SetModifiedAt allocates a new a.ModifiedAt and returns the pointer to it.
+func (a *APIKey) SetModifiedAt(v string) {
+	a.ModifiedAt = &v
 }
 
-// GetStatus returns the Status field if non-nil, zero value otherwise.
-func (c *Check) GetStatus() Status {
-	if c == nil || c.Status == nil {
-		return 0
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (a *APIKey) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
 	}
-	return *c.Status
+	return *a.Name
 }
 
-// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Check) GetStatusOk() (Status, bool) {
-	if c == nil || c.Status == nil {
-		return 0, false
+func (a *APIKey) GetNameOk() (string, bool) {
+	if a == nil || a.Name == nil {
+		return "", false
 	}
-	return *c.Status, true
+	return *a.Name, true
 }
 
-// HasStatus returns a boolean if a field has been set.
-func (c *Check) HasStatus() bool {
-	if c != nil && c.Status != nil {
+// HasName returns a boolean if a field has been set.
+func (a *APIKey) HasName() bool {
+	if a != nil && a.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStatus allocates a new c.Status and returns the pointer to it.
-func (c *Check) SetStatus(v Status) {
-	c.Status = &v
+// SetName allocates a new a.Name and returns the pointer to it.
+func (a *APIKey) SetName(v string) {
+	a.Name = &v
 }
 
-// GetTimestamp returns the Timestamp field if non-nil, zero value otherwise.
-func (c *Check) GetTimestamp() string {
-	if c == nil || c.Timestamp == nil {
-		return ""
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (a *apiKeyData) GetAttributes() APIKey {
+	if a == nil || a.Attributes == nil {
+		return APIKey{}
 	}
-	return *c.Timestamp
+	return *a.Attributes
 }
 
-// GetTimestampOk returns a tuple with the Timestamp field if it's non-nil, zero value otherwise
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Check) GetTimestampOk() (string, bool) {
-	if c == nil || c.Timestamp == nil {
-		return "", false
+func (a *apiKeyData) GetAttributesOk() (APIKey, bool) {
+	if a == nil || a.Attributes == nil {
+		return APIKey{}, false
 	}
-	return *c.Timestamp, true
+	return *a.Attributes, true
 }
 
-// HasTimestamp returns a boolean if a field has been set.
-func (c *Check) HasTimestamp() bool {
-	if c != nil && c.Timestamp != nil {
+// HasAttributes returns a boolean if a field has been set.
+func (a *apiKeyData) HasAttributes() bool {
+	if a != nil && a.Attributes != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTimestamp allocates a new c.Timestamp and returns the pointer to it.
-func (c *Check) SetTimestamp(v string) {
-	c.Timestamp = &v
+// SetAttributes allocates a new a.Attributes and returns the pointer to it.
+func (a *apiKeyData) SetAttributes(v APIKey) {
+	a.Attributes = &v
 }
 
-// GetHandle returns the Handle field if non-nil, zero value otherwise.
-func (c *Comment) GetHandle() string {
-	if c == nil || c.Handle == nil {
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (a *apiKeyData) GetId() string {
+	if a == nil || a.Id == nil {
 		return ""
 	}
-	return *c.Handle
+	return *a.Id
 }
 
-// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetHandleOk() (string, bool) {
-	if c == nil || c.Handle == nil {
+func (a *apiKeyData) GetIdOk() (string, bool) {
+	if a == nil || a.Id == nil {
 		return "", false
 	}
-	return *c.Handle, true
+	return *a.Id, true
 }
 
-// HasHandle returns a boolean if a field has been set.
-func (c *Comment) HasHandle() bool {
-	if c != nil && c.Handle != nil {
+// HasId returns a boolean if a field has been set.
+func (a *apiKeyData) HasId() bool {
+	if a != nil && a.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHandle allocates a new c.Handle and returns the pointer to it.
-func (c *Comment) SetHandle(v string) {
-	c.Handle = &v
+// SetId allocates a new a.Id and returns the pointer to it.
+func (a *apiKeyData) SetId(v string) {
+	a.Id = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (c *Comment) GetId() int {
-	if c == nil || c.Id == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (a *ApplicationKey) GetCreatedAt() string {
+	if a == nil || a.CreatedAt == nil {
+		return ""
 	}
-	return *c.Id
+	return *a.CreatedAt
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetIdOk() (int, bool) {
-	if c == nil || c.Id == nil {
-		return 0, false
+func (a *ApplicationKey) GetCreatedAtOk() (string, bool) {
+	if a == nil || a.CreatedAt == nil {
+		return "", false
 	}
-	return *c.Id, true
+	return *a.CreatedAt, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (c *Comment) HasId() bool {
-	if c != nil && c.Id != nil {
+// HasCreatedAt returns a boolean if a field has been set.
+func (a *ApplicationKey) HasCreatedAt() bool {
+	if a != nil && a.CreatedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new c.Id and returns the pointer to it.
-func (c *Comment) SetId(v int) {
-	c.Id = &v
+// SetCreatedAt allocates a new a.CreatedAt and returns the pointer to it.
+func (a *ApplicationKey) SetCreatedAt(v string) {
+	a.CreatedAt = &v
 }
 
-// GetMessage returns the Message field if non-nil, zero value otherwise.
-func (c *Comment) GetMessage() string {
-	if c == nil || c.Message == nil {
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (a *ApplicationKey) GetId() string {
+	if a == nil || a.Id == nil {
 		return ""
 	}
-	return *c.Message
+	return *a.Id
 }
 
-// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetMessageOk() (string, bool) {
-	if c == nil || c.Message == nil {
+func (a *ApplicationKey) GetIdOk() (string, bool) {
+	if a == nil || a.Id == nil {
 		return "", false
 	}
-	return *c.Message, true
+	return *a.Id, true
 }
 
-// HasMessage returns a boolean if a field has been set.
-func (c *Comment) HasMessage() bool {
-	if c != nil && c.Message != nil {
+// HasId returns a boolean if a field has been set.
+func (a *ApplicationKey) HasId() bool {
+	if a != nil && a.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMessage allocates a new c.Message and returns the pointer to it.
-func (c *Comment) SetMessage(v string) {
-	c.Message = &v
+// SetId allocates a new a.Id and returns the pointer to it.
+func (a *ApplicationKey) SetId(v string) {
+	a.Id = &v
 }
 
-// GetRelatedId returns the RelatedId field if non-nil, zero value otherwise.
-func (c *Comment) GetRelatedId() int {
-	if c == nil || c.RelatedId == nil {
-		return 0
+// GetKey returns the Key field if non-nil, zero value otherwise.
+func (a *ApplicationKey) GetKey() string {
+	if a == nil || a.Key == nil {
+		return ""
 	}
-	return *c.RelatedId
+	return *a.Key
 }
 
-// GetRelatedIdOk returns a tuple with the RelatedId field if it's non-nil, zero value otherwise
+// GetKeyOk returns a tuple with the Key field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetRelatedIdOk() (int, bool) {
-	if c == nil || c.RelatedId == nil {
-		return 0, false
+func (a *ApplicationKey) GetKeyOk() (string, bool) {
+	if a == nil || a.Key == nil {
+		return "", false
 	}
-	return *c.RelatedId, true
+	return *a.Key, true
 }
 
-// HasRelatedId returns a boolean if a field has been set.
-func (c *Comment) HasRelatedId() bool {
-	if c != nil && c.RelatedId != nil {
+// HasKey returns a boolean if a field has been set.
+func (a *ApplicationKey) HasKey() bool {
+	if a != nil && a.Key != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRelatedId allocates a new c.RelatedId and returns the pointer to it.
-func (c *Comment) SetRelatedId(v int) {
-	c.RelatedId = &v
+// SetKey allocates a new a.Key and returns the pointer to it.
+func (a *ApplicationKey) SetKey(v string) {
+	a.Key = &v
 }
 
-// GetResource returns the Resource field if non-nil, zero value otherwise.
-func (c *Comment) GetResource() string {
-	if c == nil || c.Resource == nil {
+// GetLast4 returns the Last4 field if non-nil, zero value otherwise.
+func (a *ApplicationKey) GetLast4() string {
+	if a == nil || a.Last4 == nil {
 		return ""
 	}
-	return *c.Resource
+	return *a.Last4
 }
 
-// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// GetLast4Ok returns a tuple with the Last4 field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetResourceOk() (string, bool) {
-	if c == nil || c.Resource == nil {
+func (a *ApplicationKey) GetLast4Ok() (string, bool) {
+	if a == nil || a.Last4 == nil {
 		return "", false
 	}
-	return *c.Resource, true
+	return *a.Last4, true
 }
 
-// HasResource returns a boolean if a field has been set.
-func (c *Comment) HasResource() bool {
-	if c != nil && c.Resource != nil {
+// HasLast4 returns a boolean if a field has been set.
+func (a *ApplicationKey) HasLast4() bool {
+	if a != nil && a.Last4 != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetResource allocates a new c.Resource and returns the pointer to it.
-func (c *Comment) SetResource(v string) {
-	c.Resource = &v
+// SetLast4 allocates a new a.Last4 and returns the pointer to it.
+func (a *ApplicationKey) SetLast4(v string) {
+	a.Last4 = &v
 }
 
-// GetUrl returns the Url field if non-nil, zero value otherwise.
-func (c *Comment) GetUrl() string {
-	if c == nil || c.Url == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (a *ApplicationKey) GetName() string {
+	if a == nil || a.Name == nil {
 		return ""
 	}
-	return *c.Url
+	return *a.Name
 }
 
-// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Comment) GetUrlOk() (string, bool) {
-	if c == nil || c.Url == nil {
+func (a *ApplicationKey) GetNameOk() (string, bool) {
+	if a == nil || a.Name == nil {
 		return "", false
 	}
-	return *c.Url, true
+	return *a.Name, true
 }
 
-// HasUrl returns a boolean if a field has been set.
-func (c *Comment) HasUrl() bool {
-	if c != nil && c.Url != nil {
+// HasName returns a boolean if a field has been set.
+func (a *ApplicationKey) HasName() bool {
+	if a != nil && a.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUrl allocates a new c.Url and returns the pointer to it.
-func (c *Comment) SetUrl(v string) {
-	c.Url = &v
+// SetName allocates a new a.Name and returns the pointer to it.
+func (a *ApplicationKey) SetName(v string) {
+	a.Name = &v
 }
 
-// GetColor returns the Color field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetColor() string {
-	if c == nil || c.Color == nil {
-		return ""
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (a *applicationKeyData) GetAttributes() ApplicationKey {
+	if a == nil || a.Attributes == nil {
+		return ApplicationKey{}
 	}
-	return *c.Color
+	return *a.Attributes
 }
 
-// GetColorOk returns a tuple with the Color field if it's non-nil, zero value otherwise
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetColorOk() (string, bool) {
-	if c == nil || c.Color == nil {
-		return "", false
+func (a *applicationKeyData) GetAttributesOk() (ApplicationKey, bool) {
+	if a == nil || a.Attributes == nil {
+		return ApplicationKey{}, false
 	}
-	return *c.Color, true
+	return *a.Attributes, true
 }
 
-// HasColor returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasColor() bool {
-	if c != nil && c.Color != nil {
+// HasAttributes returns a boolean if a field has been set.
+func (a *applicationKeyData) HasAttributes() bool {
+	if a != nil && a.Attributes != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetColor allocates a new c.Color and returns the pointer to it.
-func (c *ConditionalFormat) SetColor(v string) {
-	c.Color = &v
+// SetAttributes allocates a new a.Attributes and returns the pointer to it.
+func (a *applicationKeyData) SetAttributes(v ApplicationKey) {
+	a.Attributes = &v
 }
 
-// GetComparator returns the Comparator field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetComparator() string {
-	if c == nil || c.Comparator == nil {
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (a *applicationKeyData) GetId() string {
+	if a == nil || a.Id == nil {
 		return ""
 	}
-	return *c.Comparator
+	return *a.Id
 }
 
-// GetComparatorOk returns a tuple with the Comparator field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetComparatorOk() (string, bool) {
-	if c == nil || c.Comparator == nil {
+func (a *applicationKeyData) GetIdOk() (string, bool) {
+	if a == nil || a.Id == nil {
 		return "", false
 	}
-	return *c.Comparator, true
+	return *a.Id, true
 }
 
-// HasComparator returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasComparator() bool {
-	if c != nil && c.Comparator != nil {
+// HasId returns a boolean if a field has been set.
+func (a *applicationKeyData) HasId() bool {
+	if a != nil && a.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetComparator allocates a new c.Comparator and returns the pointer to it.
-func (c *ConditionalFormat) SetComparator(v string) {
-	c.Comparator = &v
+// SetId allocates a new a.Id and returns the pointer to it.
+func (a *applicationKeyData) SetId(v string) {
+	a.Id = &v
 }
 
-// GetImageURL returns the ImageURL field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetImageURL() string {
-	if c == nil || c.ImageURL == nil {
+// GetBoardTitle returns the BoardTitle field if non-nil, zero value otherwise.
+func (b *boardFields) GetBoardTitle() string {
+	if b == nil || b.BoardTitle == nil {
 		return ""
 	}
-	return *c.ImageURL
+	return *b.BoardTitle
 }
 
-// GetImageURLOk returns a tuple with the ImageURL field if it's non-nil, zero value otherwise
+// GetBoardTitleOk returns a tuple with the BoardTitle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetImageURLOk() (string, bool) {
-	if c == nil || c.ImageURL == nil {
+func (b *boardFields) GetBoardTitleOk() (string, bool) {
+	if b == nil || b.BoardTitle == nil {
 		return "", false
 	}
-	return *c.ImageURL, true
+	return *b.BoardTitle, true
 }
 
-// HasImageURL returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasImageURL() bool {
-	if c != nil && c.ImageURL != nil {
+// HasBoardTitle returns a boolean if a field has been set.
+func (b *boardFields) HasBoardTitle() bool {
+	if b != nil && b.BoardTitle != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetImageURL allocates a new c.ImageURL and returns the pointer to it.
-func (c *ConditionalFormat) SetImageURL(v string) {
-	c.ImageURL = &v
+// SetBoardTitle allocates a new b.BoardTitle and returns the pointer to it.
+func (b *boardFields) SetBoardTitle(v string) {
+	b.BoardTitle = &v
 }
 
-// GetInvert returns the Invert field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetInvert() bool {
-	if c == nil || c.Invert == nil {
-		return false
+// GetLayoutType returns the LayoutType field if non-nil, zero value otherwise.
+func (b *boardFields) GetLayoutType() string {
+	if b == nil || b.LayoutType == nil {
+		return ""
 	}
-	return *c.Invert
+	return *b.LayoutType
 }
 
-// GetInvertOk returns a tuple with the Invert field if it's non-nil, zero value otherwise
+// GetLayoutTypeOk returns a tuple with the LayoutType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetInvertOk() (bool, bool) {
-	if c == nil || c.Invert == nil {
-		return false, false
+func (b *boardFields) GetLayoutTypeOk() (string, bool) {
+	if b == nil || b.LayoutType == nil {
+		return "", false
 	}
-	return *c.Invert, true
+	return *b.LayoutType, true
 }
 
-// HasInvert returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasInvert() bool {
-	if c != nil && c.Invert != nil {
+// HasLayoutType returns a boolean if a field has been set.
+func (b *boardFields) HasLayoutType() bool {
+	if b != nil && b.LayoutType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetInvert allocates a new c.Invert and returns the pointer to it.
-func (c *ConditionalFormat) SetInvert(v bool) {
-	c.Invert = &v
+// SetLayoutType allocates a new b.LayoutType and returns the pointer to it.
+func (b *boardFields) SetLayoutType(v string) {
+	b.LayoutType = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetPalette() string {
-	if c == nil || c.Palette == nil {
-		return ""
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (c *Category) GetFilter() FilterConfiguration {
+	if c == nil || c.Filter == nil {
+		return FilterConfiguration{}
 	}
-	return *c.Palette
+	return *c.Filter
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetPaletteOk() (string, bool) {
-	if c == nil || c.Palette == nil {
-		return "", false
+func (c *Category) GetFilterOk() (FilterConfiguration, bool) {
+	if c == nil || c.Filter == nil {
+		return FilterConfiguration{}, false
 	}
-	return *c.Palette, true
+	return *c.Filter, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasPalette() bool {
-	if c != nil && c.Palette != nil {
+// HasFilter returns a boolean if a field has been set.
+func (c *Category) HasFilter() bool {
+	if c != nil && c.Filter != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new c.Palette and returns the pointer to it.
-func (c *ConditionalFormat) SetPalette(v string) {
-	c.Palette = &v
+// SetFilter allocates a new c.Filter and returns the pointer to it.
+func (c *Category) SetFilter(v FilterConfiguration) {
+	c.Filter = &v
 }
 
-// GetValue returns the Value field if non-nil, zero value otherwise.
-func (c *ConditionalFormat) GetValue() string {
-	if c == nil || c.Value == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (c *Category) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.Value
+	return *c.Name
 }
 
-// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *ConditionalFormat) GetValueOk() (string, bool) {
-	if c == nil || c.Value == nil {
+func (c *Category) GetNameOk() (string, bool) {
+	if c == nil || c.Name == nil {
 		return "", false
 	}
-	return *c.Value, true
+	return *c.Name, true
 }
 
-// HasValue returns a boolean if a field has been set.
-func (c *ConditionalFormat) HasValue() bool {
-	if c != nil && c.Value != nil {
+// HasName returns a boolean if a field has been set.
+func (c *Category) HasName() bool {
+	if c != nil && c.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetValue allocates a new c.Value and returns the pointer to it.
-func (c *ConditionalFormat) SetValue(v string) {
-	c.Value = &v
+// SetName allocates a new c.Name and returns the pointer to it.
+func (c *Category) SetName(v string) {
+	c.Name = &v
 }
 
-// GetAccessRole returns the AccessRole field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetAccessRole() string {
-	if c == nil || c.AccessRole == nil {
+// GetTarget returns the Target field if non-nil, zero value otherwise.
+func (c *CategoryProcessor) GetTarget() string {
+	if c == nil || c.Target == nil {
 		return ""
 	}
-	return *c.AccessRole
+	return *c.Target
 }
 
-// GetAccessRoleOk returns a tuple with the AccessRole field if it's non-nil, zero value otherwise
+// GetTargetOk returns a tuple with the Target field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetAccessRoleOk() (string, bool) {
-	if c == nil || c.AccessRole == nil {
+func (c *CategoryProcessor) GetTargetOk() (string, bool) {
+	if c == nil || c.Target == nil {
 		return "", false
 	}
-	return *c.AccessRole, true
+	return *c.Target, true
 }
 
-// HasAccessRole returns a boolean if a field has been set.
-func (c *CreatedBy) HasAccessRole() bool {
-	if c != nil && c.AccessRole != nil {
+// HasTarget returns a boolean if a field has been set.
+func (c *CategoryProcessor) HasTarget() bool {
+	if c != nil && c.Target != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccessRole allocates a new c.AccessRole and returns the pointer to it.
-func (c *CreatedBy) SetAccessRole(v string) {
-	c.AccessRole = &v
+// SetTarget allocates a new c.Target and returns the pointer to it.
+func (c *CategoryProcessor) SetTarget(v string) {
+	c.Target = &v
 }
 
-// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetDisabled() bool {
-	if c == nil || c.Disabled == nil {
-		return false
+// GetAccount returns the Account field if non-nil, zero value otherwise.
+func (c *ChannelSlackRequest) GetAccount() string {
+	if c == nil || c.Account == nil {
+		return ""
 	}
-	return *c.Disabled
+	return *c.Account
 }
 
-// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
+// GetAccountOk returns a tuple with the Account field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetDisabledOk() (bool, bool) {
-	if c == nil || c.Disabled == nil {
-		return false, false
+func (c *ChannelSlackRequest) GetAccountOk() (string, bool) {
+	if c == nil || c.Account == nil {
+		return "", false
 	}
-	return *c.Disabled, true
+	return *c.Account, true
 }
 
-// HasDisabled returns a boolean if a field has been set.
-func (c *CreatedBy) HasDisabled() bool {
-	if c != nil && c.Disabled != nil {
+// HasAccount returns a boolean if a field has been set.
+func (c *ChannelSlackRequest) HasAccount() bool {
+	if c != nil && c.Account != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDisabled allocates a new c.Disabled and returns the pointer to it.
-func (c *CreatedBy) SetDisabled(v bool) {
-	c.Disabled = &v
+// SetAccount allocates a new c.Account and returns the pointer to it.
+func (c *ChannelSlackRequest) SetAccount(v string) {
+	c.Account = &v
 }
 
-// GetEmail returns the Email field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetEmail() string {
-	if c == nil || c.Email == nil {
+// GetChannelName returns the ChannelName field if non-nil, zero value otherwise.
+func (c *ChannelSlackRequest) GetChannelName() string {
+	if c == nil || c.ChannelName == nil {
 		return ""
 	}
-	return *c.Email
+	return *c.ChannelName
 }
 
-// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
+// GetChannelNameOk returns a tuple with the ChannelName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetEmailOk() (string, bool) {
-	if c == nil || c.Email == nil {
+func (c *ChannelSlackRequest) GetChannelNameOk() (string, bool) {
+	if c == nil || c.ChannelName == nil {
 		return "", false
 	}
-	return *c.Email, true
+	return *c.ChannelName, true
 }
 
-// HasEmail returns a boolean if a field has been set.
-func (c *CreatedBy) HasEmail() bool {
-	if c != nil && c.Email != nil {
+// HasChannelName returns a boolean if a field has been set.
+func (c *ChannelSlackRequest) HasChannelName() bool {
+	if c != nil && c.ChannelName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEmail allocates a new c.Email and returns the pointer to it.
-func (c *CreatedBy) SetEmail(v string) {
-	c.Email = &v
+// SetChannelName allocates a new c.ChannelName and returns the pointer to it.
+func (c *ChannelSlackRequest) SetChannelName(v string) {
+	c.ChannelName = &v
 }
 
-// GetHandle returns the Handle field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetHandle() string {
-	if c == nil || c.Handle == nil {
-		return ""
+// GetTransferAllUserComments returns the TransferAllUserComments field if non-nil, zero value otherwise.
+func (c *ChannelSlackRequest) GetTransferAllUserComments() bool {
+	if c == nil || c.TransferAllUserComments == nil {
+		return false
 	}
-	return *c.Handle
+	return *c.TransferAllUserComments
 }
 
-// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
+// GetTransferAllUserCommentsOk returns a tuple with the TransferAllUserComments field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetHandleOk() (string, bool) {
-	if c == nil || c.Handle == nil {
-		return "", false
+func (c *ChannelSlackRequest) GetTransferAllUserCommentsOk() (bool, bool) {
+	if c == nil || c.TransferAllUserComments == nil {
+		return false, false
 	}
-	return *c.Handle, true
+	return *c.TransferAllUserComments, true
 }
 
-// HasHandle returns a boolean if a field has been set.
-func (c *CreatedBy) HasHandle() bool {
-	if c != nil && c.Handle != nil {
+// HasTransferAllUserComments returns a boolean if a field has been set.
+func (c *ChannelSlackRequest) HasTransferAllUserComments() bool {
+	if c != nil && c.TransferAllUserComments != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHandle allocates a new c.Handle and returns the pointer to it.
-func (c *CreatedBy) SetHandle(v string) {
-	c.Handle = &v
+// SetTransferAllUserComments allocates a new c.TransferAllUserComments and returns the pointer to it.
+func (c *ChannelSlackRequest) SetTransferAllUserComments(v bool) {
+	c.TransferAllUserComments = &v
 }
 
-// GetIcon returns the Icon field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetIcon() string {
-	if c == nil || c.Icon == nil {
+// GetCheck returns the Check field if non-nil, zero value otherwise.
+func (c *Check) GetCheck() string {
+	if c == nil || c.Check == nil {
 		return ""
 	}
-	return *c.Icon
+	return *c.Check
 }
 
-// GetIconOk returns a tuple with the Icon field if it's non-nil, zero value otherwise
+// GetCheckOk returns a tuple with the Check field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetIconOk() (string, bool) {
-	if c == nil || c.Icon == nil {
+func (c *Check) GetCheckOk() (string, bool) {
+	if c == nil || c.Check == nil {
 		return "", false
 	}
-	return *c.Icon, true
+	return *c.Check, true
 }
 
-// HasIcon returns a boolean if a field has been set.
-func (c *CreatedBy) HasIcon() bool {
-	if c != nil && c.Icon != nil {
+// HasCheck returns a boolean if a field has been set.
+func (c *Check) HasCheck() bool {
+	if c != nil && c.Check != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIcon allocates a new c.Icon and returns the pointer to it.
-func (c *CreatedBy) SetIcon(v string) {
-	c.Icon = &v
-}
-
-// GetIsAdmin returns the IsAdmin field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetIsAdmin() bool {
-	if c == nil || c.IsAdmin == nil {
-		return false
-	}
-	return *c.IsAdmin
-}
-
-// GetIsAdminOk returns a tuple with the IsAdmin field if it's non-nil, zero value otherwise
-// and a boolean to check if the value has been set.
-func (c *CreatedBy) GetIsAdminOk() (bool, bool) {
-	if c == nil || c.IsAdmin == nil {
-		return false, false
-	}
-	return *c.IsAdmin, true
-}
-
-// HasIsAdmin returns a boolean if a field has been set.
-func (c *CreatedBy) HasIsAdmin() bool {
-	if c != nil && c.IsAdmin != nil {
-		return true
-	}
-
-	return false
-}
-
-// SetIsAdmin allocates a new c.IsAdmin and returns the pointer to it.
-func (c *CreatedBy) SetIsAdmin(v bool) {
-	c.IsAdmin = &v
+// SetCheck allocates a new c.Check and returns the pointer to it.
+func (c *Check) SetCheck(v string) {
+	c.Check = &v
 }
 
-// GetName returns the Name field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetName() string {
-	if c == nil || c.Name == nil {
+// GetHostName returns the HostName field if non-nil, zero value otherwise.
+func (c *Check) GetHostName() string {
+	if c == nil || c.HostName == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.HostName
 }
 
-// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// GetHostNameOk returns a tuple with the HostName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetNameOk() (string, bool) {
-	if c == nil || c.Name == nil {
+func (c *Check) GetHostNameOk() (string, bool) {
+	if c == nil || c.HostName == nil {
 		return "", false
 	}
-	return *c.Name, true
+	return *c.HostName, true
 }
 
-// HasName returns a boolean if a field has been set.
-func (c *CreatedBy) HasName() bool {
-	if c != nil && c.Name != nil {
+// HasHostName returns a boolean if a field has been set.
+func (c *Check) HasHostName() bool {
+	if c != nil && c.HostName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new c.Name and returns the pointer to it.
-func (c *CreatedBy) SetName(v string) {
-	c.Name = &v
+// SetHostName allocates a new c.HostName and returns the pointer to it.
+func (c *Check) SetHostName(v string) {
+	c.HostName = &v
 }
 
-// GetRole returns the Role field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetRole() string {
-	if c == nil || c.Role == nil {
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (c *Check) GetMessage() string {
+	if c == nil || c.Message == nil {
 		return ""
 	}
-	return *c.Role
+	return *c.Message
 }
 
-// GetRoleOk returns a tuple with the Role field if it's non-nil, zero value otherwise
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetRoleOk() (string, bool) {
-	if c == nil || c.Role == nil {
+func (c *Check) GetMessageOk() (string, bool) {
+	if c == nil || c.Message == nil {
 		return "", false
 	}
-	return *c.Role, true
+	return *c.Message, true
 }
 
-// HasRole returns a boolean if a field has been set.
-func (c *CreatedBy) HasRole() bool {
-	if c != nil && c.Role != nil {
+// HasMessage returns a boolean if a field has been set.
+func (c *Check) HasMessage() bool {
+	if c != nil && c.Message != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRole allocates a new c.Role and returns the pointer to it.
-func (c *CreatedBy) SetRole(v string) {
-	c.Role = &v
+// SetMessage allocates a new c.Message and returns the pointer to it.
+func (c *Check) SetMessage(v string) {
+	c.Message = &v
 }
 
-// GetVerified returns the Verified field if non-nil, zero value otherwise.
-func (c *CreatedBy) GetVerified() bool {
-	if c == nil || c.Verified == nil {
-		return false
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (c *Check) GetStatus() Status {
+	if c == nil || c.Status == nil {
+		return 0
 	}
-	return *c.Verified
+	return *c.Status
 }
 
-// GetVerifiedOk returns a tuple with the Verified field if it's non-nil, zero value otherwise
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *CreatedBy) GetVerifiedOk() (bool, bool) {
-	if c == nil || c.Verified == nil {
-		return false, false
+func (c *Check) GetStatusOk() (Status, bool) {
+	if c == nil || c.Status == nil {
+		return 0, false
 	}
-	return *c.Verified, true
+	return *c.Status, true
 }
 
-// HasVerified returns a boolean if a field has been set.
-func (c *CreatedBy) HasVerified() bool {
-	if c != nil && c.Verified != nil {
+// HasStatus returns a boolean if a field has been set.
+func (c *Check) HasStatus() bool {
+	if c != nil && c.Status != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetVerified allocates a new c.Verified and returns the pointer to it.
-func (c *CreatedBy) SetVerified(v bool) {
-	c.Verified = &v
+// SetStatus allocates a new c.Status and returns the pointer to it.
+func (c *Check) SetStatus(v Status) {
+	c.Status = &v
 }
 
-// GetEmail returns the Email field if non-nil, zero value otherwise.
-func (c *Creator) GetEmail() string {
-	if c == nil || c.Email == nil {
+// GetTimestamp returns the Timestamp field if non-nil, zero value otherwise.
+func (c *Check) GetTimestamp() string {
+	if c == nil || c.Timestamp == nil {
 		return ""
 	}
-	return *c.Email
+	return *c.Timestamp
 }
 
-// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
+// GetTimestampOk returns a tuple with the Timestamp field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Creator) GetEmailOk() (string, bool) {
-	if c == nil || c.Email == nil {
+func (c *Check) GetTimestampOk() (string, bool) {
+	if c == nil || c.Timestamp == nil {
 		return "", false
 	}
-	return *c.Email, true
+	return *c.Timestamp, true
 }
 
-// HasEmail returns a boolean if a field has been set.
-func (c *Creator) HasEmail() bool {
-	if c != nil && c.Email != nil {
+// HasTimestamp returns a boolean if a field has been set.
+func (c *Check) HasTimestamp() bool {
+	if c != nil && c.Timestamp != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEmail allocates a new c.Email and returns the pointer to it.
-func (c *Creator) SetEmail(v string) {
-	c.Email = &v
+// SetTimestamp allocates a new c.Timestamp and returns the pointer to it.
+func (c *Check) SetTimestamp(v string) {
+	c.Timestamp = &v
 }
 
 // GetHandle returns the Handle field if non-nil, zero value otherwise.
-func (c *Creator) GetHandle() string {
+func (c *Comment) GetHandle() string {
 	if c == nil || c.Handle == nil {
 		return ""
 	}
@@ -1201,7 +1170,7 @@ func (c *Creator) GetHandle() string {
 
 // GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Creator) GetHandleOk() (string, bool) {
+func (c *Comment) GetHandleOk() (string, bool) {
 	if c == nil || c.Handle == nil {
 		return "", false
 	}
@@ -1209,7 +1178,7 @@ func (c *Creator) GetHandleOk() (string, bool) {
 }
 
 // HasHandle returns a boolean if a field has been set.
-func (c *Creator) HasHandle() bool {
+func (c *Comment) HasHandle() bool {
 	if c != nil && c.Handle != nil {
 		return true
 	}
@@ -1218,12 +1187,12 @@ func (c *Creator) HasHandle() bool {
 }
 
 // SetHandle allocates a new c.Handle and returns the pointer to it.
-func (c *Creator) SetHandle(v string) {
+func (c *Comment) SetHandle(v string) {
 	c.Handle = &v
 }
 
 // GetId returns the Id field if non-nil, zero value otherwise.
-func (c *Creator) GetId() int {
+func (c *Comment) GetId() int {
 	if c == nil || c.Id == nil {
 		return 0
 	}
@@ -1232,7 +1201,7 @@ func (c *Creator) GetId() int {
 
 // GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Creator) GetIdOk() (int, bool) {
+func (c *Comment) GetIdOk() (int, bool) {
 	if c == nil || c.Id == nil {
 		return 0, false
 	}
@@ -1240,7 +1209,7 @@ func (c *Creator) GetIdOk() (int, bool) {
 }
 
 // HasId returns a boolean if a field has been set.
-func (c *Creator) HasId() bool {
+func (c *Comment) HasId() bool {
 	if c != nil && c.Id != nil {
 		return true
 	}
@@ -1249,7851 +1218,15353 @@ func (c *Creator) HasId() bool {
 }
 
 // SetId allocates a new c.Id and returns the pointer to it.
-func (c *Creator) SetId(v int) {
+func (c *Comment) SetId(v int) {
 	c.Id = &v
 }
 
-// GetName returns the Name field if non-nil, zero value otherwise.
-func (c *Creator) GetName() string {
-	if c == nil || c.Name == nil {
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (c *Comment) GetMessage() string {
+	if c == nil || c.Message == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.Message
 }
 
-// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (c *Creator) GetNameOk() (string, bool) {
-	if c == nil || c.Name == nil {
+func (c *Comment) GetMessageOk() (string, bool) {
+	if c == nil || c.Message == nil {
 		return "", false
 	}
-	return *c.Name, true
+	return *c.Message, true
 }
 
-// HasName returns a boolean if a field has been set.
-func (c *Creator) HasName() bool {
-	if c != nil && c.Name != nil {
+// HasMessage returns a boolean if a field has been set.
+func (c *Comment) HasMessage() bool {
+	if c != nil && c.Message != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new c.Name and returns the pointer to it.
-func (c *Creator) SetName(v string) {
-	c.Name = &v
+// SetMessage allocates a new c.Message and returns the pointer to it.
+func (c *Comment) SetMessage(v string) {
+	c.Message = &v
 }
 
-// GetDescription returns the Description field if non-nil, zero value otherwise.
-func (d *Dashboard) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetRelatedId returns the RelatedId field if non-nil, zero value otherwise.
+func (c *Comment) GetRelatedId() int {
+	if c == nil || c.RelatedId == nil {
+		return 0
 	}
-	return *d.Description
+	return *c.RelatedId
 }
 
-// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
+// GetRelatedIdOk returns a tuple with the RelatedId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Dashboard) GetDescriptionOk() (string, bool) {
-	if d == nil || d.Description == nil {
-		return "", false
+func (c *Comment) GetRelatedIdOk() (int, bool) {
+	if c == nil || c.RelatedId == nil {
+		return 0, false
 	}
-	return *d.Description, true
+	return *c.RelatedId, true
 }
 
-// HasDescription returns a boolean if a field has been set.
-func (d *Dashboard) HasDescription() bool {
-	if d != nil && d.Description != nil {
+// HasRelatedId returns a boolean if a field has been set.
+func (c *Comment) HasRelatedId() bool {
+	if c != nil && c.RelatedId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDescription allocates a new d.Description and returns the pointer to it.
-func (d *Dashboard) SetDescription(v string) {
-	d.Description = &v
+// SetRelatedId allocates a new c.RelatedId and returns the pointer to it.
+func (c *Comment) SetRelatedId(v int) {
+	c.RelatedId = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (d *Dashboard) GetId() int {
-	if d == nil || d.Id == nil {
-		return 0
+// GetResource returns the Resource field if non-nil, zero value otherwise.
+func (c *Comment) GetResource() string {
+	if c == nil || c.Resource == nil {
+		return ""
 	}
-	return *d.Id
+	return *c.Resource
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Dashboard) GetIdOk() (int, bool) {
-	if d == nil || d.Id == nil {
-		return 0, false
+func (c *Comment) GetResourceOk() (string, bool) {
+	if c == nil || c.Resource == nil {
+		return "", false
 	}
-	return *d.Id, true
+	return *c.Resource, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (d *Dashboard) HasId() bool {
-	if d != nil && d.Id != nil {
+// HasResource returns a boolean if a field has been set.
+func (c *Comment) HasResource() bool {
+	if c != nil && c.Resource != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new d.Id and returns the pointer to it.
-func (d *Dashboard) SetId(v int) {
-	d.Id = &v
-}
-
-// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
-func (d *Dashboard) GetReadOnly() bool {
-	if d == nil || d.ReadOnly == nil {
-		return false
+// SetResource allocates a new c.Resource and returns the pointer to it.
+func (c *Comment) SetResource(v string) {
+	c.Resource = &v
+}
+
+// GetUrl returns the Url field if non-nil, zero value otherwise.
+func (c *Comment) GetUrl() string {
+	if c == nil || c.Url == nil {
+		return ""
 	}
-	return *d.ReadOnly
+	return *c.Url
 }
 
-// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
+// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Dashboard) GetReadOnlyOk() (bool, bool) {
-	if d == nil || d.ReadOnly == nil {
-		return false, false
+func (c *Comment) GetUrlOk() (string, bool) {
+	if c == nil || c.Url == nil {
+		return "", false
 	}
-	return *d.ReadOnly, true
+	return *c.Url, true
 }
 
-// HasReadOnly returns a boolean if a field has been set.
-func (d *Dashboard) HasReadOnly() bool {
-	if d != nil && d.ReadOnly != nil {
+// HasUrl returns a boolean if a field has been set.
+func (c *Comment) HasUrl() bool {
+	if c != nil && c.Url != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetReadOnly allocates a new d.ReadOnly and returns the pointer to it.
-func (d *Dashboard) SetReadOnly(v bool) {
-	d.ReadOnly = &v
+// SetUrl allocates a new c.Url and returns the pointer to it.
+func (c *Comment) SetUrl(v string) {
+	c.Url = &v
 }
 
-// GetTitle returns the Title field if non-nil, zero value otherwise.
-func (d *Dashboard) GetTitle() string {
-	if d == nil || d.Title == nil {
+// GetColor returns the Color field if non-nil, zero value otherwise.
+func (c *ConditionalFormat) GetColor() string {
+	if c == nil || c.Color == nil {
 		return ""
 	}
-	return *d.Title
+	return *c.Color
 }
 
-// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// GetColorOk returns a tuple with the Color field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Dashboard) GetTitleOk() (string, bool) {
-	if d == nil || d.Title == nil {
+func (c *ConditionalFormat) GetColorOk() (string, bool) {
+	if c == nil || c.Color == nil {
 		return "", false
 	}
-	return *d.Title, true
+	return *c.Color, true
 }
 
-// HasTitle returns a boolean if a field has been set.
-func (d *Dashboard) HasTitle() bool {
-	if d != nil && d.Title != nil {
+// HasColor returns a boolean if a field has been set.
+func (c *ConditionalFormat) HasColor() bool {
+	if c != nil && c.Color != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new d.Title and returns the pointer to it.
-func (d *Dashboard) SetTitle(v string) {
-	d.Title = &v
+// SetColor allocates a new c.Color and returns the pointer to it.
+func (c *ConditionalFormat) SetColor(v string) {
+	c.Color = &v
 }
 
 // GetComparator returns the Comparator field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetComparator() string {
-	if d == nil || d.Comparator == nil {
+func (c *ConditionalFormat) GetComparator() string {
+	if c == nil || c.Comparator == nil {
 		return ""
 	}
-	return *d.Comparator
+	return *c.Comparator
 }
 
 // GetComparatorOk returns a tuple with the Comparator field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetComparatorOk() (string, bool) {
-	if d == nil || d.Comparator == nil {
+func (c *ConditionalFormat) GetComparatorOk() (string, bool) {
+	if c == nil || c.Comparator == nil {
 		return "", false
 	}
-	return *d.Comparator, true
+	return *c.Comparator, true
 }
 
 // HasComparator returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasComparator() bool {
-	if d != nil && d.Comparator != nil {
+func (c *ConditionalFormat) HasComparator() bool {
+	if c != nil && c.Comparator != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetComparator allocates a new d.Comparator and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetComparator(v string) {
-	d.Comparator = &v
+// SetComparator allocates a new c.Comparator and returns the pointer to it.
+func (c *ConditionalFormat) SetComparator(v string) {
+	c.Comparator = &v
 }
 
-// GetCustomBgColor returns the CustomBgColor field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetCustomBgColor() string {
-	if d == nil || d.CustomBgColor == nil {
+// GetImageURL returns the ImageURL field if non-nil, zero value otherwise.
+func (c *ConditionalFormat) GetImageURL() string {
+	if c == nil || c.ImageURL == nil {
 		return ""
 	}
-	return *d.CustomBgColor
+	return *c.ImageURL
 }
 
-// GetCustomBgColorOk returns a tuple with the CustomBgColor field if it's non-nil, zero value otherwise
+// GetImageURLOk returns a tuple with the ImageURL field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetCustomBgColorOk() (string, bool) {
-	if d == nil || d.CustomBgColor == nil {
+func (c *ConditionalFormat) GetImageURLOk() (string, bool) {
+	if c == nil || c.ImageURL == nil {
 		return "", false
 	}
-	return *d.CustomBgColor, true
+	return *c.ImageURL, true
 }
 
-// HasCustomBgColor returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasCustomBgColor() bool {
-	if d != nil && d.CustomBgColor != nil {
+// HasImageURL returns a boolean if a field has been set.
+func (c *ConditionalFormat) HasImageURL() bool {
+	if c != nil && c.ImageURL != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCustomBgColor allocates a new d.CustomBgColor and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetCustomBgColor(v string) {
-	d.CustomBgColor = &v
+// SetImageURL allocates a new c.ImageURL and returns the pointer to it.
+func (c *ConditionalFormat) SetImageURL(v string) {
+	c.ImageURL = &v
 }
 
-// GetCustomFgColor returns the CustomFgColor field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetCustomFgColor() string {
-	if d == nil || d.CustomFgColor == nil {
-		return ""
+// GetInvert returns the Invert field if non-nil, zero value otherwise.
+func (c *ConditionalFormat) GetInvert() bool {
+	if c == nil || c.Invert == nil {
+		return false
 	}
-	return *d.CustomFgColor
+	return *c.Invert
 }
 
-// GetCustomFgColorOk returns a tuple with the CustomFgColor field if it's non-nil, zero value otherwise
+// GetInvertOk returns a tuple with the Invert field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetCustomFgColorOk() (string, bool) {
-	if d == nil || d.CustomFgColor == nil {
-		return "", false
+func (c *ConditionalFormat) GetInvertOk() (bool, bool) {
+	if c == nil || c.Invert == nil {
+		return false, false
 	}
-	return *d.CustomFgColor, true
+	return *c.Invert, true
 }
 
-// HasCustomFgColor returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasCustomFgColor() bool {
-	if d != nil && d.CustomFgColor != nil {
+// HasInvert returns a boolean if a field has been set.
+func (c *ConditionalFormat) HasInvert() bool {
+	if c != nil && c.Invert != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCustomFgColor allocates a new d.CustomFgColor and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetCustomFgColor(v string) {
-	d.CustomFgColor = &v
+// SetInvert allocates a new c.Invert and returns the pointer to it.
+func (c *ConditionalFormat) SetInvert(v bool) {
+	c.Invert = &v
 }
 
-// GetCustomImageUrl returns the CustomImageUrl field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetCustomImageUrl() string {
-	if d == nil || d.CustomImageUrl == nil {
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (c *ConditionalFormat) GetPalette() string {
+	if c == nil || c.Palette == nil {
 		return ""
 	}
-	return *d.CustomImageUrl
+	return *c.Palette
 }
 
-// GetCustomImageUrlOk returns a tuple with the CustomImageUrl field if it's non-nil, zero value otherwise
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetCustomImageUrlOk() (string, bool) {
-	if d == nil || d.CustomImageUrl == nil {
+func (c *ConditionalFormat) GetPaletteOk() (string, bool) {
+	if c == nil || c.Palette == nil {
 		return "", false
 	}
-	return *d.CustomImageUrl, true
+	return *c.Palette, true
 }
 
-// HasCustomImageUrl returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasCustomImageUrl() bool {
-	if d != nil && d.CustomImageUrl != nil {
+// HasPalette returns a boolean if a field has been set.
+func (c *ConditionalFormat) HasPalette() bool {
+	if c != nil && c.Palette != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCustomImageUrl allocates a new d.CustomImageUrl and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetCustomImageUrl(v string) {
-	d.CustomImageUrl = &v
+// SetPalette allocates a new c.Palette and returns the pointer to it.
+func (c *ConditionalFormat) SetPalette(v string) {
+	c.Palette = &v
 }
 
-// GetInverted returns the Inverted field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetInverted() bool {
-	if d == nil || d.Inverted == nil {
-		return false
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (c *ConditionalFormat) GetValue() string {
+	if c == nil || c.Value == nil {
+		return ""
 	}
-	return *d.Inverted
+	return *c.Value
 }
 
-// GetInvertedOk returns a tuple with the Inverted field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetInvertedOk() (bool, bool) {
-	if d == nil || d.Inverted == nil {
-		return false, false
+func (c *ConditionalFormat) GetValueOk() (string, bool) {
+	if c == nil || c.Value == nil {
+		return "", false
 	}
-	return *d.Inverted, true
+	return *c.Value, true
 }
 
-// HasInverted returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasInverted() bool {
-	if d != nil && d.Inverted != nil {
+// HasValue returns a boolean if a field has been set.
+func (c *ConditionalFormat) HasValue() bool {
+	if c != nil && c.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetInverted allocates a new d.Inverted and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetInverted(v bool) {
-	d.Inverted = &v
+// SetValue allocates a new c.Value and returns the pointer to it.
+func (c *ConditionalFormat) SetValue(v string) {
+	c.Value = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetPalette() string {
-	if d == nil || d.Palette == nil {
+// GetAccessRole returns the AccessRole field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetAccessRole() string {
+	if c == nil || c.AccessRole == nil {
 		return ""
 	}
-	return *d.Palette
+	return *c.AccessRole
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetAccessRoleOk returns a tuple with the AccessRole field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetPaletteOk() (string, bool) {
-	if d == nil || d.Palette == nil {
+func (c *CreatedBy) GetAccessRoleOk() (string, bool) {
+	if c == nil || c.AccessRole == nil {
 		return "", false
 	}
-	return *d.Palette, true
+	return *c.AccessRole, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasPalette() bool {
-	if d != nil && d.Palette != nil {
+// HasAccessRole returns a boolean if a field has been set.
+func (c *CreatedBy) HasAccessRole() bool {
+	if c != nil && c.AccessRole != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new d.Palette and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetPalette(v string) {
-	d.Palette = &v
+// SetAccessRole allocates a new c.AccessRole and returns the pointer to it.
+func (c *CreatedBy) SetAccessRole(v string) {
+	c.AccessRole = &v
 }
 
-// GetValue returns the Value field if non-nil, zero value otherwise.
-func (d *DashboardConditionalFormat) GetValue() json.Number {
-	if d == nil || d.Value == nil {
-		return ""
+// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetDisabled() bool {
+	if c == nil || c.Disabled == nil {
+		return false
 	}
-	return *d.Value
+	return *c.Disabled
 }
 
-// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
+// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardConditionalFormat) GetValueOk() (json.Number, bool) {
-	if d == nil || d.Value == nil {
-		return "", false
+func (c *CreatedBy) GetDisabledOk() (bool, bool) {
+	if c == nil || c.Disabled == nil {
+		return false, false
 	}
-	return *d.Value, true
+	return *c.Disabled, true
 }
 
-// HasValue returns a boolean if a field has been set.
-func (d *DashboardConditionalFormat) HasValue() bool {
-	if d != nil && d.Value != nil {
+// HasDisabled returns a boolean if a field has been set.
+func (c *CreatedBy) HasDisabled() bool {
+	if c != nil && c.Disabled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetValue allocates a new d.Value and returns the pointer to it.
-func (d *DashboardConditionalFormat) SetValue(v json.Number) {
-	d.Value = &v
+// SetDisabled allocates a new c.Disabled and returns the pointer to it.
+func (c *CreatedBy) SetDisabled(v bool) {
+	c.Disabled = &v
 }
 
-// GetDashboardCount returns the DashboardCount field if non-nil, zero value otherwise.
-func (d *DashboardList) GetDashboardCount() int {
-	if d == nil || d.DashboardCount == nil {
-		return 0
+// GetEmail returns the Email field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
 	}
-	return *d.DashboardCount
+	return *c.Email
 }
 
-// GetDashboardCountOk returns a tuple with the DashboardCount field if it's non-nil, zero value otherwise
+// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardList) GetDashboardCountOk() (int, bool) {
-	if d == nil || d.DashboardCount == nil {
-		return 0, false
+func (c *CreatedBy) GetEmailOk() (string, bool) {
+	if c == nil || c.Email == nil {
+		return "", false
 	}
-	return *d.DashboardCount, true
+	return *c.Email, true
 }
 
-// HasDashboardCount returns a boolean if a field has been set.
-func (d *DashboardList) HasDashboardCount() bool {
-	if d != nil && d.DashboardCount != nil {
+// HasEmail returns a boolean if a field has been set.
+func (c *CreatedBy) HasEmail() bool {
+	if c != nil && c.Email != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDashboardCount allocates a new d.DashboardCount and returns the pointer to it.
-func (d *DashboardList) SetDashboardCount(v int) {
-	d.DashboardCount = &v
+// SetEmail allocates a new c.Email and returns the pointer to it.
+func (c *CreatedBy) SetEmail(v string) {
+	c.Email = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (d *DashboardList) GetId() int {
-	if d == nil || d.Id == nil {
-		return 0
+// GetHandle returns the Handle field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetHandle() string {
+	if c == nil || c.Handle == nil {
+		return ""
 	}
-	return *d.Id
+	return *c.Handle
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardList) GetIdOk() (int, bool) {
-	if d == nil || d.Id == nil {
-		return 0, false
+func (c *CreatedBy) GetHandleOk() (string, bool) {
+	if c == nil || c.Handle == nil {
+		return "", false
 	}
-	return *d.Id, true
+	return *c.Handle, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (d *DashboardList) HasId() bool {
-	if d != nil && d.Id != nil {
+// HasHandle returns a boolean if a field has been set.
+func (c *CreatedBy) HasHandle() bool {
+	if c != nil && c.Handle != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new d.Id and returns the pointer to it.
-func (d *DashboardList) SetId(v int) {
-	d.Id = &v
+// SetHandle allocates a new c.Handle and returns the pointer to it.
+func (c *CreatedBy) SetHandle(v string) {
+	c.Handle = &v
 }
 
-// GetName returns the Name field if non-nil, zero value otherwise.
-func (d *DashboardList) GetName() string {
-	if d == nil || d.Name == nil {
+// GetIcon returns the Icon field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetIcon() string {
+	if c == nil || c.Icon == nil {
 		return ""
 	}
-	return *d.Name
+	return *c.Icon
 }
 
-// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// GetIconOk returns a tuple with the Icon field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardList) GetNameOk() (string, bool) {
-	if d == nil || d.Name == nil {
+func (c *CreatedBy) GetIconOk() (string, bool) {
+	if c == nil || c.Icon == nil {
 		return "", false
 	}
-	return *d.Name, true
+	return *c.Icon, true
 }
 
-// HasName returns a boolean if a field has been set.
-func (d *DashboardList) HasName() bool {
-	if d != nil && d.Name != nil {
+// HasIcon returns a boolean if a field has been set.
+func (c *CreatedBy) HasIcon() bool {
+	if c != nil && c.Icon != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new d.Name and returns the pointer to it.
-func (d *DashboardList) SetName(v string) {
-	d.Name = &v
+// SetIcon allocates a new c.Icon and returns the pointer to it.
+func (c *CreatedBy) SetIcon(v string) {
+	c.Icon = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (d *DashboardListItem) GetId() int {
-	if d == nil || d.Id == nil {
-		return 0
+// GetIsAdmin returns the IsAdmin field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetIsAdmin() bool {
+	if c == nil || c.IsAdmin == nil {
+		return false
 	}
-	return *d.Id
+	return *c.IsAdmin
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetIsAdminOk returns a tuple with the IsAdmin field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardListItem) GetIdOk() (int, bool) {
-	if d == nil || d.Id == nil {
-		return 0, false
+func (c *CreatedBy) GetIsAdminOk() (bool, bool) {
+	if c == nil || c.IsAdmin == nil {
+		return false, false
 	}
-	return *d.Id, true
+	return *c.IsAdmin, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (d *DashboardListItem) HasId() bool {
-	if d != nil && d.Id != nil {
+// HasIsAdmin returns a boolean if a field has been set.
+func (c *CreatedBy) HasIsAdmin() bool {
+	if c != nil && c.IsAdmin != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new d.Id and returns the pointer to it.
-func (d *DashboardListItem) SetId(v int) {
-	d.Id = &v
+// SetIsAdmin allocates a new c.IsAdmin and returns the pointer to it.
+func (c *CreatedBy) SetIsAdmin(v bool) {
+	c.IsAdmin = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (d *DashboardListItem) GetType() string {
-	if d == nil || d.Type == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *d.Type
+	return *c.Name
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardListItem) GetTypeOk() (string, bool) {
-	if d == nil || d.Type == nil {
+func (c *CreatedBy) GetNameOk() (string, bool) {
+	if c == nil || c.Name == nil {
 		return "", false
 	}
-	return *d.Type, true
+	return *c.Name, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (d *DashboardListItem) HasType() bool {
-	if d != nil && d.Type != nil {
+// HasName returns a boolean if a field has been set.
+func (c *CreatedBy) HasName() bool {
+	if c != nil && c.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new d.Type and returns the pointer to it.
-func (d *DashboardListItem) SetType(v string) {
-	d.Type = &v
+// SetName allocates a new c.Name and returns the pointer to it.
+func (c *CreatedBy) SetName(v string) {
+	c.Name = &v
 }
 
-// GetCreated returns the Created field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetCreated() string {
-	if d == nil || d.Created == nil {
+// GetRole returns the Role field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetRole() string {
+	if c == nil || c.Role == nil {
 		return ""
 	}
-	return *d.Created
+	return *c.Role
 }
 
-// GetCreatedOk returns a tuple with the Created field if it's non-nil, zero value otherwise
+// GetRoleOk returns a tuple with the Role field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetCreatedOk() (string, bool) {
-	if d == nil || d.Created == nil {
+func (c *CreatedBy) GetRoleOk() (string, bool) {
+	if c == nil || c.Role == nil {
 		return "", false
 	}
-	return *d.Created, true
+	return *c.Role, true
 }
 
-// HasCreated returns a boolean if a field has been set.
-func (d *DashboardLite) HasCreated() bool {
-	if d != nil && d.Created != nil {
+// HasRole returns a boolean if a field has been set.
+func (c *CreatedBy) HasRole() bool {
+	if c != nil && c.Role != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCreated allocates a new d.Created and returns the pointer to it.
-func (d *DashboardLite) SetCreated(v string) {
-	d.Created = &v
+// SetRole allocates a new c.Role and returns the pointer to it.
+func (c *CreatedBy) SetRole(v string) {
+	c.Role = &v
 }
 
-// GetCreatedBy returns the CreatedBy field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetCreatedBy() CreatedBy {
-	if d == nil || d.CreatedBy == nil {
-		return CreatedBy{}
+// GetVerified returns the Verified field if non-nil, zero value otherwise.
+func (c *CreatedBy) GetVerified() bool {
+	if c == nil || c.Verified == nil {
+		return false
 	}
-	return *d.CreatedBy
+	return *c.Verified
 }
 
-// GetCreatedByOk returns a tuple with the CreatedBy field if it's non-nil, zero value otherwise
+// GetVerifiedOk returns a tuple with the Verified field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetCreatedByOk() (CreatedBy, bool) {
-	if d == nil || d.CreatedBy == nil {
-		return CreatedBy{}, false
+func (c *CreatedBy) GetVerifiedOk() (bool, bool) {
+	if c == nil || c.Verified == nil {
+		return false, false
 	}
-	return *d.CreatedBy, true
+	return *c.Verified, true
 }
 
-// HasCreatedBy returns a boolean if a field has been set.
-func (d *DashboardLite) HasCreatedBy() bool {
-	if d != nil && d.CreatedBy != nil {
+// HasVerified returns a boolean if a field has been set.
+func (c *CreatedBy) HasVerified() bool {
+	if c != nil && c.Verified != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCreatedBy allocates a new d.CreatedBy and returns the pointer to it.
-func (d *DashboardLite) SetCreatedBy(v CreatedBy) {
-	d.CreatedBy = &v
+// SetVerified allocates a new c.Verified and returns the pointer to it.
+func (c *CreatedBy) SetVerified(v bool) {
+	c.Verified = &v
 }
 
-// GetDescription returns the Description field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetEmail returns the Email field if non-nil, zero value otherwise.
+func (c *Creator) GetEmail() string {
+	if c == nil || c.Email == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.Email
 }
 
-// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
+// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetDescriptionOk() (string, bool) {
-	if d == nil || d.Description == nil {
+func (c *Creator) GetEmailOk() (string, bool) {
+	if c == nil || c.Email == nil {
 		return "", false
 	}
-	return *d.Description, true
+	return *c.Email, true
 }
 
-// HasDescription returns a boolean if a field has been set.
-func (d *DashboardLite) HasDescription() bool {
-	if d != nil && d.Description != nil {
+// HasEmail returns a boolean if a field has been set.
+func (c *Creator) HasEmail() bool {
+	if c != nil && c.Email != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDescription allocates a new d.Description and returns the pointer to it.
-func (d *DashboardLite) SetDescription(v string) {
-	d.Description = &v
+// SetEmail allocates a new c.Email and returns the pointer to it.
+func (c *Creator) SetEmail(v string) {
+	c.Email = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetId() int {
-	if d == nil || d.Id == nil {
-		return 0
-	}
-	return *d.Id
+// GetHandle returns the Handle field if non-nil, zero value otherwise.
+func (c *Creator) GetHandle() string {
+	if c == nil || c.Handle == nil {
+		return ""
+	}
+	return *c.Handle
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetIdOk() (int, bool) {
-	if d == nil || d.Id == nil {
-		return 0, false
+func (c *Creator) GetHandleOk() (string, bool) {
+	if c == nil || c.Handle == nil {
+		return "", false
 	}
-	return *d.Id, true
+	return *c.Handle, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (d *DashboardLite) HasId() bool {
-	if d != nil && d.Id != nil {
+// HasHandle returns a boolean if a field has been set.
+func (c *Creator) HasHandle() bool {
+	if c != nil && c.Handle != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new d.Id and returns the pointer to it.
-func (d *DashboardLite) SetId(v int) {
-	d.Id = &v
+// SetHandle allocates a new c.Handle and returns the pointer to it.
+func (c *Creator) SetHandle(v string) {
+	c.Handle = &v
 }
 
-// GetModified returns the Modified field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetModified() string {
-	if d == nil || d.Modified == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (c *Creator) GetId() int {
+	if c == nil || c.Id == nil {
+		return 0
 	}
-	return *d.Modified
+	return *c.Id
 }
 
-// GetModifiedOk returns a tuple with the Modified field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetModifiedOk() (string, bool) {
-	if d == nil || d.Modified == nil {
-		return "", false
+func (c *Creator) GetIdOk() (int, bool) {
+	if c == nil || c.Id == nil {
+		return 0, false
 	}
-	return *d.Modified, true
+	return *c.Id, true
 }
 
-// HasModified returns a boolean if a field has been set.
-func (d *DashboardLite) HasModified() bool {
-	if d != nil && d.Modified != nil {
+// HasId returns a boolean if a field has been set.
+func (c *Creator) HasId() bool {
+	if c != nil && c.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetModified allocates a new d.Modified and returns the pointer to it.
-func (d *DashboardLite) SetModified(v string) {
-	d.Modified = &v
+// SetId allocates a new c.Id and returns the pointer to it.
+func (c *Creator) SetId(v int) {
+	c.Id = &v
 }
 
-// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetReadOnly() bool {
-	if d == nil || d.ReadOnly == nil {
-		return false
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (c *Creator) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *d.ReadOnly
+	return *c.Name
 }
 
-// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetReadOnlyOk() (bool, bool) {
-	if d == nil || d.ReadOnly == nil {
-		return false, false
+func (c *Creator) GetNameOk() (string, bool) {
+	if c == nil || c.Name == nil {
+		return "", false
 	}
-	return *d.ReadOnly, true
+	return *c.Name, true
 }
 
-// HasReadOnly returns a boolean if a field has been set.
-func (d *DashboardLite) HasReadOnly() bool {
-	if d != nil && d.ReadOnly != nil {
+// HasName returns a boolean if a field has been set.
+func (c *Creator) HasName() bool {
+	if c != nil && c.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetReadOnly allocates a new d.ReadOnly and returns the pointer to it.
-func (d *DashboardLite) SetReadOnly(v bool) {
-	d.ReadOnly = &v
+// SetName allocates a new c.Name and returns the pointer to it.
+func (c *Creator) SetName(v string) {
+	c.Name = &v
 }
 
-// GetResource returns the Resource field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetResource() string {
-	if d == nil || d.Resource == nil {
+// GetDescription returns the Description field if non-nil, zero value otherwise.
+func (d *Dashboard) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *d.Resource
+	return *d.Description
 }
 
-// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetResourceOk() (string, bool) {
-	if d == nil || d.Resource == nil {
+func (d *Dashboard) GetDescriptionOk() (string, bool) {
+	if d == nil || d.Description == nil {
 		return "", false
 	}
-	return *d.Resource, true
+	return *d.Description, true
 }
 
-// HasResource returns a boolean if a field has been set.
-func (d *DashboardLite) HasResource() bool {
-	if d != nil && d.Resource != nil {
+// HasDescription returns a boolean if a field has been set.
+func (d *Dashboard) HasDescription() bool {
+	if d != nil && d.Description != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetResource allocates a new d.Resource and returns the pointer to it.
-func (d *DashboardLite) SetResource(v string) {
-	d.Resource = &v
+// SetDescription allocates a new d.Description and returns the pointer to it.
+func (d *Dashboard) SetDescription(v string) {
+	d.Description = &v
 }
 
-// GetTitle returns the Title field if non-nil, zero value otherwise.
-func (d *DashboardLite) GetTitle() string {
-	if d == nil || d.Title == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (d *Dashboard) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
 	}
-	return *d.Title
+	return *d.Id
 }
 
-// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *DashboardLite) GetTitleOk() (string, bool) {
-	if d == nil || d.Title == nil {
-		return "", false
+func (d *Dashboard) GetIdOk() (int, bool) {
+	if d == nil || d.Id == nil {
+		return 0, false
 	}
-	return *d.Title, true
+	return *d.Id, true
 }
 
-// HasTitle returns a boolean if a field has been set.
-func (d *DashboardLite) HasTitle() bool {
-	if d != nil && d.Title != nil {
+// HasId returns a boolean if a field has been set.
+func (d *Dashboard) HasId() bool {
+	if d != nil && d.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new d.Title and returns the pointer to it.
-func (d *DashboardLite) SetTitle(v string) {
-	d.Title = &v
+// SetId allocates a new d.Id and returns the pointer to it.
+func (d *Dashboard) SetId(v int) {
+	d.Id = &v
 }
 
-// GetActive returns the Active field if non-nil, zero value otherwise.
-func (d *Downtime) GetActive() bool {
-	if d == nil || d.Active == nil {
+// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
+func (d *Dashboard) GetReadOnly() bool {
+	if d == nil || d.ReadOnly == nil {
 		return false
 	}
-	return *d.Active
+	return *d.ReadOnly
 }
 
-// GetActiveOk returns a tuple with the Active field if it's non-nil, zero value otherwise
+// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetActiveOk() (bool, bool) {
-	if d == nil || d.Active == nil {
+func (d *Dashboard) GetReadOnlyOk() (bool, bool) {
+	if d == nil || d.ReadOnly == nil {
 		return false, false
 	}
-	return *d.Active, true
+	return *d.ReadOnly, true
 }
 
-// HasActive returns a boolean if a field has been set.
-func (d *Downtime) HasActive() bool {
-	if d != nil && d.Active != nil {
+// HasReadOnly returns a boolean if a field has been set.
+func (d *Dashboard) HasReadOnly() bool {
+	if d != nil && d.ReadOnly != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetActive allocates a new d.Active and returns the pointer to it.
-func (d *Downtime) SetActive(v bool) {
-	d.Active = &v
+// SetReadOnly allocates a new d.ReadOnly and returns the pointer to it.
+func (d *Dashboard) SetReadOnly(v bool) {
+	d.ReadOnly = &v
 }
 
-// GetCanceled returns the Canceled field if non-nil, zero value otherwise.
-func (d *Downtime) GetCanceled() int {
-	if d == nil || d.Canceled == nil {
-		return 0
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (d *Dashboard) GetTitle() string {
+	if d == nil || d.Title == nil {
+		return ""
 	}
-	return *d.Canceled
+	return *d.Title
 }
 
-// GetCanceledOk returns a tuple with the Canceled field if it's non-nil, zero value otherwise
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetCanceledOk() (int, bool) {
-	if d == nil || d.Canceled == nil {
-		return 0, false
+func (d *Dashboard) GetTitleOk() (string, bool) {
+	if d == nil || d.Title == nil {
+		return "", false
 	}
-	return *d.Canceled, true
+	return *d.Title, true
 }
 
-// HasCanceled returns a boolean if a field has been set.
-func (d *Downtime) HasCanceled() bool {
-	if d != nil && d.Canceled != nil {
+// HasTitle returns a boolean if a field has been set.
+func (d *Dashboard) HasTitle() bool {
+	if d != nil && d.Title != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCanceled allocates a new d.Canceled and returns the pointer to it.
-func (d *Downtime) SetCanceled(v int) {
-	d.Canceled = &v
+// SetTitle allocates a new d.Title and returns the pointer to it.
+func (d *Dashboard) SetTitle(v string) {
+	d.Title = &v
 }
 
-// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
-func (d *Downtime) GetDisabled() bool {
-	if d == nil || d.Disabled == nil {
-		return false
+// GetComparator returns the Comparator field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetComparator() string {
+	if d == nil || d.Comparator == nil {
+		return ""
 	}
-	return *d.Disabled
+	return *d.Comparator
 }
 
-// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
+// GetComparatorOk returns a tuple with the Comparator field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetDisabledOk() (bool, bool) {
-	if d == nil || d.Disabled == nil {
-		return false, false
+func (d *DashboardConditionalFormat) GetComparatorOk() (string, bool) {
+	if d == nil || d.Comparator == nil {
+		return "", false
 	}
-	return *d.Disabled, true
+	return *d.Comparator, true
 }
 
-// HasDisabled returns a boolean if a field has been set.
-func (d *Downtime) HasDisabled() bool {
-	if d != nil && d.Disabled != nil {
+// HasComparator returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasComparator() bool {
+	if d != nil && d.Comparator != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDisabled allocates a new d.Disabled and returns the pointer to it.
-func (d *Downtime) SetDisabled(v bool) {
-	d.Disabled = &v
+// SetComparator allocates a new d.Comparator and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetComparator(v string) {
+	d.Comparator = &v
 }
 
-// GetEnd returns the End field if non-nil, zero value otherwise.
-func (d *Downtime) GetEnd() int {
-	if d == nil || d.End == nil {
-		return 0
+// GetCustomBgColor returns the CustomBgColor field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetCustomBgColor() string {
+	if d == nil || d.CustomBgColor == nil {
+		return ""
 	}
-	return *d.End
+	return *d.CustomBgColor
 }
 
-// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// GetCustomBgColorOk returns a tuple with the CustomBgColor field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetEndOk() (int, bool) {
-	if d == nil || d.End == nil {
-		return 0, false
+func (d *DashboardConditionalFormat) GetCustomBgColorOk() (string, bool) {
+	if d == nil || d.CustomBgColor == nil {
+		return "", false
 	}
-	return *d.End, true
+	return *d.CustomBgColor, true
 }
 
-// HasEnd returns a boolean if a field has been set.
-func (d *Downtime) HasEnd() bool {
-	if d != nil && d.End != nil {
+// HasCustomBgColor returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasCustomBgColor() bool {
+	if d != nil && d.CustomBgColor != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEnd allocates a new d.End and returns the pointer to it.
-func (d *Downtime) SetEnd(v int) {
-	d.End = &v
+// SetCustomBgColor allocates a new d.CustomBgColor and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetCustomBgColor(v string) {
+	d.CustomBgColor = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (d *Downtime) GetId() int {
-	if d == nil || d.Id == nil {
-		return 0
+// GetCustomFgColor returns the CustomFgColor field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetCustomFgColor() string {
+	if d == nil || d.CustomFgColor == nil {
+		return ""
 	}
-	return *d.Id
+	return *d.CustomFgColor
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetCustomFgColorOk returns a tuple with the CustomFgColor field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetIdOk() (int, bool) {
-	if d == nil || d.Id == nil {
-		return 0, false
+func (d *DashboardConditionalFormat) GetCustomFgColorOk() (string, bool) {
+	if d == nil || d.CustomFgColor == nil {
+		return "", false
 	}
-	return *d.Id, true
+	return *d.CustomFgColor, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (d *Downtime) HasId() bool {
-	if d != nil && d.Id != nil {
+// HasCustomFgColor returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasCustomFgColor() bool {
+	if d != nil && d.CustomFgColor != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new d.Id and returns the pointer to it.
-func (d *Downtime) SetId(v int) {
-	d.Id = &v
+// SetCustomFgColor allocates a new d.CustomFgColor and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetCustomFgColor(v string) {
+	d.CustomFgColor = &v
 }
 
-// GetMessage returns the Message field if non-nil, zero value otherwise.
-func (d *Downtime) GetMessage() string {
-	if d == nil || d.Message == nil {
+// GetCustomImageUrl returns the CustomImageUrl field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetCustomImageUrl() string {
+	if d == nil || d.CustomImageUrl == nil {
 		return ""
 	}
-	return *d.Message
+	return *d.CustomImageUrl
 }
 
-// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// GetCustomImageUrlOk returns a tuple with the CustomImageUrl field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetMessageOk() (string, bool) {
-	if d == nil || d.Message == nil {
+func (d *DashboardConditionalFormat) GetCustomImageUrlOk() (string, bool) {
+	if d == nil || d.CustomImageUrl == nil {
 		return "", false
 	}
-	return *d.Message, true
+	return *d.CustomImageUrl, true
 }
 
-// HasMessage returns a boolean if a field has been set.
-func (d *Downtime) HasMessage() bool {
-	if d != nil && d.Message != nil {
+// HasCustomImageUrl returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasCustomImageUrl() bool {
+	if d != nil && d.CustomImageUrl != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMessage allocates a new d.Message and returns the pointer to it.
-func (d *Downtime) SetMessage(v string) {
-	d.Message = &v
+// SetCustomImageUrl allocates a new d.CustomImageUrl and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetCustomImageUrl(v string) {
+	d.CustomImageUrl = &v
 }
 
-// GetMonitorId returns the MonitorId field if non-nil, zero value otherwise.
-func (d *Downtime) GetMonitorId() int {
-	if d == nil || d.MonitorId == nil {
-		return 0
+// GetInverted returns the Inverted field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetInverted() bool {
+	if d == nil || d.Inverted == nil {
+		return false
 	}
-	return *d.MonitorId
+	return *d.Inverted
 }
 
-// GetMonitorIdOk returns a tuple with the MonitorId field if it's non-nil, zero value otherwise
+// GetInvertedOk returns a tuple with the Inverted field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetMonitorIdOk() (int, bool) {
-	if d == nil || d.MonitorId == nil {
-		return 0, false
+func (d *DashboardConditionalFormat) GetInvertedOk() (bool, bool) {
+	if d == nil || d.Inverted == nil {
+		return false, false
 	}
-	return *d.MonitorId, true
+	return *d.Inverted, true
 }
 
-// HasMonitorId returns a boolean if a field has been set.
-func (d *Downtime) HasMonitorId() bool {
-	if d != nil && d.MonitorId != nil {
+// HasInverted returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasInverted() bool {
+	if d != nil && d.Inverted != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMonitorId allocates a new d.MonitorId and returns the pointer to it.
-func (d *Downtime) SetMonitorId(v int) {
-	d.MonitorId = &v
+// SetInverted allocates a new d.Inverted and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetInverted(v bool) {
+	d.Inverted = &v
 }
 
-// GetRecurrence returns the Recurrence field if non-nil, zero value otherwise.
-func (d *Downtime) GetRecurrence() Recurrence {
-	if d == nil || d.Recurrence == nil {
-		return Recurrence{}
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetPalette() string {
+	if d == nil || d.Palette == nil {
+		return ""
 	}
-	return *d.Recurrence
+	return *d.Palette
 }
 
-// GetRecurrenceOk returns a tuple with the Recurrence field if it's non-nil, zero value otherwise
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetRecurrenceOk() (Recurrence, bool) {
-	if d == nil || d.Recurrence == nil {
-		return Recurrence{}, false
+func (d *DashboardConditionalFormat) GetPaletteOk() (string, bool) {
+	if d == nil || d.Palette == nil {
+		return "", false
 	}
-	return *d.Recurrence, true
+	return *d.Palette, true
 }
 
-// HasRecurrence returns a boolean if a field has been set.
-func (d *Downtime) HasRecurrence() bool {
-	if d != nil && d.Recurrence != nil {
+// HasPalette returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasPalette() bool {
+	if d != nil && d.Palette != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRecurrence allocates a new d.Recurrence and returns the pointer to it.
-func (d *Downtime) SetRecurrence(v Recurrence) {
-	d.Recurrence = &v
+// SetPalette allocates a new d.Palette and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetPalette(v string) {
+	d.Palette = &v
 }
 
-// GetStart returns the Start field if non-nil, zero value otherwise.
-func (d *Downtime) GetStart() int {
-	if d == nil || d.Start == nil {
-		return 0
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (d *DashboardConditionalFormat) GetValue() json.Number {
+	if d == nil || d.Value == nil {
+		return ""
 	}
-	return *d.Start
+	return *d.Value
 }
 
-// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (d *Downtime) GetStartOk() (int, bool) {
-	if d == nil || d.Start == nil {
-		return 0, false
+func (d *DashboardConditionalFormat) GetValueOk() (json.Number, bool) {
+	if d == nil || d.Value == nil {
+		return "", false
 	}
-	return *d.Start, true
+	return *d.Value, true
 }
 
-// HasStart returns a boolean if a field has been set.
-func (d *Downtime) HasStart() bool {
-	if d != nil && d.Start != nil {
+// HasValue returns a boolean if a field has been set.
+func (d *DashboardConditionalFormat) HasValue() bool {
+	if d != nil && d.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStart allocates a new d.Start and returns the pointer to it.
-func (d *Downtime) SetStart(v int) {
-	d.Start = &v
+// SetValue allocates a new d.Value and returns the pointer to it.
+func (d *DashboardConditionalFormat) SetValue(v json.Number) {
+	d.Value = &v
 }
 
-// GetAggregation returns the Aggregation field if non-nil, zero value otherwise.
-func (e *Event) GetAggregation() string {
-	if e == nil || e.Aggregation == nil {
-		return ""
+// GetDashboardCount returns the DashboardCount field if non-nil, zero value otherwise.
+func (d *DashboardList) GetDashboardCount() int {
+	if d == nil || d.DashboardCount == nil {
+		return 0
 	}
-	return *e.Aggregation
+	return *d.DashboardCount
 }
 
-// GetAggregationOk returns a tuple with the Aggregation field if it's non-nil, zero value otherwise
+// GetDashboardCountOk returns a tuple with the DashboardCount field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetAggregationOk() (string, bool) {
-	if e == nil || e.Aggregation == nil {
-		return "", false
+func (d *DashboardList) GetDashboardCountOk() (int, bool) {
+	if d == nil || d.DashboardCount == nil {
+		return 0, false
 	}
-	return *e.Aggregation, true
+	return *d.DashboardCount, true
 }
 
-// HasAggregation returns a boolean if a field has been set.
-func (e *Event) HasAggregation() bool {
-	if e != nil && e.Aggregation != nil {
+// HasDashboardCount returns a boolean if a field has been set.
+func (d *DashboardList) HasDashboardCount() bool {
+	if d != nil && d.DashboardCount != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAggregation allocates a new e.Aggregation and returns the pointer to it.
-func (e *Event) SetAggregation(v string) {
-	e.Aggregation = &v
+// SetDashboardCount allocates a new d.DashboardCount and returns the pointer to it.
+func (d *DashboardList) SetDashboardCount(v int) {
+	d.DashboardCount = &v
 }
 
-// GetAlertType returns the AlertType field if non-nil, zero value otherwise.
-func (e *Event) GetAlertType() string {
-	if e == nil || e.AlertType == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (d *DashboardList) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
 	}
-	return *e.AlertType
+	return *d.Id
 }
 
-// GetAlertTypeOk returns a tuple with the AlertType field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetAlertTypeOk() (string, bool) {
-	if e == nil || e.AlertType == nil {
-		return "", false
+func (d *DashboardList) GetIdOk() (int, bool) {
+	if d == nil || d.Id == nil {
+		return 0, false
 	}
-	return *e.AlertType, true
+	return *d.Id, true
 }
 
-// HasAlertType returns a boolean if a field has been set.
-func (e *Event) HasAlertType() bool {
-	if e != nil && e.AlertType != nil {
+// HasId returns a boolean if a field has been set.
+func (d *DashboardList) HasId() bool {
+	if d != nil && d.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAlertType allocates a new e.AlertType and returns the pointer to it.
-func (e *Event) SetAlertType(v string) {
-	e.AlertType = &v
+// SetId allocates a new d.Id and returns the pointer to it.
+func (d *DashboardList) SetId(v int) {
+	d.Id = &v
 }
 
-// GetEventType returns the EventType field if non-nil, zero value otherwise.
-func (e *Event) GetEventType() string {
-	if e == nil || e.EventType == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (d *DashboardList) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *e.EventType
+	return *d.Name
 }
 
-// GetEventTypeOk returns a tuple with the EventType field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetEventTypeOk() (string, bool) {
-	if e == nil || e.EventType == nil {
+func (d *DashboardList) GetNameOk() (string, bool) {
+	if d == nil || d.Name == nil {
 		return "", false
 	}
-	return *e.EventType, true
-}
-
-// HasEventType returns a boolean if a field has been set.
-func (e *Event) HasEventType() bool {
-	if e != nil && e.EventType != nil {
-		return true
-	}
-
-	return false
-}
-
-// SetEventType allocates a new e.EventType and returns the pointer to it.
-func (e *Event) SetEventType(v string) {
-	e.EventType = &v
-}
-
-// GetHost returns the Host field if non-nil, zero value otherwise.
-func (e *Event) GetHost() string {
-	if e == nil || e.Host == nil {
-		return ""
-	}
-	return *e.Host
-}
-
-// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
-// and a boolean to check if the value has been set.
-func (e *Event) GetHostOk() (string, bool) {
-	if e == nil || e.Host == nil {
-		return "", false
-	}
-	return *e.Host, true
+	return *d.Name, true
 }
 
-// HasHost returns a boolean if a field has been set.
-func (e *Event) HasHost() bool {
-	if e != nil && e.Host != nil {
+// HasName returns a boolean if a field has been set.
+func (d *DashboardList) HasName() bool {
+	if d != nil && d.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHost allocates a new e.Host and returns the pointer to it.
-func (e *Event) SetHost(v string) {
-	e.Host = &v
+// SetName allocates a new d.Name and returns the pointer to it.
+func (d *DashboardList) SetName(v string) {
+	d.Name = &v
 }
 
 // GetId returns the Id field if non-nil, zero value otherwise.
-func (e *Event) GetId() int {
-	if e == nil || e.Id == nil {
+func (d *DashboardListItem) GetId() int {
+	if d == nil || d.Id == nil {
 		return 0
 	}
-	return *e.Id
+	return *d.Id
 }
 
 // GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetIdOk() (int, bool) {
-	if e == nil || e.Id == nil {
+func (d *DashboardListItem) GetIdOk() (int, bool) {
+	if d == nil || d.Id == nil {
 		return 0, false
 	}
-	return *e.Id, true
+	return *d.Id, true
 }
 
 // HasId returns a boolean if a field has been set.
-func (e *Event) HasId() bool {
-	if e != nil && e.Id != nil {
+func (d *DashboardListItem) HasId() bool {
+	if d != nil && d.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new e.Id and returns the pointer to it.
-func (e *Event) SetId(v int) {
-	e.Id = &v
+// SetId allocates a new d.Id and returns the pointer to it.
+func (d *DashboardListItem) SetId(v int) {
+	d.Id = &v
 }
 
-// GetPriority returns the Priority field if non-nil, zero value otherwise.
-func (e *Event) GetPriority() string {
-	if e == nil || e.Priority == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (d *DashboardListItem) GetType() string {
+	if d == nil || d.Type == nil {
 		return ""
 	}
-	return *e.Priority
+	return *d.Type
 }
 
-// GetPriorityOk returns a tuple with the Priority field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetPriorityOk() (string, bool) {
-	if e == nil || e.Priority == nil {
+func (d *DashboardListItem) GetTypeOk() (string, bool) {
+	if d == nil || d.Type == nil {
 		return "", false
 	}
-	return *e.Priority, true
+	return *d.Type, true
 }
 
-// HasPriority returns a boolean if a field has been set.
-func (e *Event) HasPriority() bool {
-	if e != nil && e.Priority != nil {
+// HasType returns a boolean if a field has been set.
+func (d *DashboardListItem) HasType() bool {
+	if d != nil && d.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPriority allocates a new e.Priority and returns the pointer to it.
-func (e *Event) SetPriority(v string) {
-	e.Priority = &v
+// SetType allocates a new d.Type and returns the pointer to it.
+func (d *DashboardListItem) SetType(v string) {
+	d.Type = &v
 }
 
-// GetResource returns the Resource field if non-nil, zero value otherwise.
-func (e *Event) GetResource() string {
-	if e == nil || e.Resource == nil {
+// GetCreated returns the Created field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetCreated() string {
+	if d == nil || d.Created == nil {
 		return ""
 	}
-	return *e.Resource
+	return *d.Created
 }
 
-// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// GetCreatedOk returns a tuple with the Created field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetResourceOk() (string, bool) {
-	if e == nil || e.Resource == nil {
+func (d *DashboardLite) GetCreatedOk() (string, bool) {
+	if d == nil || d.Created == nil {
 		return "", false
 	}
-	return *e.Resource, true
+	return *d.Created, true
 }
 
-// HasResource returns a boolean if a field has been set.
-func (e *Event) HasResource() bool {
-	if e != nil && e.Resource != nil {
+// HasCreated returns a boolean if a field has been set.
+func (d *DashboardLite) HasCreated() bool {
+	if d != nil && d.Created != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetResource allocates a new e.Resource and returns the pointer to it.
-func (e *Event) SetResource(v string) {
-	e.Resource = &v
+// SetCreated allocates a new d.Created and returns the pointer to it.
+func (d *DashboardLite) SetCreated(v string) {
+	d.Created = &v
 }
 
-// GetSourceType returns the SourceType field if non-nil, zero value otherwise.
-func (e *Event) GetSourceType() string {
-	if e == nil || e.SourceType == nil {
-		return ""
+// GetCreatedBy returns the CreatedBy field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetCreatedBy() CreatedBy {
+	if d == nil || d.CreatedBy == nil {
+		return CreatedBy{}
 	}
-	return *e.SourceType
+	return *d.CreatedBy
 }
 
-// GetSourceTypeOk returns a tuple with the SourceType field if it's non-nil, zero value otherwise
+// GetCreatedByOk returns a tuple with the CreatedBy field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetSourceTypeOk() (string, bool) {
-	if e == nil || e.SourceType == nil {
-		return "", false
+func (d *DashboardLite) GetCreatedByOk() (CreatedBy, bool) {
+	if d == nil || d.CreatedBy == nil {
+		return CreatedBy{}, false
 	}
-	return *e.SourceType, true
+	return *d.CreatedBy, true
 }
 
-// HasSourceType returns a boolean if a field has been set.
-func (e *Event) HasSourceType() bool {
-	if e != nil && e.SourceType != nil {
+// HasCreatedBy returns a boolean if a field has been set.
+func (d *DashboardLite) HasCreatedBy() bool {
+	if d != nil && d.CreatedBy != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetSourceType allocates a new e.SourceType and returns the pointer to it.
-func (e *Event) SetSourceType(v string) {
-	e.SourceType = &v
+// SetCreatedBy allocates a new d.CreatedBy and returns the pointer to it.
+func (d *DashboardLite) SetCreatedBy(v CreatedBy) {
+	d.CreatedBy = &v
 }
 
-// GetText returns the Text field if non-nil, zero value otherwise.
-func (e *Event) GetText() string {
-	if e == nil || e.Text == nil {
+// GetDescription returns the Description field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *e.Text
+	return *d.Description
 }
 
-// GetTextOk returns a tuple with the Text field if it's non-nil, zero value otherwise
+// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetTextOk() (string, bool) {
-	if e == nil || e.Text == nil {
+func (d *DashboardLite) GetDescriptionOk() (string, bool) {
+	if d == nil || d.Description == nil {
 		return "", false
 	}
-	return *e.Text, true
+	return *d.Description, true
 }
 
-// HasText returns a boolean if a field has been set.
-func (e *Event) HasText() bool {
-	if e != nil && e.Text != nil {
+// HasDescription returns a boolean if a field has been set.
+func (d *DashboardLite) HasDescription() bool {
+	if d != nil && d.Description != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetText allocates a new e.Text and returns the pointer to it.
-func (e *Event) SetText(v string) {
-	e.Text = &v
+// SetDescription allocates a new d.Description and returns the pointer to it.
+func (d *DashboardLite) SetDescription(v string) {
+	d.Description = &v
 }
 
-// GetTime returns the Time field if non-nil, zero value otherwise.
-func (e *Event) GetTime() int {
-	if e == nil || e.Time == nil {
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetId() int {
+	if d == nil || d.Id == nil {
 		return 0
 	}
-	return *e.Time
+	return *d.Id
 }
 
-// GetTimeOk returns a tuple with the Time field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetTimeOk() (int, bool) {
-	if e == nil || e.Time == nil {
+func (d *DashboardLite) GetIdOk() (int, bool) {
+	if d == nil || d.Id == nil {
 		return 0, false
 	}
-	return *e.Time, true
+	return *d.Id, true
 }
 
-// HasTime returns a boolean if a field has been set.
-func (e *Event) HasTime() bool {
-	if e != nil && e.Time != nil {
+// HasId returns a boolean if a field has been set.
+func (d *DashboardLite) HasId() bool {
+	if d != nil && d.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTime allocates a new e.Time and returns the pointer to it.
-func (e *Event) SetTime(v int) {
-	e.Time = &v
+// SetId allocates a new d.Id and returns the pointer to it.
+func (d *DashboardLite) SetId(v int) {
+	d.Id = &v
 }
 
-// GetTitle returns the Title field if non-nil, zero value otherwise.
-func (e *Event) GetTitle() string {
-	if e == nil || e.Title == nil {
+// GetModified returns the Modified field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetModified() string {
+	if d == nil || d.Modified == nil {
 		return ""
 	}
-	return *e.Title
+	return *d.Modified
 }
 
-// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// GetModifiedOk returns a tuple with the Modified field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetTitleOk() (string, bool) {
-	if e == nil || e.Title == nil {
+func (d *DashboardLite) GetModifiedOk() (string, bool) {
+	if d == nil || d.Modified == nil {
 		return "", false
 	}
-	return *e.Title, true
+	return *d.Modified, true
 }
 
-// HasTitle returns a boolean if a field has been set.
-func (e *Event) HasTitle() bool {
-	if e != nil && e.Title != nil {
+// HasModified returns a boolean if a field has been set.
+func (d *DashboardLite) HasModified() bool {
+	if d != nil && d.Modified != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new e.Title and returns the pointer to it.
-func (e *Event) SetTitle(v string) {
-	e.Title = &v
+// SetModified allocates a new d.Modified and returns the pointer to it.
+func (d *DashboardLite) SetModified(v string) {
+	d.Modified = &v
 }
 
-// GetUrl returns the Url field if non-nil, zero value otherwise.
-func (e *Event) GetUrl() string {
-	if e == nil || e.Url == nil {
-		return ""
+// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetReadOnly() bool {
+	if d == nil || d.ReadOnly == nil {
+		return false
 	}
-	return *e.Url
+	return *d.ReadOnly
 }
 
-// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (e *Event) GetUrlOk() (string, bool) {
-	if e == nil || e.Url == nil {
-		return "", false
+func (d *DashboardLite) GetReadOnlyOk() (bool, bool) {
+	if d == nil || d.ReadOnly == nil {
+		return false, false
 	}
-	return *e.Url, true
+	return *d.ReadOnly, true
 }
 
-// HasUrl returns a boolean if a field has been set.
-func (e *Event) HasUrl() bool {
-	if e != nil && e.Url != nil {
+// HasReadOnly returns a boolean if a field has been set.
+func (d *DashboardLite) HasReadOnly() bool {
+	if d != nil && d.ReadOnly != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUrl allocates a new e.Url and returns the pointer to it.
-func (e *Event) SetUrl(v string) {
-	e.Url = &v
+// SetReadOnly allocates a new d.ReadOnly and returns the pointer to it.
+func (d *DashboardLite) SetReadOnly(v bool) {
+	d.ReadOnly = &v
 }
 
-// GetDefinition returns the Definition field if non-nil, zero value otherwise.
-func (g *Graph) GetDefinition() GraphDefinition {
-	if g == nil || g.Definition == nil {
-		return GraphDefinition{}
+// GetResource returns the Resource field if non-nil, zero value otherwise.
+func (d *DashboardLite) GetResource() string {
+	if d == nil || d.Resource == nil {
+		return ""
 	}
-	return *g.Definition
+	return *d.Resource
 }
 
-// GetDefinitionOk returns a tuple with the Definition field if it's non-nil, zero value otherwise
+// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *Graph) GetDefinitionOk() (GraphDefinition, bool) {
-	if g == nil || g.Definition == nil {
-		return GraphDefinition{}, false
+func (d *DashboardLite) GetResourceOk() (string, bool) {
+	if d == nil || d.Resource == nil {
+		return "", false
 	}
-	return *g.Definition, true
+	return *d.Resource, true
 }
 
-// HasDefinition returns a boolean if a field has been set.
-func (g *Graph) HasDefinition() bool {
-	if g != nil && g.Definition != nil {
+// HasResource returns a boolean if a field has been set.
+func (d *DashboardLite) HasResource() bool {
+	if d != nil && d.Resource != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDefinition allocates a new g.Definition and returns the pointer to it.
-func (g *Graph) SetDefinition(v GraphDefinition) {
-	g.Definition = &v
+// SetResource allocates a new d.Resource and returns the pointer to it.
+func (d *DashboardLite) SetResource(v string) {
+	d.Resource = &v
 }
 
 // GetTitle returns the Title field if non-nil, zero value otherwise.
-func (g *Graph) GetTitle() string {
-	if g == nil || g.Title == nil {
+func (d *DashboardLite) GetTitle() string {
+	if d == nil || d.Title == nil {
 		return ""
 	}
-	return *g.Title
+	return *d.Title
 }
 
 // GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *Graph) GetTitleOk() (string, bool) {
-	if g == nil || g.Title == nil {
+func (d *DashboardLite) GetTitleOk() (string, bool) {
+	if d == nil || d.Title == nil {
 		return "", false
 	}
-	return *g.Title, true
+	return *d.Title, true
 }
 
 // HasTitle returns a boolean if a field has been set.
-func (g *Graph) HasTitle() bool {
-	if g != nil && g.Title != nil {
+func (d *DashboardLite) HasTitle() bool {
+	if d != nil && d.Title != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new g.Title and returns the pointer to it.
-func (g *Graph) SetTitle(v string) {
-	g.Title = &v
+// SetTitle allocates a new d.Title and returns the pointer to it.
+func (d *DashboardLite) SetTitle(v string) {
+	d.Title = &v
 }
 
-// GetAutoscale returns the Autoscale field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetAutoscale() bool {
-	if g == nil || g.Autoscale == nil {
-		return false
+// GetTotal returns the Total field if non-nil, zero value otherwise.
+func (d *DashboardsPage) GetTotal() int {
+	if d == nil || d.Total == nil {
+		return 0
 	}
-	return *g.Autoscale
+	return *d.Total
 }
 
-// GetAutoscaleOk returns a tuple with the Autoscale field if it's non-nil, zero value otherwise
+// GetTotalOk returns a tuple with the Total field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetAutoscaleOk() (bool, bool) {
-	if g == nil || g.Autoscale == nil {
-		return false, false
+func (d *DashboardsPage) GetTotalOk() (int, bool) {
+	if d == nil || d.Total == nil {
+		return 0, false
 	}
-	return *g.Autoscale, true
+	return *d.Total, true
 }
 
-// HasAutoscale returns a boolean if a field has been set.
-func (g *GraphDefinition) HasAutoscale() bool {
-	if g != nil && g.Autoscale != nil {
+// HasTotal returns a boolean if a field has been set.
+func (d *DashboardsPage) HasTotal() bool {
+	if d != nil && d.Total != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAutoscale allocates a new g.Autoscale and returns the pointer to it.
-func (g *GraphDefinition) SetAutoscale(v bool) {
-	g.Autoscale = &v
+// SetTotal allocates a new d.Total and returns the pointer to it.
+func (d *DashboardsPage) SetTotal(v int) {
+	d.Total = &v
 }
 
-// GetCustomUnit returns the CustomUnit field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetCustomUnit() string {
-	if g == nil || g.CustomUnit == nil {
+// GetHost returns the Host field if non-nil, zero value otherwise.
+func (d *DistributionMetric) GetHost() string {
+	if d == nil || d.Host == nil {
 		return ""
 	}
-	return *g.CustomUnit
+	return *d.Host
 }
 
-// GetCustomUnitOk returns a tuple with the CustomUnit field if it's non-nil, zero value otherwise
+// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetCustomUnitOk() (string, bool) {
-	if g == nil || g.CustomUnit == nil {
+func (d *DistributionMetric) GetHostOk() (string, bool) {
+	if d == nil || d.Host == nil {
 		return "", false
 	}
-	return *g.CustomUnit, true
+	return *d.Host, true
 }
 
-// HasCustomUnit returns a boolean if a field has been set.
-func (g *GraphDefinition) HasCustomUnit() bool {
-	if g != nil && g.CustomUnit != nil {
+// HasHost returns a boolean if a field has been set.
+func (d *DistributionMetric) HasHost() bool {
+	if d != nil && d.Host != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCustomUnit allocates a new g.CustomUnit and returns the pointer to it.
-func (g *GraphDefinition) SetCustomUnit(v string) {
-	g.CustomUnit = &v
+// SetHost allocates a new d.Host and returns the pointer to it.
+func (d *DistributionMetric) SetHost(v string) {
+	d.Host = &v
 }
 
-// GetIncludeNoMetricHosts returns the IncludeNoMetricHosts field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetIncludeNoMetricHosts() bool {
-	if g == nil || g.IncludeNoMetricHosts == nil {
-		return false
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (d *DistributionMetric) GetMetric() string {
+	if d == nil || d.Metric == nil {
+		return ""
 	}
-	return *g.IncludeNoMetricHosts
+	return *d.Metric
 }
 
-// GetIncludeNoMetricHostsOk returns a tuple with the IncludeNoMetricHosts field if it's non-nil, zero value otherwise
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetIncludeNoMetricHostsOk() (bool, bool) {
-	if g == nil || g.IncludeNoMetricHosts == nil {
-		return false, false
+func (d *DistributionMetric) GetMetricOk() (string, bool) {
+	if d == nil || d.Metric == nil {
+		return "", false
 	}
-	return *g.IncludeNoMetricHosts, true
+	return *d.Metric, true
 }
 
-// HasIncludeNoMetricHosts returns a boolean if a field has been set.
-func (g *GraphDefinition) HasIncludeNoMetricHosts() bool {
-	if g != nil && g.IncludeNoMetricHosts != nil {
+// HasMetric returns a boolean if a field has been set.
+func (d *DistributionMetric) HasMetric() bool {
+	if d != nil && d.Metric != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIncludeNoMetricHosts allocates a new g.IncludeNoMetricHosts and returns the pointer to it.
-func (g *GraphDefinition) SetIncludeNoMetricHosts(v bool) {
-	g.IncludeNoMetricHosts = &v
+// SetMetric allocates a new d.Metric and returns the pointer to it.
+func (d *DistributionMetric) SetMetric(v string) {
+	d.Metric = &v
 }
 
-// GetIncludeUngroupedHosts returns the IncludeUngroupedHosts field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetIncludeUngroupedHosts() bool {
-	if g == nil || g.IncludeUngroupedHosts == nil {
+// GetActive returns the Active field if non-nil, zero value otherwise.
+func (d *Downtime) GetActive() bool {
+	if d == nil || d.Active == nil {
 		return false
 	}
-	return *g.IncludeUngroupedHosts
+	return *d.Active
 }
 
-// GetIncludeUngroupedHostsOk returns a tuple with the IncludeUngroupedHosts field if it's non-nil, zero value otherwise
+// GetActiveOk returns a tuple with the Active field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetIncludeUngroupedHostsOk() (bool, bool) {
-	if g == nil || g.IncludeUngroupedHosts == nil {
+func (d *Downtime) GetActiveOk() (bool, bool) {
+	if d == nil || d.Active == nil {
 		return false, false
 	}
-	return *g.IncludeUngroupedHosts, true
+	return *d.Active, true
 }
 
-// HasIncludeUngroupedHosts returns a boolean if a field has been set.
-func (g *GraphDefinition) HasIncludeUngroupedHosts() bool {
-	if g != nil && g.IncludeUngroupedHosts != nil {
+// HasActive returns a boolean if a field has been set.
+func (d *Downtime) HasActive() bool {
+	if d != nil && d.Active != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIncludeUngroupedHosts allocates a new g.IncludeUngroupedHosts and returns the pointer to it.
-func (g *GraphDefinition) SetIncludeUngroupedHosts(v bool) {
-	g.IncludeUngroupedHosts = &v
+// SetActive allocates a new d.Active and returns the pointer to it.
+func (d *Downtime) SetActive(v bool) {
+	d.Active = &v
 }
 
-// GetNodeType returns the NodeType field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetNodeType() string {
-	if g == nil || g.NodeType == nil {
-		return ""
+// GetCanceled returns the Canceled field if non-nil, zero value otherwise.
+func (d *Downtime) GetCanceled() int {
+	if d == nil || d.Canceled == nil {
+		return 0
 	}
-	return *g.NodeType
+	return *d.Canceled
 }
 
-// GetNodeTypeOk returns a tuple with the NodeType field if it's non-nil, zero value otherwise
+// GetCanceledOk returns a tuple with the Canceled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetNodeTypeOk() (string, bool) {
-	if g == nil || g.NodeType == nil {
-		return "", false
+func (d *Downtime) GetCanceledOk() (int, bool) {
+	if d == nil || d.Canceled == nil {
+		return 0, false
 	}
-	return *g.NodeType, true
+	return *d.Canceled, true
 }
 
-// HasNodeType returns a boolean if a field has been set.
-func (g *GraphDefinition) HasNodeType() bool {
-	if g != nil && g.NodeType != nil {
+// HasCanceled returns a boolean if a field has been set.
+func (d *Downtime) HasCanceled() bool {
+	if d != nil && d.Canceled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNodeType allocates a new g.NodeType and returns the pointer to it.
-func (g *GraphDefinition) SetNodeType(v string) {
-	g.NodeType = &v
+// SetCanceled allocates a new d.Canceled and returns the pointer to it.
+func (d *Downtime) SetCanceled(v int) {
+	d.Canceled = &v
 }
 
-// GetPrecision returns the Precision field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetPrecision() PrecisionT {
-	if g == nil || g.Precision == nil {
-		return ""
+// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
+func (d *Downtime) GetDisabled() bool {
+	if d == nil || d.Disabled == nil {
+		return false
 	}
-	return *g.Precision
+	return *d.Disabled
 }
 
-// GetPrecisionOk returns a tuple with the Precision field if it's non-nil, zero value otherwise
+// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetPrecisionOk() (PrecisionT, bool) {
-	if g == nil || g.Precision == nil {
-		return "", false
+func (d *Downtime) GetDisabledOk() (bool, bool) {
+	if d == nil || d.Disabled == nil {
+		return false, false
 	}
-	return *g.Precision, true
+	return *d.Disabled, true
 }
 
-// HasPrecision returns a boolean if a field has been set.
-func (g *GraphDefinition) HasPrecision() bool {
-	if g != nil && g.Precision != nil {
+// HasDisabled returns a boolean if a field has been set.
+func (d *Downtime) HasDisabled() bool {
+	if d != nil && d.Disabled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPrecision allocates a new g.Precision and returns the pointer to it.
-func (g *GraphDefinition) SetPrecision(v PrecisionT) {
-	g.Precision = &v
+// SetDisabled allocates a new d.Disabled and returns the pointer to it.
+func (d *Downtime) SetDisabled(v bool) {
+	d.Disabled = &v
 }
 
-// GetStyle returns the Style field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetStyle() Style {
-	if g == nil || g.Style == nil {
-		return Style{}
+// GetEnd returns the End field if non-nil, zero value otherwise.
+func (d *Downtime) GetEnd() int {
+	if d == nil || d.End == nil {
+		return 0
 	}
-	return *g.Style
+	return *d.End
 }
 
-// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
+// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetStyleOk() (Style, bool) {
-	if g == nil || g.Style == nil {
-		return Style{}, false
+func (d *Downtime) GetEndOk() (int, bool) {
+	if d == nil || d.End == nil {
+		return 0, false
 	}
-	return *g.Style, true
+	return *d.End, true
 }
 
-// HasStyle returns a boolean if a field has been set.
-func (g *GraphDefinition) HasStyle() bool {
-	if g != nil && g.Style != nil {
+// HasEnd returns a boolean if a field has been set.
+func (d *Downtime) HasEnd() bool {
+	if d != nil && d.End != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStyle allocates a new g.Style and returns the pointer to it.
-func (g *GraphDefinition) SetStyle(v Style) {
-	g.Style = &v
+// SetEnd allocates a new d.End and returns the pointer to it.
+func (d *Downtime) SetEnd(v int) {
+	d.End = &v
 }
 
-// GetTextAlign returns the TextAlign field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetTextAlign() string {
-	if g == nil || g.TextAlign == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (d *Downtime) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
 	}
-	return *g.TextAlign
+	return *d.Id
 }
 
-// GetTextAlignOk returns a tuple with the TextAlign field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetTextAlignOk() (string, bool) {
-	if g == nil || g.TextAlign == nil {
-		return "", false
+func (d *Downtime) GetIdOk() (int, bool) {
+	if d == nil || d.Id == nil {
+		return 0, false
 	}
-	return *g.TextAlign, true
+	return *d.Id, true
 }
 
-// HasTextAlign returns a boolean if a field has been set.
-func (g *GraphDefinition) HasTextAlign() bool {
-	if g != nil && g.TextAlign != nil {
+// HasId returns a boolean if a field has been set.
+func (d *Downtime) HasId() bool {
+	if d != nil && d.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTextAlign allocates a new g.TextAlign and returns the pointer to it.
-func (g *GraphDefinition) SetTextAlign(v string) {
-	g.TextAlign = &v
+// SetId allocates a new d.Id and returns the pointer to it.
+func (d *Downtime) SetId(v int) {
+	d.Id = &v
 }
 
-// GetViz returns the Viz field if non-nil, zero value otherwise.
-func (g *GraphDefinition) GetViz() string {
-	if g == nil || g.Viz == nil {
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (d *Downtime) GetMessage() string {
+	if d == nil || d.Message == nil {
 		return ""
 	}
-	return *g.Viz
+	return *d.Message
 }
 
-// GetVizOk returns a tuple with the Viz field if it's non-nil, zero value otherwise
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinition) GetVizOk() (string, bool) {
-	if g == nil || g.Viz == nil {
+func (d *Downtime) GetMessageOk() (string, bool) {
+	if d == nil || d.Message == nil {
 		return "", false
 	}
-	return *g.Viz, true
+	return *d.Message, true
 }
 
-// HasViz returns a boolean if a field has been set.
-func (g *GraphDefinition) HasViz() bool {
-	if g != nil && g.Viz != nil {
+// HasMessage returns a boolean if a field has been set.
+func (d *Downtime) HasMessage() bool {
+	if d != nil && d.Message != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetViz allocates a new g.Viz and returns the pointer to it.
-func (g *GraphDefinition) SetViz(v string) {
-	g.Viz = &v
+// SetMessage allocates a new d.Message and returns the pointer to it.
+func (d *Downtime) SetMessage(v string) {
+	d.Message = &v
 }
 
-// GetLabel returns the Label field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetLabel() string {
-	if g == nil || g.Label == nil {
-		return ""
+// GetMonitorId returns the MonitorId field if non-nil, zero value otherwise.
+func (d *Downtime) GetMonitorId() int {
+	if d == nil || d.MonitorId == nil {
+		return 0
 	}
-	return *g.Label
+	return *d.MonitorId
 }
 
-// GetLabelOk returns a tuple with the Label field if it's non-nil, zero value otherwise
+// GetMonitorIdOk returns a tuple with the MonitorId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetLabelOk() (string, bool) {
-	if g == nil || g.Label == nil {
-		return "", false
+func (d *Downtime) GetMonitorIdOk() (int, bool) {
+	if d == nil || d.MonitorId == nil {
+		return 0, false
 	}
-	return *g.Label, true
+	return *d.MonitorId, true
 }
 
-// HasLabel returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasLabel() bool {
-	if g != nil && g.Label != nil {
+// HasMonitorId returns a boolean if a field has been set.
+func (d *Downtime) HasMonitorId() bool {
+	if d != nil && d.MonitorId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLabel allocates a new g.Label and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetLabel(v string) {
-	g.Label = &v
+// SetMonitorId allocates a new d.MonitorId and returns the pointer to it.
+func (d *Downtime) SetMonitorId(v int) {
+	d.MonitorId = &v
 }
 
-// GetMax returns the Max field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetMax() json.Number {
-	if g == nil || g.Max == nil {
-		return ""
+// GetRecurrence returns the Recurrence field if non-nil, zero value otherwise.
+func (d *Downtime) GetRecurrence() Recurrence {
+	if d == nil || d.Recurrence == nil {
+		return Recurrence{}
 	}
-	return *g.Max
+	return *d.Recurrence
 }
 
-// GetMaxOk returns a tuple with the Max field if it's non-nil, zero value otherwise
+// GetRecurrenceOk returns a tuple with the Recurrence field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetMaxOk() (json.Number, bool) {
-	if g == nil || g.Max == nil {
-		return "", false
+func (d *Downtime) GetRecurrenceOk() (Recurrence, bool) {
+	if d == nil || d.Recurrence == nil {
+		return Recurrence{}, false
 	}
-	return *g.Max, true
+	return *d.Recurrence, true
 }
 
-// HasMax returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasMax() bool {
-	if g != nil && g.Max != nil {
+// HasRecurrence returns a boolean if a field has been set.
+func (d *Downtime) HasRecurrence() bool {
+	if d != nil && d.Recurrence != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMax allocates a new g.Max and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetMax(v json.Number) {
-	g.Max = &v
+// SetRecurrence allocates a new d.Recurrence and returns the pointer to it.
+func (d *Downtime) SetRecurrence(v Recurrence) {
+	d.Recurrence = &v
 }
 
-// GetMin returns the Min field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetMin() json.Number {
-	if g == nil || g.Min == nil {
-		return ""
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (d *Downtime) GetStart() int {
+	if d == nil || d.Start == nil {
+		return 0
 	}
-	return *g.Min
+	return *d.Start
 }
 
-// GetMinOk returns a tuple with the Min field if it's non-nil, zero value otherwise
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetMinOk() (json.Number, bool) {
-	if g == nil || g.Min == nil {
-		return "", false
+func (d *Downtime) GetStartOk() (int, bool) {
+	if d == nil || d.Start == nil {
+		return 0, false
 	}
-	return *g.Min, true
+	return *d.Start, true
 }
 
-// HasMin returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasMin() bool {
-	if g != nil && g.Min != nil {
+// HasStart returns a boolean if a field has been set.
+func (d *Downtime) HasStart() bool {
+	if d != nil && d.Start != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMin allocates a new g.Min and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetMin(v json.Number) {
-	g.Min = &v
+// SetStart allocates a new d.Start and returns the pointer to it.
+func (d *Downtime) SetStart(v int) {
+	d.Start = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetType() string {
-	if g == nil || g.Type == nil {
+// GetAggregation returns the Aggregation field if non-nil, zero value otherwise.
+func (e *Event) GetAggregation() string {
+	if e == nil || e.Aggregation == nil {
 		return ""
 	}
-	return *g.Type
+	return *e.Aggregation
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetAggregationOk returns a tuple with the Aggregation field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetTypeOk() (string, bool) {
-	if g == nil || g.Type == nil {
+func (e *Event) GetAggregationOk() (string, bool) {
+	if e == nil || e.Aggregation == nil {
 		return "", false
 	}
-	return *g.Type, true
+	return *e.Aggregation, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasType() bool {
-	if g != nil && g.Type != nil {
+// HasAggregation returns a boolean if a field has been set.
+func (e *Event) HasAggregation() bool {
+	if e != nil && e.Aggregation != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new g.Type and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetType(v string) {
-	g.Type = &v
+// SetAggregation allocates a new e.Aggregation and returns the pointer to it.
+func (e *Event) SetAggregation(v string) {
+	e.Aggregation = &v
 }
 
-// GetVal returns the Val field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetVal() json.Number {
-	if g == nil || g.Val == nil {
+// GetAlertType returns the AlertType field if non-nil, zero value otherwise.
+func (e *Event) GetAlertType() string {
+	if e == nil || e.AlertType == nil {
 		return ""
 	}
-	return *g.Val
+	return *e.AlertType
 }
 
-// GetValOk returns a tuple with the Val field if it's non-nil, zero value otherwise
+// GetAlertTypeOk returns a tuple with the AlertType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetValOk() (json.Number, bool) {
-	if g == nil || g.Val == nil {
+func (e *Event) GetAlertTypeOk() (string, bool) {
+	if e == nil || e.AlertType == nil {
 		return "", false
 	}
-	return *g.Val, true
+	return *e.AlertType, true
 }
 
-// HasVal returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasVal() bool {
-	if g != nil && g.Val != nil {
+// HasAlertType returns a boolean if a field has been set.
+func (e *Event) HasAlertType() bool {
+	if e != nil && e.AlertType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetVal allocates a new g.Val and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetVal(v json.Number) {
-	g.Val = &v
+// SetAlertType allocates a new e.AlertType and returns the pointer to it.
+func (e *Event) SetAlertType(v string) {
+	e.AlertType = &v
 }
 
-// GetValue returns the Value field if non-nil, zero value otherwise.
-func (g *GraphDefinitionMarker) GetValue() string {
-	if g == nil || g.Value == nil {
+// GetDeviceName returns the DeviceName field if non-nil, zero value otherwise.
+func (e *Event) GetDeviceName() string {
+	if e == nil || e.DeviceName == nil {
 		return ""
 	}
-	return *g.Value
+	return *e.DeviceName
 }
 
-// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
+// GetDeviceNameOk returns a tuple with the DeviceName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionMarker) GetValueOk() (string, bool) {
-	if g == nil || g.Value == nil {
+func (e *Event) GetDeviceNameOk() (string, bool) {
+	if e == nil || e.DeviceName == nil {
 		return "", false
 	}
-	return *g.Value, true
+	return *e.DeviceName, true
 }
 
-// HasValue returns a boolean if a field has been set.
-func (g *GraphDefinitionMarker) HasValue() bool {
-	if g != nil && g.Value != nil {
+// HasDeviceName returns a boolean if a field has been set.
+func (e *Event) HasDeviceName() bool {
+	if e != nil && e.DeviceName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetValue allocates a new g.Value and returns the pointer to it.
-func (g *GraphDefinitionMarker) SetValue(v string) {
-	g.Value = &v
+// SetDeviceName allocates a new e.DeviceName and returns the pointer to it.
+func (e *Event) SetDeviceName(v string) {
+	e.DeviceName = &v
 }
 
-// GetAggregator returns the Aggregator field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetAggregator() string {
-	if g == nil || g.Aggregator == nil {
+// GetEventType returns the EventType field if non-nil, zero value otherwise.
+func (e *Event) GetEventType() string {
+	if e == nil || e.EventType == nil {
 		return ""
 	}
-	return *g.Aggregator
+	return *e.EventType
 }
 
-// GetAggregatorOk returns a tuple with the Aggregator field if it's non-nil, zero value otherwise
+// GetEventTypeOk returns a tuple with the EventType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetAggregatorOk() (string, bool) {
-	if g == nil || g.Aggregator == nil {
+func (e *Event) GetEventTypeOk() (string, bool) {
+	if e == nil || e.EventType == nil {
 		return "", false
 	}
-	return *g.Aggregator, true
+	return *e.EventType, true
 }
 
-// HasAggregator returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasAggregator() bool {
-	if g != nil && g.Aggregator != nil {
+// HasEventType returns a boolean if a field has been set.
+func (e *Event) HasEventType() bool {
+	if e != nil && e.EventType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAggregator allocates a new g.Aggregator and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetAggregator(v string) {
-	g.Aggregator = &v
+// SetEventType allocates a new e.EventType and returns the pointer to it.
+func (e *Event) SetEventType(v string) {
+	e.EventType = &v
 }
 
-// GetChangeType returns the ChangeType field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetChangeType() string {
-	if g == nil || g.ChangeType == nil {
+// GetHost returns the Host field if non-nil, zero value otherwise.
+func (e *Event) GetHost() string {
+	if e == nil || e.Host == nil {
 		return ""
 	}
-	return *g.ChangeType
+	return *e.Host
 }
 
-// GetChangeTypeOk returns a tuple with the ChangeType field if it's non-nil, zero value otherwise
+// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetChangeTypeOk() (string, bool) {
-	if g == nil || g.ChangeType == nil {
+func (e *Event) GetHostOk() (string, bool) {
+	if e == nil || e.Host == nil {
 		return "", false
 	}
-	return *g.ChangeType, true
+	return *e.Host, true
 }
 
-// HasChangeType returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasChangeType() bool {
-	if g != nil && g.ChangeType != nil {
+// HasHost returns a boolean if a field has been set.
+func (e *Event) HasHost() bool {
+	if e != nil && e.Host != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetChangeType allocates a new g.ChangeType and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetChangeType(v string) {
-	g.ChangeType = &v
+// SetHost allocates a new e.Host and returns the pointer to it.
+func (e *Event) SetHost(v string) {
+	e.Host = &v
 }
 
-// GetCompareTo returns the CompareTo field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetCompareTo() string {
-	if g == nil || g.CompareTo == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (e *Event) GetId() int {
+	if e == nil || e.Id == nil {
+		return 0
 	}
-	return *g.CompareTo
+	return *e.Id
 }
 
-// GetCompareToOk returns a tuple with the CompareTo field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetCompareToOk() (string, bool) {
-	if g == nil || g.CompareTo == nil {
-		return "", false
+func (e *Event) GetIdOk() (int, bool) {
+	if e == nil || e.Id == nil {
+		return 0, false
 	}
-	return *g.CompareTo, true
+	return *e.Id, true
 }
 
-// HasCompareTo returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasCompareTo() bool {
-	if g != nil && g.CompareTo != nil {
+// HasId returns a boolean if a field has been set.
+func (e *Event) HasId() bool {
+	if e != nil && e.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCompareTo allocates a new g.CompareTo and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetCompareTo(v string) {
-	g.CompareTo = &v
+// SetId allocates a new e.Id and returns the pointer to it.
+func (e *Event) SetId(v int) {
+	e.Id = &v
 }
 
-// GetExtraCol returns the ExtraCol field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetExtraCol() string {
-	if g == nil || g.ExtraCol == nil {
-		return ""
+// GetMonitorId returns the MonitorId field if non-nil, zero value otherwise.
+func (e *Event) GetMonitorId() int {
+	if e == nil || e.MonitorId == nil {
+		return 0
 	}
-	return *g.ExtraCol
+	return *e.MonitorId
 }
 
-// GetExtraColOk returns a tuple with the ExtraCol field if it's non-nil, zero value otherwise
+// GetMonitorIdOk returns a tuple with the MonitorId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetExtraColOk() (string, bool) {
-	if g == nil || g.ExtraCol == nil {
-		return "", false
+func (e *Event) GetMonitorIdOk() (int, bool) {
+	if e == nil || e.MonitorId == nil {
+		return 0, false
 	}
-	return *g.ExtraCol, true
+	return *e.MonitorId, true
 }
 
-// HasExtraCol returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasExtraCol() bool {
-	if g != nil && g.ExtraCol != nil {
+// HasMonitorId returns a boolean if a field has been set.
+func (e *Event) HasMonitorId() bool {
+	if e != nil && e.MonitorId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetExtraCol allocates a new g.ExtraCol and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetExtraCol(v string) {
-	g.ExtraCol = &v
+// SetMonitorId allocates a new e.MonitorId and returns the pointer to it.
+func (e *Event) SetMonitorId(v int) {
+	e.MonitorId = &v
 }
 
-// GetIncreaseGood returns the IncreaseGood field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetIncreaseGood() bool {
-	if g == nil || g.IncreaseGood == nil {
-		return false
+// GetPriority returns the Priority field if non-nil, zero value otherwise.
+func (e *Event) GetPriority() string {
+	if e == nil || e.Priority == nil {
+		return ""
 	}
-	return *g.IncreaseGood
+	return *e.Priority
 }
 
-// GetIncreaseGoodOk returns a tuple with the IncreaseGood field if it's non-nil, zero value otherwise
+// GetPriorityOk returns a tuple with the Priority field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetIncreaseGoodOk() (bool, bool) {
-	if g == nil || g.IncreaseGood == nil {
-		return false, false
+func (e *Event) GetPriorityOk() (string, bool) {
+	if e == nil || e.Priority == nil {
+		return "", false
 	}
-	return *g.IncreaseGood, true
+	return *e.Priority, true
 }
 
-// HasIncreaseGood returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasIncreaseGood() bool {
-	if g != nil && g.IncreaseGood != nil {
+// HasPriority returns a boolean if a field has been set.
+func (e *Event) HasPriority() bool {
+	if e != nil && e.Priority != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIncreaseGood allocates a new g.IncreaseGood and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetIncreaseGood(v bool) {
-	g.IncreaseGood = &v
+// SetPriority allocates a new e.Priority and returns the pointer to it.
+func (e *Event) SetPriority(v string) {
+	e.Priority = &v
 }
 
-// GetOrderBy returns the OrderBy field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetOrderBy() string {
-	if g == nil || g.OrderBy == nil {
-		return ""
+// GetRelatedEventId returns the RelatedEventId field if non-nil, zero value otherwise.
+func (e *Event) GetRelatedEventId() int {
+	if e == nil || e.RelatedEventId == nil {
+		return 0
 	}
-	return *g.OrderBy
+	return *e.RelatedEventId
 }
 
-// GetOrderByOk returns a tuple with the OrderBy field if it's non-nil, zero value otherwise
+// GetRelatedEventIdOk returns a tuple with the RelatedEventId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetOrderByOk() (string, bool) {
-	if g == nil || g.OrderBy == nil {
-		return "", false
+func (e *Event) GetRelatedEventIdOk() (int, bool) {
+	if e == nil || e.RelatedEventId == nil {
+		return 0, false
 	}
-	return *g.OrderBy, true
+	return *e.RelatedEventId, true
 }
 
-// HasOrderBy returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasOrderBy() bool {
-	if g != nil && g.OrderBy != nil {
+// HasRelatedEventId returns a boolean if a field has been set.
+func (e *Event) HasRelatedEventId() bool {
+	if e != nil && e.RelatedEventId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOrderBy allocates a new g.OrderBy and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetOrderBy(v string) {
-	g.OrderBy = &v
+// SetRelatedEventId allocates a new e.RelatedEventId and returns the pointer to it.
+func (e *Event) SetRelatedEventId(v int) {
+	e.RelatedEventId = &v
 }
 
-// GetOrderDirection returns the OrderDirection field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetOrderDirection() string {
-	if g == nil || g.OrderDirection == nil {
+// GetResource returns the Resource field if non-nil, zero value otherwise.
+func (e *Event) GetResource() string {
+	if e == nil || e.Resource == nil {
 		return ""
 	}
-	return *g.OrderDirection
+	return *e.Resource
 }
 
-// GetOrderDirectionOk returns a tuple with the OrderDirection field if it's non-nil, zero value otherwise
+// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetOrderDirectionOk() (string, bool) {
-	if g == nil || g.OrderDirection == nil {
+func (e *Event) GetResourceOk() (string, bool) {
+	if e == nil || e.Resource == nil {
 		return "", false
 	}
-	return *g.OrderDirection, true
+	return *e.Resource, true
 }
 
-// HasOrderDirection returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasOrderDirection() bool {
-	if g != nil && g.OrderDirection != nil {
+// HasResource returns a boolean if a field has been set.
+func (e *Event) HasResource() bool {
+	if e != nil && e.Resource != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOrderDirection allocates a new g.OrderDirection and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetOrderDirection(v string) {
-	g.OrderDirection = &v
+// SetResource allocates a new e.Resource and returns the pointer to it.
+func (e *Event) SetResource(v string) {
+	e.Resource = &v
 }
 
-// GetQuery returns the Query field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetQuery() string {
-	if g == nil || g.Query == nil {
+// GetSourceType returns the SourceType field if non-nil, zero value otherwise.
+func (e *Event) GetSourceType() string {
+	if e == nil || e.SourceType == nil {
 		return ""
 	}
-	return *g.Query
+	return *e.SourceType
 }
 
-// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// GetSourceTypeOk returns a tuple with the SourceType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetQueryOk() (string, bool) {
-	if g == nil || g.Query == nil {
+func (e *Event) GetSourceTypeOk() (string, bool) {
+	if e == nil || e.SourceType == nil {
 		return "", false
 	}
-	return *g.Query, true
+	return *e.SourceType, true
 }
 
-// HasQuery returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasQuery() bool {
-	if g != nil && g.Query != nil {
+// HasSourceType returns a boolean if a field has been set.
+func (e *Event) HasSourceType() bool {
+	if e != nil && e.SourceType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQuery allocates a new g.Query and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetQuery(v string) {
-	g.Query = &v
+// SetSourceType allocates a new e.SourceType and returns the pointer to it.
+func (e *Event) SetSourceType(v string) {
+	e.SourceType = &v
 }
 
-// GetStacked returns the Stacked field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetStacked() bool {
-	if g == nil || g.Stacked == nil {
-		return false
+// GetText returns the Text field if non-nil, zero value otherwise.
+func (e *Event) GetText() string {
+	if e == nil || e.Text == nil {
+		return ""
 	}
-	return *g.Stacked
+	return *e.Text
 }
 
-// GetStackedOk returns a tuple with the Stacked field if it's non-nil, zero value otherwise
+// GetTextOk returns a tuple with the Text field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetStackedOk() (bool, bool) {
-	if g == nil || g.Stacked == nil {
-		return false, false
+func (e *Event) GetTextOk() (string, bool) {
+	if e == nil || e.Text == nil {
+		return "", false
 	}
-	return *g.Stacked, true
+	return *e.Text, true
 }
 
-// HasStacked returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasStacked() bool {
-	if g != nil && g.Stacked != nil {
+// HasText returns a boolean if a field has been set.
+func (e *Event) HasText() bool {
+	if e != nil && e.Text != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStacked allocates a new g.Stacked and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetStacked(v bool) {
-	g.Stacked = &v
+// SetText allocates a new e.Text and returns the pointer to it.
+func (e *Event) SetText(v string) {
+	e.Text = &v
 }
 
-// GetStyle returns the Style field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetStyle() GraphDefinitionRequestStyle {
-	if g == nil || g.Style == nil {
-		return GraphDefinitionRequestStyle{}
+// GetTime returns the Time field if non-nil, zero value otherwise.
+func (e *Event) GetTime() int {
+	if e == nil || e.Time == nil {
+		return 0
 	}
-	return *g.Style
+	return *e.Time
 }
 
-// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
+// GetTimeOk returns a tuple with the Time field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetStyleOk() (GraphDefinitionRequestStyle, bool) {
-	if g == nil || g.Style == nil {
-		return GraphDefinitionRequestStyle{}, false
+func (e *Event) GetTimeOk() (int, bool) {
+	if e == nil || e.Time == nil {
+		return 0, false
 	}
-	return *g.Style, true
+	return *e.Time, true
 }
 
-// HasStyle returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasStyle() bool {
-	if g != nil && g.Style != nil {
+// HasTime returns a boolean if a field has been set.
+func (e *Event) HasTime() bool {
+	if e != nil && e.Time != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStyle allocates a new g.Style and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetStyle(v GraphDefinitionRequestStyle) {
-	g.Style = &v
+// SetTime allocates a new e.Time and returns the pointer to it.
+func (e *Event) SetTime(v int) {
+	e.Time = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequest) GetType() string {
-	if g == nil || g.Type == nil {
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (e *Event) GetTitle() string {
+	if e == nil || e.Title == nil {
 		return ""
 	}
-	return *g.Type
+	return *e.Title
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequest) GetTypeOk() (string, bool) {
-	if g == nil || g.Type == nil {
+func (e *Event) GetTitleOk() (string, bool) {
+	if e == nil || e.Title == nil {
 		return "", false
 	}
-	return *g.Type, true
+	return *e.Title, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (g *GraphDefinitionRequest) HasType() bool {
-	if g != nil && g.Type != nil {
+// HasTitle returns a boolean if a field has been set.
+func (e *Event) HasTitle() bool {
+	if e != nil && e.Title != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new g.Type and returns the pointer to it.
-func (g *GraphDefinitionRequest) SetType(v string) {
-	g.Type = &v
+// SetTitle allocates a new e.Title and returns the pointer to it.
+func (e *Event) SetTitle(v string) {
+	e.Title = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequestStyle) GetPalette() string {
-	if g == nil || g.Palette == nil {
+// GetUrl returns the Url field if non-nil, zero value otherwise.
+func (e *Event) GetUrl() string {
+	if e == nil || e.Url == nil {
 		return ""
 	}
-	return *g.Palette
+	return *e.Url
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequestStyle) GetPaletteOk() (string, bool) {
-	if g == nil || g.Palette == nil {
+func (e *Event) GetUrlOk() (string, bool) {
+	if e == nil || e.Url == nil {
 		return "", false
 	}
-	return *g.Palette, true
+	return *e.Url, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (g *GraphDefinitionRequestStyle) HasPalette() bool {
-	if g != nil && g.Palette != nil {
+// HasUrl returns a boolean if a field has been set.
+func (e *Event) HasUrl() bool {
+	if e != nil && e.Url != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new g.Palette and returns the pointer to it.
-func (g *GraphDefinitionRequestStyle) SetPalette(v string) {
-	g.Palette = &v
+// SetUrl allocates a new e.Url and returns the pointer to it.
+func (e *Event) SetUrl(v string) {
+	e.Url = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequestStyle) GetType() string {
-	if g == nil || g.Type == nil {
-		return ""
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (e *ExclusionFilter) GetFilter() ExclusionFilterQuery {
+	if e == nil || e.Filter == nil {
+		return ExclusionFilterQuery{}
 	}
-	return *g.Type
+	return *e.Filter
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequestStyle) GetTypeOk() (string, bool) {
-	if g == nil || g.Type == nil {
-		return "", false
+func (e *ExclusionFilter) GetFilterOk() (ExclusionFilterQuery, bool) {
+	if e == nil || e.Filter == nil {
+		return ExclusionFilterQuery{}, false
 	}
-	return *g.Type, true
+	return *e.Filter, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (g *GraphDefinitionRequestStyle) HasType() bool {
-	if g != nil && g.Type != nil {
+// HasFilter returns a boolean if a field has been set.
+func (e *ExclusionFilter) HasFilter() bool {
+	if e != nil && e.Filter != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new g.Type and returns the pointer to it.
-func (g *GraphDefinitionRequestStyle) SetType(v string) {
-	g.Type = &v
+// SetFilter allocates a new e.Filter and returns the pointer to it.
+func (e *ExclusionFilter) SetFilter(v ExclusionFilterQuery) {
+	e.Filter = &v
 }
 
-// GetWidth returns the Width field if non-nil, zero value otherwise.
-func (g *GraphDefinitionRequestStyle) GetWidth() string {
-	if g == nil || g.Width == nil {
-		return ""
+// GetIsEnabled returns the IsEnabled field if non-nil, zero value otherwise.
+func (e *ExclusionFilter) GetIsEnabled() bool {
+	if e == nil || e.IsEnabled == nil {
+		return false
 	}
-	return *g.Width
+	return *e.IsEnabled
 }
 
-// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
+// GetIsEnabledOk returns a tuple with the IsEnabled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphDefinitionRequestStyle) GetWidthOk() (string, bool) {
-	if g == nil || g.Width == nil {
-		return "", false
+func (e *ExclusionFilter) GetIsEnabledOk() (bool, bool) {
+	if e == nil || e.IsEnabled == nil {
+		return false, false
 	}
-	return *g.Width, true
+	return *e.IsEnabled, true
 }
 
-// HasWidth returns a boolean if a field has been set.
-func (g *GraphDefinitionRequestStyle) HasWidth() bool {
-	if g != nil && g.Width != nil {
+// HasIsEnabled returns a boolean if a field has been set.
+func (e *ExclusionFilter) HasIsEnabled() bool {
+	if e != nil && e.IsEnabled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetWidth allocates a new g.Width and returns the pointer to it.
-func (g *GraphDefinitionRequestStyle) SetWidth(v string) {
-	g.Width = &v
+// SetIsEnabled allocates a new e.IsEnabled and returns the pointer to it.
+func (e *ExclusionFilter) SetIsEnabled(v bool) {
+	e.IsEnabled = &v
 }
 
-// GetQuery returns the Query field if non-nil, zero value otherwise.
-func (g *GraphEvent) GetQuery() string {
-	if g == nil || g.Query == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (e *ExclusionFilter) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *g.Query
+	return *e.Name
 }
 
-// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GraphEvent) GetQueryOk() (string, bool) {
-	if g == nil || g.Query == nil {
+func (e *ExclusionFilter) GetNameOk() (string, bool) {
+	if e == nil || e.Name == nil {
 		return "", false
 	}
-	return *g.Query, true
+	return *e.Name, true
 }
 
-// HasQuery returns a boolean if a field has been set.
-func (g *GraphEvent) HasQuery() bool {
-	if g != nil && g.Query != nil {
+// HasName returns a boolean if a field has been set.
+func (e *ExclusionFilter) HasName() bool {
+	if e != nil && e.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQuery allocates a new g.Query and returns the pointer to it.
-func (g *GraphEvent) SetQuery(v string) {
-	g.Query = &v
+// SetName allocates a new e.Name and returns the pointer to it.
+func (e *ExclusionFilter) SetName(v string) {
+	e.Name = &v
 }
 
-// GetLastNoDataTs returns the LastNoDataTs field if non-nil, zero value otherwise.
-func (g *GroupData) GetLastNoDataTs() int {
-	if g == nil || g.LastNoDataTs == nil {
-		return 0
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (e *ExclusionFilterQuery) GetQuery() string {
+	if e == nil || e.Query == nil {
+		return ""
 	}
-	return *g.LastNoDataTs
+	return *e.Query
 }
 
-// GetLastNoDataTsOk returns a tuple with the LastNoDataTs field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetLastNoDataTsOk() (int, bool) {
-	if g == nil || g.LastNoDataTs == nil {
-		return 0, false
+func (e *ExclusionFilterQuery) GetQueryOk() (string, bool) {
+	if e == nil || e.Query == nil {
+		return "", false
 	}
-	return *g.LastNoDataTs, true
+	return *e.Query, true
 }
 
-// HasLastNoDataTs returns a boolean if a field has been set.
-func (g *GroupData) HasLastNoDataTs() bool {
-	if g != nil && g.LastNoDataTs != nil {
+// HasQuery returns a boolean if a field has been set.
+func (e *ExclusionFilterQuery) HasQuery() bool {
+	if e != nil && e.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLastNoDataTs allocates a new g.LastNoDataTs and returns the pointer to it.
-func (g *GroupData) SetLastNoDataTs(v int) {
-	g.LastNoDataTs = &v
+// SetQuery allocates a new e.Query and returns the pointer to it.
+func (e *ExclusionFilterQuery) SetQuery(v string) {
+	e.Query = &v
 }
 
-// GetLastNotifiedTs returns the LastNotifiedTs field if non-nil, zero value otherwise.
-func (g *GroupData) GetLastNotifiedTs() int {
-	if g == nil || g.LastNotifiedTs == nil {
+// GetSampleRate returns the SampleRate field if non-nil, zero value otherwise.
+func (e *ExclusionFilterQuery) GetSampleRate() float64 {
+	if e == nil || e.SampleRate == nil {
 		return 0
 	}
-	return *g.LastNotifiedTs
+	return *e.SampleRate
 }
 
-// GetLastNotifiedTsOk returns a tuple with the LastNotifiedTs field if it's non-nil, zero value otherwise
+// GetSampleRateOk returns a tuple with the SampleRate field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetLastNotifiedTsOk() (int, bool) {
-	if g == nil || g.LastNotifiedTs == nil {
+func (e *ExclusionFilterQuery) GetSampleRateOk() (float64, bool) {
+	if e == nil || e.SampleRate == nil {
 		return 0, false
 	}
-	return *g.LastNotifiedTs, true
+	return *e.SampleRate, true
 }
 
-// HasLastNotifiedTs returns a boolean if a field has been set.
-func (g *GroupData) HasLastNotifiedTs() bool {
-	if g != nil && g.LastNotifiedTs != nil {
+// HasSampleRate returns a boolean if a field has been set.
+func (e *ExclusionFilterQuery) HasSampleRate() bool {
+	if e != nil && e.SampleRate != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLastNotifiedTs allocates a new g.LastNotifiedTs and returns the pointer to it.
-func (g *GroupData) SetLastNotifiedTs(v int) {
-	g.LastNotifiedTs = &v
+// SetSampleRate allocates a new e.SampleRate and returns the pointer to it.
+func (e *ExclusionFilterQuery) SetSampleRate(v float64) {
+	e.SampleRate = &v
 }
 
-// GetLastResolvedTs returns the LastResolvedTs field if non-nil, zero value otherwise.
-func (g *GroupData) GetLastResolvedTs() int {
-	if g == nil || g.LastResolvedTs == nil {
-		return 0
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (f *FilterConfiguration) GetQuery() string {
+	if f == nil || f.Query == nil {
+		return ""
 	}
-	return *g.LastResolvedTs
+	return *f.Query
 }
 
-// GetLastResolvedTsOk returns a tuple with the LastResolvedTs field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetLastResolvedTsOk() (int, bool) {
-	if g == nil || g.LastResolvedTs == nil {
-		return 0, false
+func (f *FilterConfiguration) GetQueryOk() (string, bool) {
+	if f == nil || f.Query == nil {
+		return "", false
 	}
-	return *g.LastResolvedTs, true
+	return *f.Query, true
 }
 
-// HasLastResolvedTs returns a boolean if a field has been set.
-func (g *GroupData) HasLastResolvedTs() bool {
-	if g != nil && g.LastResolvedTs != nil {
+// HasQuery returns a boolean if a field has been set.
+func (f *FilterConfiguration) HasQuery() bool {
+	if f != nil && f.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLastResolvedTs allocates a new g.LastResolvedTs and returns the pointer to it.
-func (g *GroupData) SetLastResolvedTs(v int) {
-	g.LastResolvedTs = &v
+// SetQuery allocates a new f.Query and returns the pointer to it.
+func (f *FilterConfiguration) SetQuery(v string) {
+	f.Query = &v
 }
 
-// GetLastTriggeredTs returns the LastTriggeredTs field if non-nil, zero value otherwise.
-func (g *GroupData) GetLastTriggeredTs() int {
-	if g == nil || g.LastTriggeredTs == nil {
-		return 0
+// GetDefinition returns the Definition field if non-nil, zero value otherwise.
+func (g *Graph) GetDefinition() GraphDefinition {
+	if g == nil || g.Definition == nil {
+		return GraphDefinition{}
 	}
-	return *g.LastTriggeredTs
+	return *g.Definition
 }
 
-// GetLastTriggeredTsOk returns a tuple with the LastTriggeredTs field if it's non-nil, zero value otherwise
+// GetDefinitionOk returns a tuple with the Definition field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetLastTriggeredTsOk() (int, bool) {
-	if g == nil || g.LastTriggeredTs == nil {
-		return 0, false
+func (g *Graph) GetDefinitionOk() (GraphDefinition, bool) {
+	if g == nil || g.Definition == nil {
+		return GraphDefinition{}, false
 	}
-	return *g.LastTriggeredTs, true
+	return *g.Definition, true
 }
 
-// HasLastTriggeredTs returns a boolean if a field has been set.
-func (g *GroupData) HasLastTriggeredTs() bool {
-	if g != nil && g.LastTriggeredTs != nil {
+// HasDefinition returns a boolean if a field has been set.
+func (g *Graph) HasDefinition() bool {
+	if g != nil && g.Definition != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLastTriggeredTs allocates a new g.LastTriggeredTs and returns the pointer to it.
-func (g *GroupData) SetLastTriggeredTs(v int) {
-	g.LastTriggeredTs = &v
+// SetDefinition allocates a new g.Definition and returns the pointer to it.
+func (g *Graph) SetDefinition(v GraphDefinition) {
+	g.Definition = &v
 }
 
-// GetName returns the Name field if non-nil, zero value otherwise.
-func (g *GroupData) GetName() string {
-	if g == nil || g.Name == nil {
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (g *Graph) GetTitle() string {
+	if g == nil || g.Title == nil {
 		return ""
 	}
-	return *g.Name
+	return *g.Title
 }
 
-// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetNameOk() (string, bool) {
-	if g == nil || g.Name == nil {
+func (g *Graph) GetTitleOk() (string, bool) {
+	if g == nil || g.Title == nil {
 		return "", false
 	}
-	return *g.Name, true
+	return *g.Title, true
 }
 
-// HasName returns a boolean if a field has been set.
-func (g *GroupData) HasName() bool {
-	if g != nil && g.Name != nil {
+// HasTitle returns a boolean if a field has been set.
+func (g *Graph) HasTitle() bool {
+	if g != nil && g.Title != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new g.Name and returns the pointer to it.
-func (g *GroupData) SetName(v string) {
-	g.Name = &v
+// SetTitle allocates a new g.Title and returns the pointer to it.
+func (g *Graph) SetTitle(v string) {
+	g.Title = &v
 }
 
-// GetStatus returns the Status field if non-nil, zero value otherwise.
-func (g *GroupData) GetStatus() string {
-	if g == nil || g.Status == nil {
-		return ""
+// GetAutoscale returns the Autoscale field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetAutoscale() bool {
+	if g == nil || g.Autoscale == nil {
+		return false
 	}
-	return *g.Status
+	return *g.Autoscale
 }
 
-// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
+// GetAutoscaleOk returns a tuple with the Autoscale field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetStatusOk() (string, bool) {
-	if g == nil || g.Status == nil {
-		return "", false
+func (g *GraphDefinition) GetAutoscaleOk() (bool, bool) {
+	if g == nil || g.Autoscale == nil {
+		return false, false
 	}
-	return *g.Status, true
+	return *g.Autoscale, true
 }
 
-// HasStatus returns a boolean if a field has been set.
-func (g *GroupData) HasStatus() bool {
-	if g != nil && g.Status != nil {
+// HasAutoscale returns a boolean if a field has been set.
+func (g *GraphDefinition) HasAutoscale() bool {
+	if g != nil && g.Autoscale != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStatus allocates a new g.Status and returns the pointer to it.
-func (g *GroupData) SetStatus(v string) {
-	g.Status = &v
+// SetAutoscale allocates a new g.Autoscale and returns the pointer to it.
+func (g *GraphDefinition) SetAutoscale(v bool) {
+	g.Autoscale = &v
 }
 
-// GetTriggeringValue returns the TriggeringValue field if non-nil, zero value otherwise.
-func (g *GroupData) GetTriggeringValue() TriggeringValue {
-	if g == nil || g.TriggeringValue == nil {
-		return TriggeringValue{}
+// GetCustomUnit returns the CustomUnit field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetCustomUnit() string {
+	if g == nil || g.CustomUnit == nil {
+		return ""
 	}
-	return *g.TriggeringValue
+	return *g.CustomUnit
 }
 
-// GetTriggeringValueOk returns a tuple with the TriggeringValue field if it's non-nil, zero value otherwise
+// GetCustomUnitOk returns a tuple with the CustomUnit field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (g *GroupData) GetTriggeringValueOk() (TriggeringValue, bool) {
-	if g == nil || g.TriggeringValue == nil {
-		return TriggeringValue{}, false
-	}
-	return *g.TriggeringValue, true
+func (g *GraphDefinition) GetCustomUnitOk() (string, bool) {
+	if g == nil || g.CustomUnit == nil {
+		return "", false
+	}
+	return *g.CustomUnit, true
 }
 
-// HasTriggeringValue returns a boolean if a field has been set.
-func (g *GroupData) HasTriggeringValue() bool {
-	if g != nil && g.TriggeringValue != nil {
+// HasCustomUnit returns a boolean if a field has been set.
+func (g *GraphDefinition) HasCustomUnit() bool {
+	if g != nil && g.CustomUnit != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTriggeringValue allocates a new g.TriggeringValue and returns the pointer to it.
-func (g *GroupData) SetTriggeringValue(v TriggeringValue) {
-	g.TriggeringValue = &v
+// SetCustomUnit allocates a new g.CustomUnit and returns the pointer to it.
+func (g *GraphDefinition) SetCustomUnit(v string) {
+	g.CustomUnit = &v
 }
 
-// GetEndTime returns the EndTime field if non-nil, zero value otherwise.
-func (h *HostActionMute) GetEndTime() string {
-	if h == nil || h.EndTime == nil {
-		return ""
+// GetIncludeNoMetricHosts returns the IncludeNoMetricHosts field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetIncludeNoMetricHosts() bool {
+	if g == nil || g.IncludeNoMetricHosts == nil {
+		return false
 	}
-	return *h.EndTime
+	return *g.IncludeNoMetricHosts
 }
 
-// GetEndTimeOk returns a tuple with the EndTime field if it's non-nil, zero value otherwise
+// GetIncludeNoMetricHostsOk returns a tuple with the IncludeNoMetricHosts field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (h *HostActionMute) GetEndTimeOk() (string, bool) {
-	if h == nil || h.EndTime == nil {
-		return "", false
+func (g *GraphDefinition) GetIncludeNoMetricHostsOk() (bool, bool) {
+	if g == nil || g.IncludeNoMetricHosts == nil {
+		return false, false
 	}
-	return *h.EndTime, true
+	return *g.IncludeNoMetricHosts, true
 }
 
-// HasEndTime returns a boolean if a field has been set.
-func (h *HostActionMute) HasEndTime() bool {
-	if h != nil && h.EndTime != nil {
+// HasIncludeNoMetricHosts returns a boolean if a field has been set.
+func (g *GraphDefinition) HasIncludeNoMetricHosts() bool {
+	if g != nil && g.IncludeNoMetricHosts != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEndTime allocates a new h.EndTime and returns the pointer to it.
-func (h *HostActionMute) SetEndTime(v string) {
-	h.EndTime = &v
+// SetIncludeNoMetricHosts allocates a new g.IncludeNoMetricHosts and returns the pointer to it.
+func (g *GraphDefinition) SetIncludeNoMetricHosts(v bool) {
+	g.IncludeNoMetricHosts = &v
 }
 
-// GetMessage returns the Message field if non-nil, zero value otherwise.
-func (h *HostActionMute) GetMessage() string {
-	if h == nil || h.Message == nil {
-		return ""
+// GetIncludeUngroupedHosts returns the IncludeUngroupedHosts field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetIncludeUngroupedHosts() bool {
+	if g == nil || g.IncludeUngroupedHosts == nil {
+		return false
 	}
-	return *h.Message
+	return *g.IncludeUngroupedHosts
 }
 
-// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// GetIncludeUngroupedHostsOk returns a tuple with the IncludeUngroupedHosts field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (h *HostActionMute) GetMessageOk() (string, bool) {
-	if h == nil || h.Message == nil {
-		return "", false
+func (g *GraphDefinition) GetIncludeUngroupedHostsOk() (bool, bool) {
+	if g == nil || g.IncludeUngroupedHosts == nil {
+		return false, false
 	}
-	return *h.Message, true
+	return *g.IncludeUngroupedHosts, true
 }
 
-// HasMessage returns a boolean if a field has been set.
-func (h *HostActionMute) HasMessage() bool {
-	if h != nil && h.Message != nil {
+// HasIncludeUngroupedHosts returns a boolean if a field has been set.
+func (g *GraphDefinition) HasIncludeUngroupedHosts() bool {
+	if g != nil && g.IncludeUngroupedHosts != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMessage allocates a new h.Message and returns the pointer to it.
-func (h *HostActionMute) SetMessage(v string) {
-	h.Message = &v
+// SetIncludeUngroupedHosts allocates a new g.IncludeUngroupedHosts and returns the pointer to it.
+func (g *GraphDefinition) SetIncludeUngroupedHosts(v bool) {
+	g.IncludeUngroupedHosts = &v
 }
 
-// GetOverride returns the Override field if non-nil, zero value otherwise.
-func (h *HostActionMute) GetOverride() bool {
-	if h == nil || h.Override == nil {
-		return false
+// GetNodeType returns the NodeType field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetNodeType() string {
+	if g == nil || g.NodeType == nil {
+		return ""
 	}
-	return *h.Override
+	return *g.NodeType
 }
 
-// GetOverrideOk returns a tuple with the Override field if it's non-nil, zero value otherwise
+// GetNodeTypeOk returns a tuple with the NodeType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (h *HostActionMute) GetOverrideOk() (bool, bool) {
-	if h == nil || h.Override == nil {
-		return false, false
+func (g *GraphDefinition) GetNodeTypeOk() (string, bool) {
+	if g == nil || g.NodeType == nil {
+		return "", false
 	}
-	return *h.Override, true
+	return *g.NodeType, true
 }
 
-// HasOverride returns a boolean if a field has been set.
-func (h *HostActionMute) HasOverride() bool {
-	if h != nil && h.Override != nil {
+// HasNodeType returns a boolean if a field has been set.
+func (g *GraphDefinition) HasNodeType() bool {
+	if g != nil && g.NodeType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOverride allocates a new h.Override and returns the pointer to it.
-func (h *HostActionMute) SetOverride(v bool) {
-	h.Override = &v
+// SetNodeType allocates a new g.NodeType and returns the pointer to it.
+func (g *GraphDefinition) SetNodeType(v string) {
+	g.NodeType = &v
 }
 
-// GetAccountID returns the AccountID field if non-nil, zero value otherwise.
-func (i *IntegrationAWSAccount) GetAccountID() string {
-	if i == nil || i.AccountID == nil {
+// GetPrecision returns the Precision field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetPrecision() PrecisionT {
+	if g == nil || g.Precision == nil {
 		return ""
 	}
-	return *i.AccountID
+	return *g.Precision
 }
 
-// GetAccountIDOk returns a tuple with the AccountID field if it's non-nil, zero value otherwise
+// GetPrecisionOk returns a tuple with the Precision field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationAWSAccount) GetAccountIDOk() (string, bool) {
-	if i == nil || i.AccountID == nil {
+func (g *GraphDefinition) GetPrecisionOk() (PrecisionT, bool) {
+	if g == nil || g.Precision == nil {
 		return "", false
 	}
-	return *i.AccountID, true
+	return *g.Precision, true
 }
 
-// HasAccountID returns a boolean if a field has been set.
-func (i *IntegrationAWSAccount) HasAccountID() bool {
-	if i != nil && i.AccountID != nil {
+// HasPrecision returns a boolean if a field has been set.
+func (g *GraphDefinition) HasPrecision() bool {
+	if g != nil && g.Precision != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccountID allocates a new i.AccountID and returns the pointer to it.
-func (i *IntegrationAWSAccount) SetAccountID(v string) {
-	i.AccountID = &v
+// SetPrecision allocates a new g.Precision and returns the pointer to it.
+func (g *GraphDefinition) SetPrecision(v PrecisionT) {
+	g.Precision = &v
 }
 
-// GetRoleName returns the RoleName field if non-nil, zero value otherwise.
-func (i *IntegrationAWSAccount) GetRoleName() string {
-	if i == nil || i.RoleName == nil {
-		return ""
+// GetStyle returns the Style field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetStyle() Style {
+	if g == nil || g.Style == nil {
+		return Style{}
 	}
-	return *i.RoleName
+	return *g.Style
 }
 
-// GetRoleNameOk returns a tuple with the RoleName field if it's non-nil, zero value otherwise
+// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationAWSAccount) GetRoleNameOk() (string, bool) {
-	if i == nil || i.RoleName == nil {
-		return "", false
+func (g *GraphDefinition) GetStyleOk() (Style, bool) {
+	if g == nil || g.Style == nil {
+		return Style{}, false
 	}
-	return *i.RoleName, true
+	return *g.Style, true
 }
 
-// HasRoleName returns a boolean if a field has been set.
-func (i *IntegrationAWSAccount) HasRoleName() bool {
-	if i != nil && i.RoleName != nil {
+// HasStyle returns a boolean if a field has been set.
+func (g *GraphDefinition) HasStyle() bool {
+	if g != nil && g.Style != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRoleName allocates a new i.RoleName and returns the pointer to it.
-func (i *IntegrationAWSAccount) SetRoleName(v string) {
-	i.RoleName = &v
+// SetStyle allocates a new g.Style and returns the pointer to it.
+func (g *GraphDefinition) SetStyle(v Style) {
+	g.Style = &v
 }
 
-// GetAccountID returns the AccountID field if non-nil, zero value otherwise.
-func (i *IntegrationAWSAccountDeleteRequest) GetAccountID() string {
-	if i == nil || i.AccountID == nil {
+// GetTextAlign returns the TextAlign field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetTextAlign() string {
+	if g == nil || g.TextAlign == nil {
 		return ""
 	}
-	return *i.AccountID
+	return *g.TextAlign
 }
 
-// GetAccountIDOk returns a tuple with the AccountID field if it's non-nil, zero value otherwise
+// GetTextAlignOk returns a tuple with the TextAlign field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationAWSAccountDeleteRequest) GetAccountIDOk() (string, bool) {
-	if i == nil || i.AccountID == nil {
+func (g *GraphDefinition) GetTextAlignOk() (string, bool) {
+	if g == nil || g.TextAlign == nil {
 		return "", false
 	}
-	return *i.AccountID, true
+	return *g.TextAlign, true
 }
 
-// HasAccountID returns a boolean if a field has been set.
-func (i *IntegrationAWSAccountDeleteRequest) HasAccountID() bool {
-	if i != nil && i.AccountID != nil {
+// HasTextAlign returns a boolean if a field has been set.
+func (g *GraphDefinition) HasTextAlign() bool {
+	if g != nil && g.TextAlign != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccountID allocates a new i.AccountID and returns the pointer to it.
-func (i *IntegrationAWSAccountDeleteRequest) SetAccountID(v string) {
-	i.AccountID = &v
+// SetTextAlign allocates a new g.TextAlign and returns the pointer to it.
+func (g *GraphDefinition) SetTextAlign(v string) {
+	g.TextAlign = &v
 }
 
-// GetRoleName returns the RoleName field if non-nil, zero value otherwise.
-func (i *IntegrationAWSAccountDeleteRequest) GetRoleName() string {
-	if i == nil || i.RoleName == nil {
+// GetViz returns the Viz field if non-nil, zero value otherwise.
+func (g *GraphDefinition) GetViz() string {
+	if g == nil || g.Viz == nil {
 		return ""
 	}
-	return *i.RoleName
+	return *g.Viz
 }
 
-// GetRoleNameOk returns a tuple with the RoleName field if it's non-nil, zero value otherwise
+// GetVizOk returns a tuple with the Viz field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationAWSAccountDeleteRequest) GetRoleNameOk() (string, bool) {
-	if i == nil || i.RoleName == nil {
+func (g *GraphDefinition) GetVizOk() (string, bool) {
+	if g == nil || g.Viz == nil {
 		return "", false
 	}
-	return *i.RoleName, true
+	return *g.Viz, true
 }
 
-// HasRoleName returns a boolean if a field has been set.
-func (i *IntegrationAWSAccountDeleteRequest) HasRoleName() bool {
-	if i != nil && i.RoleName != nil {
+// HasViz returns a boolean if a field has been set.
+func (g *GraphDefinition) HasViz() bool {
+	if g != nil && g.Viz != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRoleName allocates a new i.RoleName and returns the pointer to it.
-func (i *IntegrationAWSAccountDeleteRequest) SetRoleName(v string) {
-	i.RoleName = &v
+// SetViz allocates a new g.Viz and returns the pointer to it.
+func (g *GraphDefinition) SetViz(v string) {
+	g.Viz = &v
 }
 
-// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
-func (i *IntegrationGCP) GetClientEmail() string {
-	if i == nil || i.ClientEmail == nil {
+// GetLabel returns the Label field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetLabel() string {
+	if g == nil || g.Label == nil {
 		return ""
 	}
-	return *i.ClientEmail
+	return *g.Label
 }
 
-// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// GetLabelOk returns a tuple with the Label field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCP) GetClientEmailOk() (string, bool) {
-	if i == nil || i.ClientEmail == nil {
+func (g *GraphDefinitionMarker) GetLabelOk() (string, bool) {
+	if g == nil || g.Label == nil {
 		return "", false
 	}
-	return *i.ClientEmail, true
+	return *g.Label, true
 }
 
-// HasClientEmail returns a boolean if a field has been set.
-func (i *IntegrationGCP) HasClientEmail() bool {
-	if i != nil && i.ClientEmail != nil {
+// HasLabel returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasLabel() bool {
+	if g != nil && g.Label != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
-func (i *IntegrationGCP) SetClientEmail(v string) {
-	i.ClientEmail = &v
-}
+// SetLabel allocates a new g.Label and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetLabel(v string) {
+	g.Label = &v
+}
 
-// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
-func (i *IntegrationGCP) GetHostFilters() string {
-	if i == nil || i.HostFilters == nil {
+// GetMax returns the Max field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetMax() json.Number {
+	if g == nil || g.Max == nil {
 		return ""
 	}
-	return *i.HostFilters
+	return *g.Max
 }
 
-// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// GetMaxOk returns a tuple with the Max field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCP) GetHostFiltersOk() (string, bool) {
-	if i == nil || i.HostFilters == nil {
+func (g *GraphDefinitionMarker) GetMaxOk() (json.Number, bool) {
+	if g == nil || g.Max == nil {
 		return "", false
 	}
-	return *i.HostFilters, true
+	return *g.Max, true
 }
 
-// HasHostFilters returns a boolean if a field has been set.
-func (i *IntegrationGCP) HasHostFilters() bool {
-	if i != nil && i.HostFilters != nil {
+// HasMax returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasMax() bool {
+	if g != nil && g.Max != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
-func (i *IntegrationGCP) SetHostFilters(v string) {
-	i.HostFilters = &v
+// SetMax allocates a new g.Max and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetMax(v json.Number) {
+	g.Max = &v
 }
 
-// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
-func (i *IntegrationGCP) GetProjectID() string {
-	if i == nil || i.ProjectID == nil {
+// GetMin returns the Min field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetMin() json.Number {
+	if g == nil || g.Min == nil {
 		return ""
 	}
-	return *i.ProjectID
+	return *g.Min
 }
 
-// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// GetMinOk returns a tuple with the Min field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCP) GetProjectIDOk() (string, bool) {
-	if i == nil || i.ProjectID == nil {
+func (g *GraphDefinitionMarker) GetMinOk() (json.Number, bool) {
+	if g == nil || g.Min == nil {
 		return "", false
 	}
-	return *i.ProjectID, true
+	return *g.Min, true
 }
 
-// HasProjectID returns a boolean if a field has been set.
-func (i *IntegrationGCP) HasProjectID() bool {
-	if i != nil && i.ProjectID != nil {
+// HasMin returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasMin() bool {
+	if g != nil && g.Min != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
-func (i *IntegrationGCP) SetProjectID(v string) {
-	i.ProjectID = &v
+// SetMin allocates a new g.Min and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetMin(v json.Number) {
+	g.Min = &v
 }
 
-// GetAuthProviderX509CertURL returns the AuthProviderX509CertURL field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetAuthProviderX509CertURL() string {
-	if i == nil || i.AuthProviderX509CertURL == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.AuthProviderX509CertURL
+	return *g.Type
 }
 
-// GetAuthProviderX509CertURLOk returns a tuple with the AuthProviderX509CertURL field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetAuthProviderX509CertURLOk() (string, bool) {
-	if i == nil || i.AuthProviderX509CertURL == nil {
+func (g *GraphDefinitionMarker) GetTypeOk() (string, bool) {
+	if g == nil || g.Type == nil {
 		return "", false
 	}
-	return *i.AuthProviderX509CertURL, true
+	return *g.Type, true
 }
 
-// HasAuthProviderX509CertURL returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasAuthProviderX509CertURL() bool {
-	if i != nil && i.AuthProviderX509CertURL != nil {
+// HasType returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasType() bool {
+	if g != nil && g.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAuthProviderX509CertURL allocates a new i.AuthProviderX509CertURL and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetAuthProviderX509CertURL(v string) {
-	i.AuthProviderX509CertURL = &v
+// SetType allocates a new g.Type and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetType(v string) {
+	g.Type = &v
 }
 
-// GetAuthURI returns the AuthURI field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetAuthURI() string {
-	if i == nil || i.AuthURI == nil {
+// GetVal returns the Val field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetVal() json.Number {
+	if g == nil || g.Val == nil {
 		return ""
 	}
-	return *i.AuthURI
+	return *g.Val
 }
 
-// GetAuthURIOk returns a tuple with the AuthURI field if it's non-nil, zero value otherwise
+// GetValOk returns a tuple with the Val field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetAuthURIOk() (string, bool) {
-	if i == nil || i.AuthURI == nil {
+func (g *GraphDefinitionMarker) GetValOk() (json.Number, bool) {
+	if g == nil || g.Val == nil {
 		return "", false
 	}
-	return *i.AuthURI, true
+	return *g.Val, true
 }
 
-// HasAuthURI returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasAuthURI() bool {
-	if i != nil && i.AuthURI != nil {
+// HasVal returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasVal() bool {
+	if g != nil && g.Val != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAuthURI allocates a new i.AuthURI and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetAuthURI(v string) {
-	i.AuthURI = &v
+// SetVal allocates a new g.Val and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetVal(v json.Number) {
+	g.Val = &v
 }
 
-// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetClientEmail() string {
-	if i == nil || i.ClientEmail == nil {
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (g *GraphDefinitionMarker) GetValue() string {
+	if g == nil || g.Value == nil {
 		return ""
 	}
-	return *i.ClientEmail
+	return *g.Value
 }
 
-// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetClientEmailOk() (string, bool) {
-	if i == nil || i.ClientEmail == nil {
+func (g *GraphDefinitionMarker) GetValueOk() (string, bool) {
+	if g == nil || g.Value == nil {
 		return "", false
 	}
-	return *i.ClientEmail, true
+	return *g.Value, true
 }
 
-// HasClientEmail returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasClientEmail() bool {
-	if i != nil && i.ClientEmail != nil {
+// HasValue returns a boolean if a field has been set.
+func (g *GraphDefinitionMarker) HasValue() bool {
+	if g != nil && g.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetClientEmail(v string) {
-	i.ClientEmail = &v
+// SetValue allocates a new g.Value and returns the pointer to it.
+func (g *GraphDefinitionMarker) SetValue(v string) {
+	g.Value = &v
 }
 
-// GetClientID returns the ClientID field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetClientID() string {
-	if i == nil || i.ClientID == nil {
+// GetAggregator returns the Aggregator field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetAggregator() string {
+	if g == nil || g.Aggregator == nil {
 		return ""
 	}
-	return *i.ClientID
+	return *g.Aggregator
 }
 
-// GetClientIDOk returns a tuple with the ClientID field if it's non-nil, zero value otherwise
+// GetAggregatorOk returns a tuple with the Aggregator field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetClientIDOk() (string, bool) {
-	if i == nil || i.ClientID == nil {
+func (g *GraphDefinitionRequest) GetAggregatorOk() (string, bool) {
+	if g == nil || g.Aggregator == nil {
 		return "", false
 	}
-	return *i.ClientID, true
+	return *g.Aggregator, true
 }
 
-// HasClientID returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasClientID() bool {
-	if i != nil && i.ClientID != nil {
+// HasAggregator returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasAggregator() bool {
+	if g != nil && g.Aggregator != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientID allocates a new i.ClientID and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetClientID(v string) {
-	i.ClientID = &v
+// SetAggregator allocates a new g.Aggregator and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetAggregator(v string) {
+	g.Aggregator = &v
 }
 
-// GetClientX509CertURL returns the ClientX509CertURL field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetClientX509CertURL() string {
-	if i == nil || i.ClientX509CertURL == nil {
+// GetChangeType returns the ChangeType field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetChangeType() string {
+	if g == nil || g.ChangeType == nil {
 		return ""
 	}
-	return *i.ClientX509CertURL
+	return *g.ChangeType
 }
 
-// GetClientX509CertURLOk returns a tuple with the ClientX509CertURL field if it's non-nil, zero value otherwise
+// GetChangeTypeOk returns a tuple with the ChangeType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetClientX509CertURLOk() (string, bool) {
-	if i == nil || i.ClientX509CertURL == nil {
+func (g *GraphDefinitionRequest) GetChangeTypeOk() (string, bool) {
+	if g == nil || g.ChangeType == nil {
 		return "", false
 	}
-	return *i.ClientX509CertURL, true
+	return *g.ChangeType, true
 }
 
-// HasClientX509CertURL returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasClientX509CertURL() bool {
-	if i != nil && i.ClientX509CertURL != nil {
+// HasChangeType returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasChangeType() bool {
+	if g != nil && g.ChangeType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientX509CertURL allocates a new i.ClientX509CertURL and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetClientX509CertURL(v string) {
-	i.ClientX509CertURL = &v
+// SetChangeType allocates a new g.ChangeType and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetChangeType(v string) {
+	g.ChangeType = &v
 }
 
-// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetHostFilters() string {
-	if i == nil || i.HostFilters == nil {
+// GetCompareTo returns the CompareTo field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetCompareTo() string {
+	if g == nil || g.CompareTo == nil {
 		return ""
 	}
-	return *i.HostFilters
+	return *g.CompareTo
 }
 
-// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// GetCompareToOk returns a tuple with the CompareTo field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetHostFiltersOk() (string, bool) {
-	if i == nil || i.HostFilters == nil {
+func (g *GraphDefinitionRequest) GetCompareToOk() (string, bool) {
+	if g == nil || g.CompareTo == nil {
 		return "", false
 	}
-	return *i.HostFilters, true
+	return *g.CompareTo, true
 }
 
-// HasHostFilters returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasHostFilters() bool {
-	if i != nil && i.HostFilters != nil {
+// HasCompareTo returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasCompareTo() bool {
+	if g != nil && g.CompareTo != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetHostFilters(v string) {
-	i.HostFilters = &v
+// SetCompareTo allocates a new g.CompareTo and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetCompareTo(v string) {
+	g.CompareTo = &v
 }
 
-// GetPrivateKey returns the PrivateKey field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetPrivateKey() string {
-	if i == nil || i.PrivateKey == nil {
+// GetExtraCol returns the ExtraCol field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetExtraCol() string {
+	if g == nil || g.ExtraCol == nil {
 		return ""
 	}
-	return *i.PrivateKey
+	return *g.ExtraCol
 }
 
-// GetPrivateKeyOk returns a tuple with the PrivateKey field if it's non-nil, zero value otherwise
+// GetExtraColOk returns a tuple with the ExtraCol field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetPrivateKeyOk() (string, bool) {
-	if i == nil || i.PrivateKey == nil {
+func (g *GraphDefinitionRequest) GetExtraColOk() (string, bool) {
+	if g == nil || g.ExtraCol == nil {
 		return "", false
 	}
-	return *i.PrivateKey, true
+	return *g.ExtraCol, true
 }
 
-// HasPrivateKey returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasPrivateKey() bool {
-	if i != nil && i.PrivateKey != nil {
+// HasExtraCol returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasExtraCol() bool {
+	if g != nil && g.ExtraCol != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPrivateKey allocates a new i.PrivateKey and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetPrivateKey(v string) {
-	i.PrivateKey = &v
+// SetExtraCol allocates a new g.ExtraCol and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetExtraCol(v string) {
+	g.ExtraCol = &v
 }
 
-// GetPrivateKeyID returns the PrivateKeyID field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetPrivateKeyID() string {
-	if i == nil || i.PrivateKeyID == nil {
-		return ""
+// GetIncreaseGood returns the IncreaseGood field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetIncreaseGood() bool {
+	if g == nil || g.IncreaseGood == nil {
+		return false
 	}
-	return *i.PrivateKeyID
+	return *g.IncreaseGood
 }
 
-// GetPrivateKeyIDOk returns a tuple with the PrivateKeyID field if it's non-nil, zero value otherwise
+// GetIncreaseGoodOk returns a tuple with the IncreaseGood field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetPrivateKeyIDOk() (string, bool) {
-	if i == nil || i.PrivateKeyID == nil {
-		return "", false
+func (g *GraphDefinitionRequest) GetIncreaseGoodOk() (bool, bool) {
+	if g == nil || g.IncreaseGood == nil {
+		return false, false
 	}
-	return *i.PrivateKeyID, true
+	return *g.IncreaseGood, true
 }
 
-// HasPrivateKeyID returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasPrivateKeyID() bool {
-	if i != nil && i.PrivateKeyID != nil {
+// HasIncreaseGood returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasIncreaseGood() bool {
+	if g != nil && g.IncreaseGood != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPrivateKeyID allocates a new i.PrivateKeyID and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetPrivateKeyID(v string) {
-	i.PrivateKeyID = &v
+// SetIncreaseGood allocates a new g.IncreaseGood and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetIncreaseGood(v bool) {
+	g.IncreaseGood = &v
 }
 
-// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetProjectID() string {
-	if i == nil || i.ProjectID == nil {
+// GetOrderBy returns the OrderBy field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetOrderBy() string {
+	if g == nil || g.OrderBy == nil {
 		return ""
 	}
-	return *i.ProjectID
+	return *g.OrderBy
 }
 
-// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// GetOrderByOk returns a tuple with the OrderBy field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetProjectIDOk() (string, bool) {
-	if i == nil || i.ProjectID == nil {
+func (g *GraphDefinitionRequest) GetOrderByOk() (string, bool) {
+	if g == nil || g.OrderBy == nil {
 		return "", false
 	}
-	return *i.ProjectID, true
+	return *g.OrderBy, true
 }
 
-// HasProjectID returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasProjectID() bool {
-	if i != nil && i.ProjectID != nil {
+// HasOrderBy returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasOrderBy() bool {
+	if g != nil && g.OrderBy != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetProjectID(v string) {
-	i.ProjectID = &v
+// SetOrderBy allocates a new g.OrderBy and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetOrderBy(v string) {
+	g.OrderBy = &v
 }
 
-// GetTokenURI returns the TokenURI field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetTokenURI() string {
-	if i == nil || i.TokenURI == nil {
+// GetOrderDirection returns the OrderDirection field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetOrderDirection() string {
+	if g == nil || g.OrderDirection == nil {
 		return ""
 	}
-	return *i.TokenURI
+	return *g.OrderDirection
 }
 
-// GetTokenURIOk returns a tuple with the TokenURI field if it's non-nil, zero value otherwise
+// GetOrderDirectionOk returns a tuple with the OrderDirection field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetTokenURIOk() (string, bool) {
-	if i == nil || i.TokenURI == nil {
+func (g *GraphDefinitionRequest) GetOrderDirectionOk() (string, bool) {
+	if g == nil || g.OrderDirection == nil {
 		return "", false
 	}
-	return *i.TokenURI, true
+	return *g.OrderDirection, true
 }
 
-// HasTokenURI returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasTokenURI() bool {
-	if i != nil && i.TokenURI != nil {
+// HasOrderDirection returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasOrderDirection() bool {
+	if g != nil && g.OrderDirection != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTokenURI allocates a new i.TokenURI and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetTokenURI(v string) {
-	i.TokenURI = &v
+// SetOrderDirection allocates a new g.OrderDirection and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetOrderDirection(v string) {
+	g.OrderDirection = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (i *IntegrationGCPCreateRequest) GetType() string {
-	if i == nil || i.Type == nil {
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetQuery() string {
+	if g == nil || g.Query == nil {
 		return ""
 	}
-	return *i.Type
+	return *g.Query
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPCreateRequest) GetTypeOk() (string, bool) {
-	if i == nil || i.Type == nil {
+func (g *GraphDefinitionRequest) GetQueryOk() (string, bool) {
+	if g == nil || g.Query == nil {
 		return "", false
 	}
-	return *i.Type, true
+	return *g.Query, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (i *IntegrationGCPCreateRequest) HasType() bool {
-	if i != nil && i.Type != nil {
+// HasQuery returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasQuery() bool {
+	if g != nil && g.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new i.Type and returns the pointer to it.
-func (i *IntegrationGCPCreateRequest) SetType(v string) {
-	i.Type = &v
+// SetQuery allocates a new g.Query and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetQuery(v string) {
+	g.Query = &v
 }
 
-// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
-func (i *IntegrationGCPDeleteRequest) GetClientEmail() string {
-	if i == nil || i.ClientEmail == nil {
-		return ""
+// GetStacked returns the Stacked field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetStacked() bool {
+	if g == nil || g.Stacked == nil {
+		return false
 	}
-	return *i.ClientEmail
+	return *g.Stacked
 }
 
-// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// GetStackedOk returns a tuple with the Stacked field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPDeleteRequest) GetClientEmailOk() (string, bool) {
-	if i == nil || i.ClientEmail == nil {
-		return "", false
+func (g *GraphDefinitionRequest) GetStackedOk() (bool, bool) {
+	if g == nil || g.Stacked == nil {
+		return false, false
 	}
-	return *i.ClientEmail, true
+	return *g.Stacked, true
 }
 
-// HasClientEmail returns a boolean if a field has been set.
-func (i *IntegrationGCPDeleteRequest) HasClientEmail() bool {
-	if i != nil && i.ClientEmail != nil {
+// HasStacked returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasStacked() bool {
+	if g != nil && g.Stacked != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
-func (i *IntegrationGCPDeleteRequest) SetClientEmail(v string) {
-	i.ClientEmail = &v
+// SetStacked allocates a new g.Stacked and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetStacked(v bool) {
+	g.Stacked = &v
 }
 
-// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
-func (i *IntegrationGCPDeleteRequest) GetProjectID() string {
-	if i == nil || i.ProjectID == nil {
-		return ""
+// GetStyle returns the Style field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetStyle() GraphDefinitionRequestStyle {
+	if g == nil || g.Style == nil {
+		return GraphDefinitionRequestStyle{}
 	}
-	return *i.ProjectID
+	return *g.Style
 }
 
-// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPDeleteRequest) GetProjectIDOk() (string, bool) {
-	if i == nil || i.ProjectID == nil {
-		return "", false
+func (g *GraphDefinitionRequest) GetStyleOk() (GraphDefinitionRequestStyle, bool) {
+	if g == nil || g.Style == nil {
+		return GraphDefinitionRequestStyle{}, false
 	}
-	return *i.ProjectID, true
+	return *g.Style, true
 }
 
-// HasProjectID returns a boolean if a field has been set.
-func (i *IntegrationGCPDeleteRequest) HasProjectID() bool {
-	if i != nil && i.ProjectID != nil {
+// HasStyle returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasStyle() bool {
+	if g != nil && g.Style != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
-func (i *IntegrationGCPDeleteRequest) SetProjectID(v string) {
-	i.ProjectID = &v
+// SetStyle allocates a new g.Style and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetStyle(v GraphDefinitionRequestStyle) {
+	g.Style = &v
 }
 
-// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
-func (i *IntegrationGCPUpdateRequest) GetClientEmail() string {
-	if i == nil || i.ClientEmail == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequest) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.ClientEmail
+	return *g.Type
 }
 
-// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPUpdateRequest) GetClientEmailOk() (string, bool) {
-	if i == nil || i.ClientEmail == nil {
+func (g *GraphDefinitionRequest) GetTypeOk() (string, bool) {
+	if g == nil || g.Type == nil {
 		return "", false
 	}
-	return *i.ClientEmail, true
+	return *g.Type, true
 }
 
-// HasClientEmail returns a boolean if a field has been set.
-func (i *IntegrationGCPUpdateRequest) HasClientEmail() bool {
-	if i != nil && i.ClientEmail != nil {
+// HasType returns a boolean if a field has been set.
+func (g *GraphDefinitionRequest) HasType() bool {
+	if g != nil && g.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
-func (i *IntegrationGCPUpdateRequest) SetClientEmail(v string) {
-	i.ClientEmail = &v
+// SetType allocates a new g.Type and returns the pointer to it.
+func (g *GraphDefinitionRequest) SetType(v string) {
+	g.Type = &v
 }
 
-// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
-func (i *IntegrationGCPUpdateRequest) GetHostFilters() string {
-	if i == nil || i.HostFilters == nil {
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequestStyle) GetPalette() string {
+	if g == nil || g.Palette == nil {
 		return ""
 	}
-	return *i.HostFilters
+	return *g.Palette
 }
 
-// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPUpdateRequest) GetHostFiltersOk() (string, bool) {
-	if i == nil || i.HostFilters == nil {
+func (g *GraphDefinitionRequestStyle) GetPaletteOk() (string, bool) {
+	if g == nil || g.Palette == nil {
 		return "", false
 	}
-	return *i.HostFilters, true
+	return *g.Palette, true
 }
 
-// HasHostFilters returns a boolean if a field has been set.
-func (i *IntegrationGCPUpdateRequest) HasHostFilters() bool {
-	if i != nil && i.HostFilters != nil {
+// HasPalette returns a boolean if a field has been set.
+func (g *GraphDefinitionRequestStyle) HasPalette() bool {
+	if g != nil && g.Palette != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
-func (i *IntegrationGCPUpdateRequest) SetHostFilters(v string) {
-	i.HostFilters = &v
+// SetPalette allocates a new g.Palette and returns the pointer to it.
+func (g *GraphDefinitionRequestStyle) SetPalette(v string) {
+	g.Palette = &v
 }
 
-// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
-func (i *IntegrationGCPUpdateRequest) GetProjectID() string {
-	if i == nil || i.ProjectID == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequestStyle) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.ProjectID
+	return *g.Type
 }
 
-// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationGCPUpdateRequest) GetProjectIDOk() (string, bool) {
-	if i == nil || i.ProjectID == nil {
+func (g *GraphDefinitionRequestStyle) GetTypeOk() (string, bool) {
+	if g == nil || g.Type == nil {
 		return "", false
 	}
-	return *i.ProjectID, true
+	return *g.Type, true
 }
 
-// HasProjectID returns a boolean if a field has been set.
-func (i *IntegrationGCPUpdateRequest) HasProjectID() bool {
-	if i != nil && i.ProjectID != nil {
-		return true
+// HasType returns a boolean if a field has been set.
+func (g *GraphDefinitionRequestStyle) HasType() bool {
+	if g != nil && g.Type != nil {
+		return true
 	}
 
 	return false
 }
 
-// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
-func (i *IntegrationGCPUpdateRequest) SetProjectID(v string) {
-	i.ProjectID = &v
+// SetType allocates a new g.Type and returns the pointer to it.
+func (g *GraphDefinitionRequestStyle) SetType(v string) {
+	g.Type = &v
 }
 
-// GetAPIToken returns the APIToken field if non-nil, zero value otherwise.
-func (i *integrationPD) GetAPIToken() string {
-	if i == nil || i.APIToken == nil {
+// GetWidth returns the Width field if non-nil, zero value otherwise.
+func (g *GraphDefinitionRequestStyle) GetWidth() string {
+	if g == nil || g.Width == nil {
 		return ""
 	}
-	return *i.APIToken
+	return *g.Width
 }
 
-// GetAPITokenOk returns a tuple with the APIToken field if it's non-nil, zero value otherwise
+// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *integrationPD) GetAPITokenOk() (string, bool) {
-	if i == nil || i.APIToken == nil {
+func (g *GraphDefinitionRequestStyle) GetWidthOk() (string, bool) {
+	if g == nil || g.Width == nil {
 		return "", false
 	}
-	return *i.APIToken, true
+	return *g.Width, true
 }
 
-// HasAPIToken returns a boolean if a field has been set.
-func (i *integrationPD) HasAPIToken() bool {
-	if i != nil && i.APIToken != nil {
+// HasWidth returns a boolean if a field has been set.
+func (g *GraphDefinitionRequestStyle) HasWidth() bool {
+	if g != nil && g.Width != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAPIToken allocates a new i.APIToken and returns the pointer to it.
-func (i *integrationPD) SetAPIToken(v string) {
-	i.APIToken = &v
+// SetWidth allocates a new g.Width and returns the pointer to it.
+func (g *GraphDefinitionRequestStyle) SetWidth(v string) {
+	g.Width = &v
 }
 
-// GetSubdomain returns the Subdomain field if non-nil, zero value otherwise.
-func (i *integrationPD) GetSubdomain() string {
-	if i == nil || i.Subdomain == nil {
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (g *GraphEvent) GetQuery() string {
+	if g == nil || g.Query == nil {
 		return ""
 	}
-	return *i.Subdomain
+	return *g.Query
 }
 
-// GetSubdomainOk returns a tuple with the Subdomain field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *integrationPD) GetSubdomainOk() (string, bool) {
-	if i == nil || i.Subdomain == nil {
+func (g *GraphEvent) GetQueryOk() (string, bool) {
+	if g == nil || g.Query == nil {
 		return "", false
 	}
-	return *i.Subdomain, true
+	return *g.Query, true
 }
 
-// HasSubdomain returns a boolean if a field has been set.
-func (i *integrationPD) HasSubdomain() bool {
-	if i != nil && i.Subdomain != nil {
+// HasQuery returns a boolean if a field has been set.
+func (g *GraphEvent) HasQuery() bool {
+	if g != nil && g.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetSubdomain allocates a new i.Subdomain and returns the pointer to it.
-func (i *integrationPD) SetSubdomain(v string) {
-	i.Subdomain = &v
+// SetQuery allocates a new g.Query and returns the pointer to it.
+func (g *GraphEvent) SetQuery(v string) {
+	g.Query = &v
 }
 
-// GetAPIToken returns the APIToken field if non-nil, zero value otherwise.
-func (i *IntegrationPDRequest) GetAPIToken() string {
-	if i == nil || i.APIToken == nil {
-		return ""
+// GetGrokRule returns the GrokRule field if non-nil, zero value otherwise.
+func (g *GrokParser) GetGrokRule() GrokRule {
+	if g == nil || g.GrokRule == nil {
+		return GrokRule{}
 	}
-	return *i.APIToken
+	return *g.GrokRule
 }
 
-// GetAPITokenOk returns a tuple with the APIToken field if it's non-nil, zero value otherwise
+// GetGrokRuleOk returns a tuple with the GrokRule field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationPDRequest) GetAPITokenOk() (string, bool) {
-	if i == nil || i.APIToken == nil {
-		return "", false
+func (g *GrokParser) GetGrokRuleOk() (GrokRule, bool) {
+	if g == nil || g.GrokRule == nil {
+		return GrokRule{}, false
 	}
-	return *i.APIToken, true
+	return *g.GrokRule, true
 }
 
-// HasAPIToken returns a boolean if a field has been set.
-func (i *IntegrationPDRequest) HasAPIToken() bool {
-	if i != nil && i.APIToken != nil {
+// HasGrokRule returns a boolean if a field has been set.
+func (g *GrokParser) HasGrokRule() bool {
+	if g != nil && g.GrokRule != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAPIToken allocates a new i.APIToken and returns the pointer to it.
-func (i *IntegrationPDRequest) SetAPIToken(v string) {
-	i.APIToken = &v
+// SetGrokRule allocates a new g.GrokRule and returns the pointer to it.
+func (g *GrokParser) SetGrokRule(v GrokRule) {
+	g.GrokRule = &v
 }
 
-// GetRunCheck returns the RunCheck field if non-nil, zero value otherwise.
-func (i *IntegrationPDRequest) GetRunCheck() bool {
-	if i == nil || i.RunCheck == nil {
-		return false
+// GetSource returns the Source field if non-nil, zero value otherwise.
+func (g *GrokParser) GetSource() string {
+	if g == nil || g.Source == nil {
+		return ""
 	}
-	return *i.RunCheck
+	return *g.Source
 }
 
-// GetRunCheckOk returns a tuple with the RunCheck field if it's non-nil, zero value otherwise
+// GetSourceOk returns a tuple with the Source field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationPDRequest) GetRunCheckOk() (bool, bool) {
-	if i == nil || i.RunCheck == nil {
-		return false, false
+func (g *GrokParser) GetSourceOk() (string, bool) {
+	if g == nil || g.Source == nil {
+		return "", false
 	}
-	return *i.RunCheck, true
+	return *g.Source, true
 }
 
-// HasRunCheck returns a boolean if a field has been set.
-func (i *IntegrationPDRequest) HasRunCheck() bool {
-	if i != nil && i.RunCheck != nil {
+// HasSource returns a boolean if a field has been set.
+func (g *GrokParser) HasSource() bool {
+	if g != nil && g.Source != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRunCheck allocates a new i.RunCheck and returns the pointer to it.
-func (i *IntegrationPDRequest) SetRunCheck(v bool) {
-	i.RunCheck = &v
+// SetSource allocates a new g.Source and returns the pointer to it.
+func (g *GrokParser) SetSource(v string) {
+	g.Source = &v
 }
 
-// GetSubdomain returns the Subdomain field if non-nil, zero value otherwise.
-func (i *IntegrationPDRequest) GetSubdomain() string {
-	if i == nil || i.Subdomain == nil {
+// GetMatchRules returns the MatchRules field if non-nil, zero value otherwise.
+func (g *GrokRule) GetMatchRules() string {
+	if g == nil || g.MatchRules == nil {
 		return ""
 	}
-	return *i.Subdomain
+	return *g.MatchRules
 }
 
-// GetSubdomainOk returns a tuple with the Subdomain field if it's non-nil, zero value otherwise
+// GetMatchRulesOk returns a tuple with the MatchRules field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationPDRequest) GetSubdomainOk() (string, bool) {
-	if i == nil || i.Subdomain == nil {
+func (g *GrokRule) GetMatchRulesOk() (string, bool) {
+	if g == nil || g.MatchRules == nil {
 		return "", false
 	}
-	return *i.Subdomain, true
+	return *g.MatchRules, true
 }
 
-// HasSubdomain returns a boolean if a field has been set.
-func (i *IntegrationPDRequest) HasSubdomain() bool {
-	if i != nil && i.Subdomain != nil {
+// HasMatchRules returns a boolean if a field has been set.
+func (g *GrokRule) HasMatchRules() bool {
+	if g != nil && g.MatchRules != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetSubdomain allocates a new i.Subdomain and returns the pointer to it.
-func (i *IntegrationPDRequest) SetSubdomain(v string) {
-	i.Subdomain = &v
+// SetMatchRules allocates a new g.MatchRules and returns the pointer to it.
+func (g *GrokRule) SetMatchRules(v string) {
+	g.MatchRules = &v
 }
 
-// GetRunCheck returns the RunCheck field if non-nil, zero value otherwise.
-func (i *IntegrationSlackRequest) GetRunCheck() bool {
-	if i == nil || i.RunCheck == nil {
-		return false
+// GetSupportRules returns the SupportRules field if non-nil, zero value otherwise.
+func (g *GrokRule) GetSupportRules() string {
+	if g == nil || g.SupportRules == nil {
+		return ""
 	}
-	return *i.RunCheck
+	return *g.SupportRules
 }
 
-// GetRunCheckOk returns a tuple with the RunCheck field if it's non-nil, zero value otherwise
+// GetSupportRulesOk returns a tuple with the SupportRules field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (i *IntegrationSlackRequest) GetRunCheckOk() (bool, bool) {
-	if i == nil || i.RunCheck == nil {
-		return false, false
+func (g *GrokRule) GetSupportRulesOk() (string, bool) {
+	if g == nil || g.SupportRules == nil {
+		return "", false
 	}
-	return *i.RunCheck, true
+	return *g.SupportRules, true
 }
 
-// HasRunCheck returns a boolean if a field has been set.
-func (i *IntegrationSlackRequest) HasRunCheck() bool {
-	if i != nil && i.RunCheck != nil {
+// HasSupportRules returns a boolean if a field has been set.
+func (g *GrokRule) HasSupportRules() bool {
+	if g != nil && g.SupportRules != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRunCheck allocates a new i.RunCheck and returns the pointer to it.
-func (i *IntegrationSlackRequest) SetRunCheck(v bool) {
-	i.RunCheck = &v
+// SetSupportRules allocates a new g.SupportRules and returns the pointer to it.
+func (g *GrokRule) SetSupportRules(v string) {
+	g.SupportRules = &v
 }
 
-// GetHost returns the Host field if non-nil, zero value otherwise.
-func (m *Metric) GetHost() string {
-	if m == nil || m.Host == nil {
-		return ""
+// GetLastNoDataTs returns the LastNoDataTs field if non-nil, zero value otherwise.
+func (g *GroupData) GetLastNoDataTs() int {
+	if g == nil || g.LastNoDataTs == nil {
+		return 0
 	}
-	return *m.Host
+	return *g.LastNoDataTs
 }
 
-// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
+// GetLastNoDataTsOk returns a tuple with the LastNoDataTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Metric) GetHostOk() (string, bool) {
-	if m == nil || m.Host == nil {
-		return "", false
+func (g *GroupData) GetLastNoDataTsOk() (int, bool) {
+	if g == nil || g.LastNoDataTs == nil {
+		return 0, false
 	}
-	return *m.Host, true
+	return *g.LastNoDataTs, true
 }
 
-// HasHost returns a boolean if a field has been set.
-func (m *Metric) HasHost() bool {
-	if m != nil && m.Host != nil {
+// HasLastNoDataTs returns a boolean if a field has been set.
+func (g *GroupData) HasLastNoDataTs() bool {
+	if g != nil && g.LastNoDataTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHost allocates a new m.Host and returns the pointer to it.
-func (m *Metric) SetHost(v string) {
-	m.Host = &v
+// SetLastNoDataTs allocates a new g.LastNoDataTs and returns the pointer to it.
+func (g *GroupData) SetLastNoDataTs(v int) {
+	g.LastNoDataTs = &v
 }
 
-// GetMetric returns the Metric field if non-nil, zero value otherwise.
-func (m *Metric) GetMetric() string {
-	if m == nil || m.Metric == nil {
-		return ""
+// GetLastNotifiedTs returns the LastNotifiedTs field if non-nil, zero value otherwise.
+func (g *GroupData) GetLastNotifiedTs() int {
+	if g == nil || g.LastNotifiedTs == nil {
+		return 0
 	}
-	return *m.Metric
+	return *g.LastNotifiedTs
 }
 
-// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// GetLastNotifiedTsOk returns a tuple with the LastNotifiedTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Metric) GetMetricOk() (string, bool) {
-	if m == nil || m.Metric == nil {
-		return "", false
+func (g *GroupData) GetLastNotifiedTsOk() (int, bool) {
+	if g == nil || g.LastNotifiedTs == nil {
+		return 0, false
 	}
-	return *m.Metric, true
+	return *g.LastNotifiedTs, true
 }
 
-// HasMetric returns a boolean if a field has been set.
-func (m *Metric) HasMetric() bool {
-	if m != nil && m.Metric != nil {
+// HasLastNotifiedTs returns a boolean if a field has been set.
+func (g *GroupData) HasLastNotifiedTs() bool {
+	if g != nil && g.LastNotifiedTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMetric allocates a new m.Metric and returns the pointer to it.
-func (m *Metric) SetMetric(v string) {
-	m.Metric = &v
+// SetLastNotifiedTs allocates a new g.LastNotifiedTs and returns the pointer to it.
+func (g *GroupData) SetLastNotifiedTs(v int) {
+	g.LastNotifiedTs = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (m *Metric) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetLastResolvedTs returns the LastResolvedTs field if non-nil, zero value otherwise.
+func (g *GroupData) GetLastResolvedTs() int {
+	if g == nil || g.LastResolvedTs == nil {
+		return 0
 	}
-	return *m.Type
+	return *g.LastResolvedTs
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetLastResolvedTsOk returns a tuple with the LastResolvedTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Metric) GetTypeOk() (string, bool) {
-	if m == nil || m.Type == nil {
-		return "", false
+func (g *GroupData) GetLastResolvedTsOk() (int, bool) {
+	if g == nil || g.LastResolvedTs == nil {
+		return 0, false
 	}
-	return *m.Type, true
+	return *g.LastResolvedTs, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (m *Metric) HasType() bool {
-	if m != nil && m.Type != nil {
+// HasLastResolvedTs returns a boolean if a field has been set.
+func (g *GroupData) HasLastResolvedTs() bool {
+	if g != nil && g.LastResolvedTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new m.Type and returns the pointer to it.
-func (m *Metric) SetType(v string) {
-	m.Type = &v
+// SetLastResolvedTs allocates a new g.LastResolvedTs and returns the pointer to it.
+func (g *GroupData) SetLastResolvedTs(v int) {
+	g.LastResolvedTs = &v
 }
 
-// GetUnit returns the Unit field if non-nil, zero value otherwise.
-func (m *Metric) GetUnit() string {
-	if m == nil || m.Unit == nil {
-		return ""
+// GetLastTriggeredTs returns the LastTriggeredTs field if non-nil, zero value otherwise.
+func (g *GroupData) GetLastTriggeredTs() int {
+	if g == nil || g.LastTriggeredTs == nil {
+		return 0
 	}
-	return *m.Unit
+	return *g.LastTriggeredTs
 }
 
-// GetUnitOk returns a tuple with the Unit field if it's non-nil, zero value otherwise
+// GetLastTriggeredTsOk returns a tuple with the LastTriggeredTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Metric) GetUnitOk() (string, bool) {
-	if m == nil || m.Unit == nil {
-		return "", false
+func (g *GroupData) GetLastTriggeredTsOk() (int, bool) {
+	if g == nil || g.LastTriggeredTs == nil {
+		return 0, false
 	}
-	return *m.Unit, true
+	return *g.LastTriggeredTs, true
 }
 
-// HasUnit returns a boolean if a field has been set.
-func (m *Metric) HasUnit() bool {
-	if m != nil && m.Unit != nil {
+// HasLastTriggeredTs returns a boolean if a field has been set.
+func (g *GroupData) HasLastTriggeredTs() bool {
+	if g != nil && g.LastTriggeredTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUnit allocates a new m.Unit and returns the pointer to it.
-func (m *Metric) SetUnit(v string) {
-	m.Unit = &v
+// SetLastTriggeredTs allocates a new g.LastTriggeredTs and returns the pointer to it.
+func (g *GroupData) SetLastTriggeredTs(v int) {
+	g.LastTriggeredTs = &v
 }
 
-// GetDescription returns the Description field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (g *GroupData) GetName() string {
+	if g == nil || g.Name == nil {
 		return ""
 	}
-	return *m.Description
+	return *g.Name
 }
 
-// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetDescriptionOk() (string, bool) {
-	if m == nil || m.Description == nil {
+func (g *GroupData) GetNameOk() (string, bool) {
+	if g == nil || g.Name == nil {
 		return "", false
 	}
-	return *m.Description, true
+	return *g.Name, true
 }
 
-// HasDescription returns a boolean if a field has been set.
-func (m *MetricMetadata) HasDescription() bool {
-	if m != nil && m.Description != nil {
+// HasName returns a boolean if a field has been set.
+func (g *GroupData) HasName() bool {
+	if g != nil && g.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDescription allocates a new m.Description and returns the pointer to it.
-func (m *MetricMetadata) SetDescription(v string) {
-	m.Description = &v
+// SetName allocates a new g.Name and returns the pointer to it.
+func (g *GroupData) SetName(v string) {
+	g.Name = &v
 }
 
-// GetPerUnit returns the PerUnit field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetPerUnit() string {
-	if m == nil || m.PerUnit == nil {
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (g *GroupData) GetStatus() string {
+	if g == nil || g.Status == nil {
 		return ""
 	}
-	return *m.PerUnit
+	return *g.Status
 }
 
-// GetPerUnitOk returns a tuple with the PerUnit field if it's non-nil, zero value otherwise
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetPerUnitOk() (string, bool) {
-	if m == nil || m.PerUnit == nil {
+func (g *GroupData) GetStatusOk() (string, bool) {
+	if g == nil || g.Status == nil {
 		return "", false
 	}
-	return *m.PerUnit, true
+	return *g.Status, true
 }
 
-// HasPerUnit returns a boolean if a field has been set.
-func (m *MetricMetadata) HasPerUnit() bool {
-	if m != nil && m.PerUnit != nil {
+// HasStatus returns a boolean if a field has been set.
+func (g *GroupData) HasStatus() bool {
+	if g != nil && g.Status != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPerUnit allocates a new m.PerUnit and returns the pointer to it.
-func (m *MetricMetadata) SetPerUnit(v string) {
-	m.PerUnit = &v
+// SetStatus allocates a new g.Status and returns the pointer to it.
+func (g *GroupData) SetStatus(v string) {
+	g.Status = &v
 }
 
-// GetShortName returns the ShortName field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetShortName() string {
-	if m == nil || m.ShortName == nil {
-		return ""
+// GetTriggeringValue returns the TriggeringValue field if non-nil, zero value otherwise.
+func (g *GroupData) GetTriggeringValue() TriggeringValue {
+	if g == nil || g.TriggeringValue == nil {
+		return TriggeringValue{}
 	}
-	return *m.ShortName
+	return *g.TriggeringValue
 }
 
-// GetShortNameOk returns a tuple with the ShortName field if it's non-nil, zero value otherwise
+// GetTriggeringValueOk returns a tuple with the TriggeringValue field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetShortNameOk() (string, bool) {
-	if m == nil || m.ShortName == nil {
-		return "", false
+func (g *GroupData) GetTriggeringValueOk() (TriggeringValue, bool) {
+	if g == nil || g.TriggeringValue == nil {
+		return TriggeringValue{}, false
 	}
-	return *m.ShortName, true
+	return *g.TriggeringValue, true
 }
 
-// HasShortName returns a boolean if a field has been set.
-func (m *MetricMetadata) HasShortName() bool {
-	if m != nil && m.ShortName != nil {
+// HasTriggeringValue returns a boolean if a field has been set.
+func (g *GroupData) HasTriggeringValue() bool {
+	if g != nil && g.TriggeringValue != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetShortName allocates a new m.ShortName and returns the pointer to it.
-func (m *MetricMetadata) SetShortName(v string) {
-	m.ShortName = &v
+// SetTriggeringValue allocates a new g.TriggeringValue and returns the pointer to it.
+func (g *GroupData) SetTriggeringValue(v TriggeringValue) {
+	g.TriggeringValue = &v
 }
 
-// GetStatsdInterval returns the StatsdInterval field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetStatsdInterval() int {
-	if m == nil || m.StatsdInterval == nil {
-		return 0
+// GetHostName returns the HostName field if non-nil, zero value otherwise.
+func (h *Host) GetHostName() string {
+	if h == nil || h.HostName == nil {
+		return ""
 	}
-	return *m.StatsdInterval
+	return *h.HostName
 }
 
-// GetStatsdIntervalOk returns a tuple with the StatsdInterval field if it's non-nil, zero value otherwise
+// GetHostNameOk returns a tuple with the HostName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetStatsdIntervalOk() (int, bool) {
-	if m == nil || m.StatsdInterval == nil {
-		return 0, false
+func (h *Host) GetHostNameOk() (string, bool) {
+	if h == nil || h.HostName == nil {
+		return "", false
 	}
-	return *m.StatsdInterval, true
+	return *h.HostName, true
 }
 
-// HasStatsdInterval returns a boolean if a field has been set.
-func (m *MetricMetadata) HasStatsdInterval() bool {
-	if m != nil && m.StatsdInterval != nil {
+// HasHostName returns a boolean if a field has been set.
+func (h *Host) HasHostName() bool {
+	if h != nil && h.HostName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStatsdInterval allocates a new m.StatsdInterval and returns the pointer to it.
-func (m *MetricMetadata) SetStatsdInterval(v int) {
-	m.StatsdInterval = &v
+// SetHostName allocates a new h.HostName and returns the pointer to it.
+func (h *Host) SetHostName(v string) {
+	h.HostName = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (h *Host) GetId() int {
+	if h == nil || h.Id == nil {
+		return 0
 	}
-	return *m.Type
+	return *h.Id
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetTypeOk() (string, bool) {
-	if m == nil || m.Type == nil {
-		return "", false
+func (h *Host) GetIdOk() (int, bool) {
+	if h == nil || h.Id == nil {
+		return 0, false
 	}
-	return *m.Type, true
+	return *h.Id, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (m *MetricMetadata) HasType() bool {
-	if m != nil && m.Type != nil {
+// HasId returns a boolean if a field has been set.
+func (h *Host) HasId() bool {
+	if h != nil && h.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new m.Type and returns the pointer to it.
-func (m *MetricMetadata) SetType(v string) {
-	m.Type = &v
+// SetId allocates a new h.Id and returns the pointer to it.
+func (h *Host) SetId(v int) {
+	h.Id = &v
 }
 
-// GetUnit returns the Unit field if non-nil, zero value otherwise.
-func (m *MetricMetadata) GetUnit() string {
-	if m == nil || m.Unit == nil {
-		return ""
+// GetIsMuted returns the IsMuted field if non-nil, zero value otherwise.
+func (h *Host) GetIsMuted() bool {
+	if h == nil || h.IsMuted == nil {
+		return false
 	}
-	return *m.Unit
+	return *h.IsMuted
 }
 
-// GetUnitOk returns a tuple with the Unit field if it's non-nil, zero value otherwise
+// GetIsMutedOk returns a tuple with the IsMuted field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *MetricMetadata) GetUnitOk() (string, bool) {
-	if m == nil || m.Unit == nil {
-		return "", false
+func (h *Host) GetIsMutedOk() (bool, bool) {
+	if h == nil || h.IsMuted == nil {
+		return false, false
 	}
-	return *m.Unit, true
+	return *h.IsMuted, true
 }
 
-// HasUnit returns a boolean if a field has been set.
-func (m *MetricMetadata) HasUnit() bool {
-	if m != nil && m.Unit != nil {
+// HasIsMuted returns a boolean if a field has been set.
+func (h *Host) HasIsMuted() bool {
+	if h != nil && h.IsMuted != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUnit allocates a new m.Unit and returns the pointer to it.
-func (m *MetricMetadata) SetUnit(v string) {
-	m.Unit = &v
+// SetIsMuted allocates a new h.IsMuted and returns the pointer to it.
+func (h *Host) SetIsMuted(v bool) {
+	h.IsMuted = &v
 }
 
-// GetCreator returns the Creator field if non-nil, zero value otherwise.
-func (m *Monitor) GetCreator() Creator {
-	if m == nil || m.Creator == nil {
-		return Creator{}
+// GetLastReportedTime returns the LastReportedTime field if non-nil, zero value otherwise.
+func (h *Host) GetLastReportedTime() int {
+	if h == nil || h.LastReportedTime == nil {
+		return 0
 	}
-	return *m.Creator
+	return *h.LastReportedTime
 }
 
-// GetCreatorOk returns a tuple with the Creator field if it's non-nil, zero value otherwise
+// GetLastReportedTimeOk returns a tuple with the LastReportedTime field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetCreatorOk() (Creator, bool) {
-	if m == nil || m.Creator == nil {
-		return Creator{}, false
+func (h *Host) GetLastReportedTimeOk() (int, bool) {
+	if h == nil || h.LastReportedTime == nil {
+		return 0, false
 	}
-	return *m.Creator, true
+	return *h.LastReportedTime, true
 }
 
-// HasCreator returns a boolean if a field has been set.
-func (m *Monitor) HasCreator() bool {
-	if m != nil && m.Creator != nil {
+// HasLastReportedTime returns a boolean if a field has been set.
+func (h *Host) HasLastReportedTime() bool {
+	if h != nil && h.LastReportedTime != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCreator allocates a new m.Creator and returns the pointer to it.
-func (m *Monitor) SetCreator(v Creator) {
-	m.Creator = &v
+// SetLastReportedTime allocates a new h.LastReportedTime and returns the pointer to it.
+func (h *Host) SetLastReportedTime(v int) {
+	h.LastReportedTime = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (m *Monitor) GetId() int {
-	if m == nil || m.Id == nil {
-		return 0
+// GetMetrics returns the Metrics field if non-nil, zero value otherwise.
+func (h *Host) GetMetrics() HostMetrics {
+	if h == nil || h.Metrics == nil {
+		return HostMetrics{}
 	}
-	return *m.Id
+	return *h.Metrics
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetMetricsOk returns a tuple with the Metrics field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetIdOk() (int, bool) {
-	if m == nil || m.Id == nil {
-		return 0, false
+func (h *Host) GetMetricsOk() (HostMetrics, bool) {
+	if h == nil || h.Metrics == nil {
+		return HostMetrics{}, false
 	}
-	return *m.Id, true
+	return *h.Metrics, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (m *Monitor) HasId() bool {
-	if m != nil && m.Id != nil {
+// HasMetrics returns a boolean if a field has been set.
+func (h *Host) HasMetrics() bool {
+	if h != nil && h.Metrics != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new m.Id and returns the pointer to it.
-func (m *Monitor) SetId(v int) {
-	m.Id = &v
+// SetMetrics allocates a new h.Metrics and returns the pointer to it.
+func (h *Host) SetMetrics(v HostMetrics) {
+	h.Metrics = &v
 }
 
-// GetMessage returns the Message field if non-nil, zero value otherwise.
-func (m *Monitor) GetMessage() string {
-	if m == nil || m.Message == nil {
-		return ""
+// GetMuteTimeout returns the MuteTimeout field if non-nil, zero value otherwise.
+func (h *Host) GetMuteTimeout() int {
+	if h == nil || h.MuteTimeout == nil {
+		return 0
 	}
-	return *m.Message
+	return *h.MuteTimeout
 }
 
-// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// GetMuteTimeoutOk returns a tuple with the MuteTimeout field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetMessageOk() (string, bool) {
-	if m == nil || m.Message == nil {
-		return "", false
+func (h *Host) GetMuteTimeoutOk() (int, bool) {
+	if h == nil || h.MuteTimeout == nil {
+		return 0, false
 	}
-	return *m.Message, true
+	return *h.MuteTimeout, true
 }
 
-// HasMessage returns a boolean if a field has been set.
-func (m *Monitor) HasMessage() bool {
-	if m != nil && m.Message != nil {
+// HasMuteTimeout returns a boolean if a field has been set.
+func (h *Host) HasMuteTimeout() bool {
+	if h != nil && h.MuteTimeout != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMessage allocates a new m.Message and returns the pointer to it.
-func (m *Monitor) SetMessage(v string) {
-	m.Message = &v
+// SetMuteTimeout allocates a new h.MuteTimeout and returns the pointer to it.
+func (h *Host) SetMuteTimeout(v int) {
+	h.MuteTimeout = &v
 }
 
 // GetName returns the Name field if non-nil, zero value otherwise.
-func (m *Monitor) GetName() string {
-	if m == nil || m.Name == nil {
+func (h *Host) GetName() string {
+	if h == nil || h.Name == nil {
 		return ""
 	}
-	return *m.Name
+	return *h.Name
 }
 
 // GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetNameOk() (string, bool) {
-	if m == nil || m.Name == nil {
+func (h *Host) GetNameOk() (string, bool) {
+	if h == nil || h.Name == nil {
 		return "", false
 	}
-	return *m.Name, true
+	return *h.Name, true
 }
 
 // HasName returns a boolean if a field has been set.
-func (m *Monitor) HasName() bool {
-	if m != nil && m.Name != nil {
+func (h *Host) HasName() bool {
+	if h != nil && h.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new m.Name and returns the pointer to it.
-func (m *Monitor) SetName(v string) {
-	m.Name = &v
+// SetName allocates a new h.Name and returns the pointer to it.
+func (h *Host) SetName(v string) {
+	h.Name = &v
 }
 
-// GetOptions returns the Options field if non-nil, zero value otherwise.
-func (m *Monitor) GetOptions() Options {
-	if m == nil || m.Options == nil {
-		return Options{}
+// GetUp returns the Up field if non-nil, zero value otherwise.
+func (h *Host) GetUp() bool {
+	if h == nil || h.Up == nil {
+		return false
 	}
-	return *m.Options
+	return *h.Up
 }
 
-// GetOptionsOk returns a tuple with the Options field if it's non-nil, zero value otherwise
+// GetUpOk returns a tuple with the Up field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetOptionsOk() (Options, bool) {
-	if m == nil || m.Options == nil {
-		return Options{}, false
+func (h *Host) GetUpOk() (bool, bool) {
+	if h == nil || h.Up == nil {
+		return false, false
 	}
-	return *m.Options, true
+	return *h.Up, true
 }
 
-// HasOptions returns a boolean if a field has been set.
-func (m *Monitor) HasOptions() bool {
-	if m != nil && m.Options != nil {
+// HasUp returns a boolean if a field has been set.
+func (h *Host) HasUp() bool {
+	if h != nil && h.Up != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOptions allocates a new m.Options and returns the pointer to it.
-func (m *Monitor) SetOptions(v Options) {
-	m.Options = &v
+// SetUp allocates a new h.Up and returns the pointer to it.
+func (h *Host) SetUp(v bool) {
+	h.Up = &v
 }
 
-// GetOverallState returns the OverallState field if non-nil, zero value otherwise.
-func (m *Monitor) GetOverallState() string {
-	if m == nil || m.OverallState == nil {
+// GetEndTime returns the EndTime field if non-nil, zero value otherwise.
+func (h *HostActionMute) GetEndTime() string {
+	if h == nil || h.EndTime == nil {
 		return ""
 	}
-	return *m.OverallState
+	return *h.EndTime
 }
 
-// GetOverallStateOk returns a tuple with the OverallState field if it's non-nil, zero value otherwise
+// GetEndTimeOk returns a tuple with the EndTime field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetOverallStateOk() (string, bool) {
-	if m == nil || m.OverallState == nil {
+func (h *HostActionMute) GetEndTimeOk() (string, bool) {
+	if h == nil || h.EndTime == nil {
 		return "", false
 	}
-	return *m.OverallState, true
+	return *h.EndTime, true
 }
 
-// HasOverallState returns a boolean if a field has been set.
-func (m *Monitor) HasOverallState() bool {
-	if m != nil && m.OverallState != nil {
+// HasEndTime returns a boolean if a field has been set.
+func (h *HostActionMute) HasEndTime() bool {
+	if h != nil && h.EndTime != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOverallState allocates a new m.OverallState and returns the pointer to it.
-func (m *Monitor) SetOverallState(v string) {
-	m.OverallState = &v
+// SetEndTime allocates a new h.EndTime and returns the pointer to it.
+func (h *HostActionMute) SetEndTime(v string) {
+	h.EndTime = &v
 }
 
-// GetOverallStateModified returns the OverallStateModified field if non-nil, zero value otherwise.
-func (m *Monitor) GetOverallStateModified() string {
-	if m == nil || m.OverallStateModified == nil {
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (h *HostActionMute) GetMessage() string {
+	if h == nil || h.Message == nil {
 		return ""
 	}
-	return *m.OverallStateModified
+	return *h.Message
 }
 
-// GetOverallStateModifiedOk returns a tuple with the OverallStateModified field if it's non-nil, zero value otherwise
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetOverallStateModifiedOk() (string, bool) {
-	if m == nil || m.OverallStateModified == nil {
+func (h *HostActionMute) GetMessageOk() (string, bool) {
+	if h == nil || h.Message == nil {
 		return "", false
 	}
-	return *m.OverallStateModified, true
+	return *h.Message, true
 }
 
-// HasOverallStateModified returns a boolean if a field has been set.
-func (m *Monitor) HasOverallStateModified() bool {
-	if m != nil && m.OverallStateModified != nil {
+// HasMessage returns a boolean if a field has been set.
+func (h *HostActionMute) HasMessage() bool {
+	if h != nil && h.Message != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOverallStateModified allocates a new m.OverallStateModified and returns the pointer to it.
-func (m *Monitor) SetOverallStateModified(v string) {
-	m.OverallStateModified = &v
+// SetMessage allocates a new h.Message and returns the pointer to it.
+func (h *HostActionMute) SetMessage(v string) {
+	h.Message = &v
 }
 
-// GetQuery returns the Query field if non-nil, zero value otherwise.
-func (m *Monitor) GetQuery() string {
-	if m == nil || m.Query == nil {
-		return ""
+// GetOverride returns the Override field if non-nil, zero value otherwise.
+func (h *HostActionMute) GetOverride() bool {
+	if h == nil || h.Override == nil {
+		return false
 	}
-	return *m.Query
+	return *h.Override
 }
 
-// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// GetOverrideOk returns a tuple with the Override field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetQueryOk() (string, bool) {
-	if m == nil || m.Query == nil {
-		return "", false
+func (h *HostActionMute) GetOverrideOk() (bool, bool) {
+	if h == nil || h.Override == nil {
+		return false, false
 	}
-	return *m.Query, true
+	return *h.Override, true
 }
 
-// HasQuery returns a boolean if a field has been set.
-func (m *Monitor) HasQuery() bool {
-	if m != nil && m.Query != nil {
+// HasOverride returns a boolean if a field has been set.
+func (h *HostActionMute) HasOverride() bool {
+	if h != nil && h.Override != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQuery allocates a new m.Query and returns the pointer to it.
-func (m *Monitor) SetQuery(v string) {
-	m.Query = &v
+// SetOverride allocates a new h.Override and returns the pointer to it.
+func (h *HostActionMute) SetOverride(v bool) {
+	h.Override = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (m *Monitor) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetTotalMatching returns the TotalMatching field if non-nil, zero value otherwise.
+func (h *HostList) GetTotalMatching() int {
+	if h == nil || h.TotalMatching == nil {
+		return 0
 	}
-	return *m.Type
+	return *h.TotalMatching
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetTotalMatchingOk returns a tuple with the TotalMatching field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (m *Monitor) GetTypeOk() (string, bool) {
-	if m == nil || m.Type == nil {
-		return "", false
+func (h *HostList) GetTotalMatchingOk() (int, bool) {
+	if h == nil || h.TotalMatching == nil {
+		return 0, false
 	}
-	return *m.Type, true
+	return *h.TotalMatching, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (m *Monitor) HasType() bool {
-	if m != nil && m.Type != nil {
+// HasTotalMatching returns a boolean if a field has been set.
+func (h *HostList) HasTotalMatching() bool {
+	if h != nil && h.TotalMatching != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new m.Type and returns the pointer to it.
-func (m *Monitor) SetType(v string) {
-	m.Type = &v
+// SetTotalMatching allocates a new h.TotalMatching and returns the pointer to it.
+func (h *HostList) SetTotalMatching(v int) {
+	h.TotalMatching = &v
 }
 
-// GetEnableLogsSample returns the EnableLogsSample field if non-nil, zero value otherwise.
-func (o *Options) GetEnableLogsSample() bool {
-	if o == nil || o.EnableLogsSample == nil {
-		return false
+// GetTotalReturned returns the TotalReturned field if non-nil, zero value otherwise.
+func (h *HostList) GetTotalReturned() int {
+	if h == nil || h.TotalReturned == nil {
+		return 0
 	}
-	return *o.EnableLogsSample
+	return *h.TotalReturned
 }
 
-// GetEnableLogsSampleOk returns a tuple with the EnableLogsSample field if it's non-nil, zero value otherwise
+// GetTotalReturnedOk returns a tuple with the TotalReturned field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetEnableLogsSampleOk() (bool, bool) {
-	if o == nil || o.EnableLogsSample == nil {
-		return false, false
+func (h *HostList) GetTotalReturnedOk() (int, bool) {
+	if h == nil || h.TotalReturned == nil {
+		return 0, false
 	}
-	return *o.EnableLogsSample, true
+	return *h.TotalReturned, true
 }
 
-// HasEnableLogsSample returns a boolean if a field has been set.
-func (o *Options) HasEnableLogsSample() bool {
-	if o != nil && o.EnableLogsSample != nil {
+// HasTotalReturned returns a boolean if a field has been set.
+func (h *HostList) HasTotalReturned() bool {
+	if h != nil && h.TotalReturned != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEnableLogsSample allocates a new o.EnableLogsSample and returns the pointer to it.
-func (o *Options) SetEnableLogsSample(v bool) {
-	o.EnableLogsSample = &v
+// SetTotalReturned allocates a new h.TotalReturned and returns the pointer to it.
+func (h *HostList) SetTotalReturned(v int) {
+	h.TotalReturned = &v
 }
 
-// GetEscalationMessage returns the EscalationMessage field if non-nil, zero value otherwise.
-func (o *Options) GetEscalationMessage() string {
-	if o == nil || o.EscalationMessage == nil {
-		return ""
+// GetCount returns the Count field if non-nil, zero value otherwise.
+func (h *HostListRequest) GetCount() int {
+	if h == nil || h.Count == nil {
+		return 0
 	}
-	return *o.EscalationMessage
+	return *h.Count
 }
 
-// GetEscalationMessageOk returns a tuple with the EscalationMessage field if it's non-nil, zero value otherwise
+// GetCountOk returns a tuple with the Count field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetEscalationMessageOk() (string, bool) {
-	if o == nil || o.EscalationMessage == nil {
-		return "", false
+func (h *HostListRequest) GetCountOk() (int, bool) {
+	if h == nil || h.Count == nil {
+		return 0, false
 	}
-	return *o.EscalationMessage, true
+	return *h.Count, true
 }
 
-// HasEscalationMessage returns a boolean if a field has been set.
-func (o *Options) HasEscalationMessage() bool {
-	if o != nil && o.EscalationMessage != nil {
+// HasCount returns a boolean if a field has been set.
+func (h *HostListRequest) HasCount() bool {
+	if h != nil && h.Count != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEscalationMessage allocates a new o.EscalationMessage and returns the pointer to it.
-func (o *Options) SetEscalationMessage(v string) {
-	o.EscalationMessage = &v
+// SetCount allocates a new h.Count and returns the pointer to it.
+func (h *HostListRequest) SetCount(v int) {
+	h.Count = &v
 }
 
-// GetEvaluationDelay returns the EvaluationDelay field if non-nil, zero value otherwise.
-func (o *Options) GetEvaluationDelay() int {
-	if o == nil || o.EvaluationDelay == nil {
-		return 0
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (h *HostListRequest) GetFilter() string {
+	if h == nil || h.Filter == nil {
+		return ""
 	}
-	return *o.EvaluationDelay
+	return *h.Filter
 }
 
-// GetEvaluationDelayOk returns a tuple with the EvaluationDelay field if it's non-nil, zero value otherwise
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetEvaluationDelayOk() (int, bool) {
-	if o == nil || o.EvaluationDelay == nil {
-		return 0, false
+func (h *HostListRequest) GetFilterOk() (string, bool) {
+	if h == nil || h.Filter == nil {
+		return "", false
 	}
-	return *o.EvaluationDelay, true
+	return *h.Filter, true
 }
 
-// HasEvaluationDelay returns a boolean if a field has been set.
-func (o *Options) HasEvaluationDelay() bool {
-	if o != nil && o.EvaluationDelay != nil {
+// HasFilter returns a boolean if a field has been set.
+func (h *HostListRequest) HasFilter() bool {
+	if h != nil && h.Filter != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEvaluationDelay allocates a new o.EvaluationDelay and returns the pointer to it.
-func (o *Options) SetEvaluationDelay(v int) {
-	o.EvaluationDelay = &v
+// SetFilter allocates a new h.Filter and returns the pointer to it.
+func (h *HostListRequest) SetFilter(v string) {
+	h.Filter = &v
 }
 
-// GetIncludeTags returns the IncludeTags field if non-nil, zero value otherwise.
-func (o *Options) GetIncludeTags() bool {
-	if o == nil || o.IncludeTags == nil {
-		return false
+// GetSortDir returns the SortDir field if non-nil, zero value otherwise.
+func (h *HostListRequest) GetSortDir() string {
+	if h == nil || h.SortDir == nil {
+		return ""
 	}
-	return *o.IncludeTags
+	return *h.SortDir
 }
 
-// GetIncludeTagsOk returns a tuple with the IncludeTags field if it's non-nil, zero value otherwise
+// GetSortDirOk returns a tuple with the SortDir field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetIncludeTagsOk() (bool, bool) {
-	if o == nil || o.IncludeTags == nil {
-		return false, false
+func (h *HostListRequest) GetSortDirOk() (string, bool) {
+	if h == nil || h.SortDir == nil {
+		return "", false
 	}
-	return *o.IncludeTags, true
+	return *h.SortDir, true
 }
 
-// HasIncludeTags returns a boolean if a field has been set.
-func (o *Options) HasIncludeTags() bool {
-	if o != nil && o.IncludeTags != nil {
+// HasSortDir returns a boolean if a field has been set.
+func (h *HostListRequest) HasSortDir() bool {
+	if h != nil && h.SortDir != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIncludeTags allocates a new o.IncludeTags and returns the pointer to it.
-func (o *Options) SetIncludeTags(v bool) {
-	o.IncludeTags = &v
+// SetSortDir allocates a new h.SortDir and returns the pointer to it.
+func (h *HostListRequest) SetSortDir(v string) {
+	h.SortDir = &v
 }
 
-// GetLocked returns the Locked field if non-nil, zero value otherwise.
-func (o *Options) GetLocked() bool {
-	if o == nil || o.Locked == nil {
-		return false
+// GetSortField returns the SortField field if non-nil, zero value otherwise.
+func (h *HostListRequest) GetSortField() string {
+	if h == nil || h.SortField == nil {
+		return ""
 	}
-	return *o.Locked
+	return *h.SortField
 }
 
-// GetLockedOk returns a tuple with the Locked field if it's non-nil, zero value otherwise
+// GetSortFieldOk returns a tuple with the SortField field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetLockedOk() (bool, bool) {
-	if o == nil || o.Locked == nil {
-		return false, false
+func (h *HostListRequest) GetSortFieldOk() (string, bool) {
+	if h == nil || h.SortField == nil {
+		return "", false
 	}
-	return *o.Locked, true
+	return *h.SortField, true
 }
 
-// HasLocked returns a boolean if a field has been set.
-func (o *Options) HasLocked() bool {
-	if o != nil && o.Locked != nil {
+// HasSortField returns a boolean if a field has been set.
+func (h *HostListRequest) HasSortField() bool {
+	if h != nil && h.SortField != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLocked allocates a new o.Locked and returns the pointer to it.
-func (o *Options) SetLocked(v bool) {
-	o.Locked = &v
+// SetSortField allocates a new h.SortField and returns the pointer to it.
+func (h *HostListRequest) SetSortField(v string) {
+	h.SortField = &v
 }
 
-// GetNewHostDelay returns the NewHostDelay field if non-nil, zero value otherwise.
-func (o *Options) GetNewHostDelay() int {
-	if o == nil || o.NewHostDelay == nil {
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (h *HostListRequest) GetStart() int {
+	if h == nil || h.Start == nil {
 		return 0
 	}
-	return *o.NewHostDelay
+	return *h.Start
 }
 
-// GetNewHostDelayOk returns a tuple with the NewHostDelay field if it's non-nil, zero value otherwise
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetNewHostDelayOk() (int, bool) {
-	if o == nil || o.NewHostDelay == nil {
+func (h *HostListRequest) GetStartOk() (int, bool) {
+	if h == nil || h.Start == nil {
 		return 0, false
 	}
-	return *o.NewHostDelay, true
+	return *h.Start, true
 }
 
-// HasNewHostDelay returns a boolean if a field has been set.
-func (o *Options) HasNewHostDelay() bool {
-	if o != nil && o.NewHostDelay != nil {
+// HasStart returns a boolean if a field has been set.
+func (h *HostListRequest) HasStart() bool {
+	if h != nil && h.Start != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNewHostDelay allocates a new o.NewHostDelay and returns the pointer to it.
-func (o *Options) SetNewHostDelay(v int) {
-	o.NewHostDelay = &v
+// SetStart allocates a new h.Start and returns the pointer to it.
+func (h *HostListRequest) SetStart(v int) {
+	h.Start = &v
 }
 
-// GetNotifyAudit returns the NotifyAudit field if non-nil, zero value otherwise.
-func (o *Options) GetNotifyAudit() bool {
-	if o == nil || o.NotifyAudit == nil {
-		return false
+// GetCpu returns the Cpu field if non-nil, zero value otherwise.
+func (h *HostMetrics) GetCpu() float64 {
+	if h == nil || h.Cpu == nil {
+		return 0
 	}
-	return *o.NotifyAudit
+	return *h.Cpu
 }
 
-// GetNotifyAuditOk returns a tuple with the NotifyAudit field if it's non-nil, zero value otherwise
+// GetCpuOk returns a tuple with the Cpu field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetNotifyAuditOk() (bool, bool) {
-	if o == nil || o.NotifyAudit == nil {
-		return false, false
+func (h *HostMetrics) GetCpuOk() (float64, bool) {
+	if h == nil || h.Cpu == nil {
+		return 0, false
 	}
-	return *o.NotifyAudit, true
+	return *h.Cpu, true
 }
 
-// HasNotifyAudit returns a boolean if a field has been set.
-func (o *Options) HasNotifyAudit() bool {
-	if o != nil && o.NotifyAudit != nil {
+// HasCpu returns a boolean if a field has been set.
+func (h *HostMetrics) HasCpu() bool {
+	if h != nil && h.Cpu != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNotifyAudit allocates a new o.NotifyAudit and returns the pointer to it.
-func (o *Options) SetNotifyAudit(v bool) {
-	o.NotifyAudit = &v
+// SetCpu allocates a new h.Cpu and returns the pointer to it.
+func (h *HostMetrics) SetCpu(v float64) {
+	h.Cpu = &v
 }
 
-// GetNotifyNoData returns the NotifyNoData field if non-nil, zero value otherwise.
-func (o *Options) GetNotifyNoData() bool {
-	if o == nil || o.NotifyNoData == nil {
-		return false
+// GetIowait returns the Iowait field if non-nil, zero value otherwise.
+func (h *HostMetrics) GetIowait() float64 {
+	if h == nil || h.Iowait == nil {
+		return 0
 	}
-	return *o.NotifyNoData
+	return *h.Iowait
 }
 
-// GetNotifyNoDataOk returns a tuple with the NotifyNoData field if it's non-nil, zero value otherwise
+// GetIowaitOk returns a tuple with the Iowait field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetNotifyNoDataOk() (bool, bool) {
-	if o == nil || o.NotifyNoData == nil {
-		return false, false
+func (h *HostMetrics) GetIowaitOk() (float64, bool) {
+	if h == nil || h.Iowait == nil {
+		return 0, false
 	}
-	return *o.NotifyNoData, true
+	return *h.Iowait, true
 }
 
-// HasNotifyNoData returns a boolean if a field has been set.
-func (o *Options) HasNotifyNoData() bool {
-	if o != nil && o.NotifyNoData != nil {
+// HasIowait returns a boolean if a field has been set.
+func (h *HostMetrics) HasIowait() bool {
+	if h != nil && h.Iowait != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNotifyNoData allocates a new o.NotifyNoData and returns the pointer to it.
-func (o *Options) SetNotifyNoData(v bool) {
-	o.NotifyNoData = &v
+// SetIowait allocates a new h.Iowait and returns the pointer to it.
+func (h *HostMetrics) SetIowait(v float64) {
+	h.Iowait = &v
 }
 
-// GetRenotifyInterval returns the RenotifyInterval field if non-nil, zero value otherwise.
-func (o *Options) GetRenotifyInterval() int {
-	if o == nil || o.RenotifyInterval == nil {
+// GetLoad returns the Load field if non-nil, zero value otherwise.
+func (h *HostMetrics) GetLoad() float64 {
+	if h == nil || h.Load == nil {
 		return 0
 	}
-	return *o.RenotifyInterval
+	return *h.Load
 }
 
-// GetRenotifyIntervalOk returns a tuple with the RenotifyInterval field if it's non-nil, zero value otherwise
+// GetLoadOk returns a tuple with the Load field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetRenotifyIntervalOk() (int, bool) {
-	if o == nil || o.RenotifyInterval == nil {
+func (h *HostMetrics) GetLoadOk() (float64, bool) {
+	if h == nil || h.Load == nil {
 		return 0, false
 	}
-	return *o.RenotifyInterval, true
+	return *h.Load, true
 }
 
-// HasRenotifyInterval returns a boolean if a field has been set.
-func (o *Options) HasRenotifyInterval() bool {
-	if o != nil && o.RenotifyInterval != nil {
+// HasLoad returns a boolean if a field has been set.
+func (h *HostMetrics) HasLoad() bool {
+	if h != nil && h.Load != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRenotifyInterval allocates a new o.RenotifyInterval and returns the pointer to it.
-func (o *Options) SetRenotifyInterval(v int) {
-	o.RenotifyInterval = &v
+// SetLoad allocates a new h.Load and returns the pointer to it.
+func (h *HostMetrics) SetLoad(v float64) {
+	h.Load = &v
 }
 
-// GetRequireFullWindow returns the RequireFullWindow field if non-nil, zero value otherwise.
-func (o *Options) GetRequireFullWindow() bool {
-	if o == nil || o.RequireFullWindow == nil {
-		return false
+// GetCreated returns the Created field if non-nil, zero value otherwise.
+func (i *Incident) GetCreated() string {
+	if i == nil || i.Created == nil {
+		return ""
 	}
-	return *o.RequireFullWindow
+	return *i.Created
 }
 
-// GetRequireFullWindowOk returns a tuple with the RequireFullWindow field if it's non-nil, zero value otherwise
+// GetCreatedOk returns a tuple with the Created field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetRequireFullWindowOk() (bool, bool) {
-	if o == nil || o.RequireFullWindow == nil {
-		return false, false
+func (i *Incident) GetCreatedOk() (string, bool) {
+	if i == nil || i.Created == nil {
+		return "", false
+	}
+	return *i.Created, true
+}
+
+// HasCreated returns a boolean if a field has been set.
+func (i *Incident) HasCreated() bool {
+	if i != nil && i.Created != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreated allocates a new i.Created and returns the pointer to it.
+func (i *Incident) SetCreated(v string) {
+	i.Created = &v
+}
+
+// GetCustomerImpacted returns the CustomerImpacted field if non-nil, zero value otherwise.
+func (i *Incident) GetCustomerImpacted() bool {
+	if i == nil || i.CustomerImpacted == nil {
+		return false
+	}
+	return *i.CustomerImpacted
+}
+
+// GetCustomerImpactedOk returns a tuple with the CustomerImpacted field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetCustomerImpactedOk() (bool, bool) {
+	if i == nil || i.CustomerImpacted == nil {
+		return false, false
+	}
+	return *i.CustomerImpacted, true
+}
+
+// HasCustomerImpacted returns a boolean if a field has been set.
+func (i *Incident) HasCustomerImpacted() bool {
+	if i != nil && i.CustomerImpacted != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCustomerImpacted allocates a new i.CustomerImpacted and returns the pointer to it.
+func (i *Incident) SetCustomerImpacted(v bool) {
+	i.CustomerImpacted = &v
+}
+
+// GetCustomerImpactScope returns the CustomerImpactScope field if non-nil, zero value otherwise.
+func (i *Incident) GetCustomerImpactScope() string {
+	if i == nil || i.CustomerImpactScope == nil {
+		return ""
+	}
+	return *i.CustomerImpactScope
+}
+
+// GetCustomerImpactScopeOk returns a tuple with the CustomerImpactScope field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetCustomerImpactScopeOk() (string, bool) {
+	if i == nil || i.CustomerImpactScope == nil {
+		return "", false
+	}
+	return *i.CustomerImpactScope, true
+}
+
+// HasCustomerImpactScope returns a boolean if a field has been set.
+func (i *Incident) HasCustomerImpactScope() bool {
+	if i != nil && i.CustomerImpactScope != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCustomerImpactScope allocates a new i.CustomerImpactScope and returns the pointer to it.
+func (i *Incident) SetCustomerImpactScope(v string) {
+	i.CustomerImpactScope = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (i *Incident) GetId() string {
+	if i == nil || i.Id == nil {
+		return ""
+	}
+	return *i.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetIdOk() (string, bool) {
+	if i == nil || i.Id == nil {
+		return "", false
+	}
+	return *i.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (i *Incident) HasId() bool {
+	if i != nil && i.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new i.Id and returns the pointer to it.
+func (i *Incident) SetId(v string) {
+	i.Id = &v
+}
+
+// GetModified returns the Modified field if non-nil, zero value otherwise.
+func (i *Incident) GetModified() string {
+	if i == nil || i.Modified == nil {
+		return ""
+	}
+	return *i.Modified
+}
+
+// GetModifiedOk returns a tuple with the Modified field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetModifiedOk() (string, bool) {
+	if i == nil || i.Modified == nil {
+		return "", false
+	}
+	return *i.Modified, true
+}
+
+// HasModified returns a boolean if a field has been set.
+func (i *Incident) HasModified() bool {
+	if i != nil && i.Modified != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetModified allocates a new i.Modified and returns the pointer to it.
+func (i *Incident) SetModified(v string) {
+	i.Modified = &v
+}
+
+// GetResolved returns the Resolved field if non-nil, zero value otherwise.
+func (i *Incident) GetResolved() string {
+	if i == nil || i.Resolved == nil {
+		return ""
+	}
+	return *i.Resolved
+}
+
+// GetResolvedOk returns a tuple with the Resolved field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetResolvedOk() (string, bool) {
+	if i == nil || i.Resolved == nil {
+		return "", false
+	}
+	return *i.Resolved, true
+}
+
+// HasResolved returns a boolean if a field has been set.
+func (i *Incident) HasResolved() bool {
+	if i != nil && i.Resolved != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResolved allocates a new i.Resolved and returns the pointer to it.
+func (i *Incident) SetResolved(v string) {
+	i.Resolved = &v
+}
+
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (i *Incident) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *Incident) GetTitleOk() (string, bool) {
+	if i == nil || i.Title == nil {
+		return "", false
+	}
+	return *i.Title, true
+}
+
+// HasTitle returns a boolean if a field has been set.
+func (i *Incident) HasTitle() bool {
+	if i != nil && i.Title != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTitle allocates a new i.Title and returns the pointer to it.
+func (i *Incident) SetTitle(v string) {
+	i.Title = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (i *incidentData) GetAttributes() Incident {
+	if i == nil || i.Attributes == nil {
+		return Incident{}
+	}
+	return *i.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *incidentData) GetAttributesOk() (Incident, bool) {
+	if i == nil || i.Attributes == nil {
+		return Incident{}, false
+	}
+	return *i.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (i *incidentData) HasAttributes() bool {
+	if i != nil && i.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new i.Attributes and returns the pointer to it.
+func (i *incidentData) SetAttributes(v Incident) {
+	i.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (i *incidentData) GetId() string {
+	if i == nil || i.Id == nil {
+		return ""
+	}
+	return *i.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *incidentData) GetIdOk() (string, bool) {
+	if i == nil || i.Id == nil {
+		return "", false
+	}
+	return *i.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (i *incidentData) HasId() bool {
+	if i != nil && i.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new i.Id and returns the pointer to it.
+func (i *incidentData) SetId(v string) {
+	i.Id = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (i *IncidentField) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
+	}
+	return *i.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IncidentField) GetTypeOk() (string, bool) {
+	if i == nil || i.Type == nil {
+		return "", false
+	}
+	return *i.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (i *IncidentField) HasType() bool {
+	if i != nil && i.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new i.Type and returns the pointer to it.
+func (i *IncidentField) SetType(v string) {
+	i.Type = &v
+}
+
+// GetAccountID returns the AccountID field if non-nil, zero value otherwise.
+func (i *IntegrationAWSAccount) GetAccountID() string {
+	if i == nil || i.AccountID == nil {
+		return ""
+	}
+	return *i.AccountID
+}
+
+// GetAccountIDOk returns a tuple with the AccountID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationAWSAccount) GetAccountIDOk() (string, bool) {
+	if i == nil || i.AccountID == nil {
+		return "", false
+	}
+	return *i.AccountID, true
+}
+
+// HasAccountID returns a boolean if a field has been set.
+func (i *IntegrationAWSAccount) HasAccountID() bool {
+	if i != nil && i.AccountID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAccountID allocates a new i.AccountID and returns the pointer to it.
+func (i *IntegrationAWSAccount) SetAccountID(v string) {
+	i.AccountID = &v
+}
+
+// GetRoleName returns the RoleName field if non-nil, zero value otherwise.
+func (i *IntegrationAWSAccount) GetRoleName() string {
+	if i == nil || i.RoleName == nil {
+		return ""
+	}
+	return *i.RoleName
+}
+
+// GetRoleNameOk returns a tuple with the RoleName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationAWSAccount) GetRoleNameOk() (string, bool) {
+	if i == nil || i.RoleName == nil {
+		return "", false
+	}
+	return *i.RoleName, true
+}
+
+// HasRoleName returns a boolean if a field has been set.
+func (i *IntegrationAWSAccount) HasRoleName() bool {
+	if i != nil && i.RoleName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRoleName allocates a new i.RoleName and returns the pointer to it.
+func (i *IntegrationAWSAccount) SetRoleName(v string) {
+	i.RoleName = &v
+}
+
+// GetAccountID returns the AccountID field if non-nil, zero value otherwise.
+func (i *IntegrationAWSAccountDeleteRequest) GetAccountID() string {
+	if i == nil || i.AccountID == nil {
+		return ""
+	}
+	return *i.AccountID
+}
+
+// GetAccountIDOk returns a tuple with the AccountID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationAWSAccountDeleteRequest) GetAccountIDOk() (string, bool) {
+	if i == nil || i.AccountID == nil {
+		return "", false
+	}
+	return *i.AccountID, true
+}
+
+// HasAccountID returns a boolean if a field has been set.
+func (i *IntegrationAWSAccountDeleteRequest) HasAccountID() bool {
+	if i != nil && i.AccountID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAccountID allocates a new i.AccountID and returns the pointer to it.
+func (i *IntegrationAWSAccountDeleteRequest) SetAccountID(v string) {
+	i.AccountID = &v
+}
+
+// GetRoleName returns the RoleName field if non-nil, zero value otherwise.
+func (i *IntegrationAWSAccountDeleteRequest) GetRoleName() string {
+	if i == nil || i.RoleName == nil {
+		return ""
+	}
+	return *i.RoleName
+}
+
+// GetRoleNameOk returns a tuple with the RoleName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationAWSAccountDeleteRequest) GetRoleNameOk() (string, bool) {
+	if i == nil || i.RoleName == nil {
+		return "", false
+	}
+	return *i.RoleName, true
+}
+
+// HasRoleName returns a boolean if a field has been set.
+func (i *IntegrationAWSAccountDeleteRequest) HasRoleName() bool {
+	if i != nil && i.RoleName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRoleName allocates a new i.RoleName and returns the pointer to it.
+func (i *IntegrationAWSAccountDeleteRequest) SetRoleName(v string) {
+	i.RoleName = &v
+}
+
+// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
+func (i *IntegrationGCP) GetClientEmail() string {
+	if i == nil || i.ClientEmail == nil {
+		return ""
+	}
+	return *i.ClientEmail
+}
+
+// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCP) GetClientEmailOk() (string, bool) {
+	if i == nil || i.ClientEmail == nil {
+		return "", false
+	}
+	return *i.ClientEmail, true
+}
+
+// HasClientEmail returns a boolean if a field has been set.
+func (i *IntegrationGCP) HasClientEmail() bool {
+	if i != nil && i.ClientEmail != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
+func (i *IntegrationGCP) SetClientEmail(v string) {
+	i.ClientEmail = &v
+}
+
+// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
+func (i *IntegrationGCP) GetHostFilters() string {
+	if i == nil || i.HostFilters == nil {
+		return ""
+	}
+	return *i.HostFilters
+}
+
+// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCP) GetHostFiltersOk() (string, bool) {
+	if i == nil || i.HostFilters == nil {
+		return "", false
+	}
+	return *i.HostFilters, true
+}
+
+// HasHostFilters returns a boolean if a field has been set.
+func (i *IntegrationGCP) HasHostFilters() bool {
+	if i != nil && i.HostFilters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
+func (i *IntegrationGCP) SetHostFilters(v string) {
+	i.HostFilters = &v
+}
+
+// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
+func (i *IntegrationGCP) GetProjectID() string {
+	if i == nil || i.ProjectID == nil {
+		return ""
+	}
+	return *i.ProjectID
+}
+
+// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCP) GetProjectIDOk() (string, bool) {
+	if i == nil || i.ProjectID == nil {
+		return "", false
+	}
+	return *i.ProjectID, true
+}
+
+// HasProjectID returns a boolean if a field has been set.
+func (i *IntegrationGCP) HasProjectID() bool {
+	if i != nil && i.ProjectID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
+func (i *IntegrationGCP) SetProjectID(v string) {
+	i.ProjectID = &v
+}
+
+// GetAuthProviderX509CertURL returns the AuthProviderX509CertURL field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetAuthProviderX509CertURL() string {
+	if i == nil || i.AuthProviderX509CertURL == nil {
+		return ""
+	}
+	return *i.AuthProviderX509CertURL
+}
+
+// GetAuthProviderX509CertURLOk returns a tuple with the AuthProviderX509CertURL field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetAuthProviderX509CertURLOk() (string, bool) {
+	if i == nil || i.AuthProviderX509CertURL == nil {
+		return "", false
+	}
+	return *i.AuthProviderX509CertURL, true
+}
+
+// HasAuthProviderX509CertURL returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasAuthProviderX509CertURL() bool {
+	if i != nil && i.AuthProviderX509CertURL != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthProviderX509CertURL allocates a new i.AuthProviderX509CertURL and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetAuthProviderX509CertURL(v string) {
+	i.AuthProviderX509CertURL = &v
+}
+
+// GetAuthURI returns the AuthURI field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetAuthURI() string {
+	if i == nil || i.AuthURI == nil {
+		return ""
+	}
+	return *i.AuthURI
+}
+
+// GetAuthURIOk returns a tuple with the AuthURI field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetAuthURIOk() (string, bool) {
+	if i == nil || i.AuthURI == nil {
+		return "", false
+	}
+	return *i.AuthURI, true
+}
+
+// HasAuthURI returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasAuthURI() bool {
+	if i != nil && i.AuthURI != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthURI allocates a new i.AuthURI and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetAuthURI(v string) {
+	i.AuthURI = &v
+}
+
+// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetClientEmail() string {
+	if i == nil || i.ClientEmail == nil {
+		return ""
+	}
+	return *i.ClientEmail
+}
+
+// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetClientEmailOk() (string, bool) {
+	if i == nil || i.ClientEmail == nil {
+		return "", false
+	}
+	return *i.ClientEmail, true
+}
+
+// HasClientEmail returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasClientEmail() bool {
+	if i != nil && i.ClientEmail != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetClientEmail(v string) {
+	i.ClientEmail = &v
+}
+
+// GetClientID returns the ClientID field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetClientID() string {
+	if i == nil || i.ClientID == nil {
+		return ""
+	}
+	return *i.ClientID
+}
+
+// GetClientIDOk returns a tuple with the ClientID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetClientIDOk() (string, bool) {
+	if i == nil || i.ClientID == nil {
+		return "", false
+	}
+	return *i.ClientID, true
+}
+
+// HasClientID returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasClientID() bool {
+	if i != nil && i.ClientID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientID allocates a new i.ClientID and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetClientID(v string) {
+	i.ClientID = &v
+}
+
+// GetClientX509CertURL returns the ClientX509CertURL field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetClientX509CertURL() string {
+	if i == nil || i.ClientX509CertURL == nil {
+		return ""
+	}
+	return *i.ClientX509CertURL
+}
+
+// GetClientX509CertURLOk returns a tuple with the ClientX509CertURL field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetClientX509CertURLOk() (string, bool) {
+	if i == nil || i.ClientX509CertURL == nil {
+		return "", false
+	}
+	return *i.ClientX509CertURL, true
+}
+
+// HasClientX509CertURL returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasClientX509CertURL() bool {
+	if i != nil && i.ClientX509CertURL != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientX509CertURL allocates a new i.ClientX509CertURL and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetClientX509CertURL(v string) {
+	i.ClientX509CertURL = &v
+}
+
+// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetHostFilters() string {
+	if i == nil || i.HostFilters == nil {
+		return ""
+	}
+	return *i.HostFilters
+}
+
+// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetHostFiltersOk() (string, bool) {
+	if i == nil || i.HostFilters == nil {
+		return "", false
+	}
+	return *i.HostFilters, true
+}
+
+// HasHostFilters returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasHostFilters() bool {
+	if i != nil && i.HostFilters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetHostFilters(v string) {
+	i.HostFilters = &v
+}
+
+// GetPrivateKey returns the PrivateKey field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetPrivateKey() string {
+	if i == nil || i.PrivateKey == nil {
+		return ""
+	}
+	return *i.PrivateKey
+}
+
+// GetPrivateKeyOk returns a tuple with the PrivateKey field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetPrivateKeyOk() (string, bool) {
+	if i == nil || i.PrivateKey == nil {
+		return "", false
+	}
+	return *i.PrivateKey, true
+}
+
+// HasPrivateKey returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasPrivateKey() bool {
+	if i != nil && i.PrivateKey != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPrivateKey allocates a new i.PrivateKey and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetPrivateKey(v string) {
+	i.PrivateKey = &v
+}
+
+// GetPrivateKeyID returns the PrivateKeyID field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetPrivateKeyID() string {
+	if i == nil || i.PrivateKeyID == nil {
+		return ""
+	}
+	return *i.PrivateKeyID
+}
+
+// GetPrivateKeyIDOk returns a tuple with the PrivateKeyID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetPrivateKeyIDOk() (string, bool) {
+	if i == nil || i.PrivateKeyID == nil {
+		return "", false
+	}
+	return *i.PrivateKeyID, true
+}
+
+// HasPrivateKeyID returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasPrivateKeyID() bool {
+	if i != nil && i.PrivateKeyID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPrivateKeyID allocates a new i.PrivateKeyID and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetPrivateKeyID(v string) {
+	i.PrivateKeyID = &v
+}
+
+// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetProjectID() string {
+	if i == nil || i.ProjectID == nil {
+		return ""
+	}
+	return *i.ProjectID
+}
+
+// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetProjectIDOk() (string, bool) {
+	if i == nil || i.ProjectID == nil {
+		return "", false
+	}
+	return *i.ProjectID, true
+}
+
+// HasProjectID returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasProjectID() bool {
+	if i != nil && i.ProjectID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetProjectID(v string) {
+	i.ProjectID = &v
+}
+
+// GetTokenURI returns the TokenURI field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetTokenURI() string {
+	if i == nil || i.TokenURI == nil {
+		return ""
+	}
+	return *i.TokenURI
+}
+
+// GetTokenURIOk returns a tuple with the TokenURI field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetTokenURIOk() (string, bool) {
+	if i == nil || i.TokenURI == nil {
+		return "", false
+	}
+	return *i.TokenURI, true
+}
+
+// HasTokenURI returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasTokenURI() bool {
+	if i != nil && i.TokenURI != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenURI allocates a new i.TokenURI and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetTokenURI(v string) {
+	i.TokenURI = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (i *IntegrationGCPCreateRequest) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
+	}
+	return *i.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPCreateRequest) GetTypeOk() (string, bool) {
+	if i == nil || i.Type == nil {
+		return "", false
+	}
+	return *i.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (i *IntegrationGCPCreateRequest) HasType() bool {
+	if i != nil && i.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new i.Type and returns the pointer to it.
+func (i *IntegrationGCPCreateRequest) SetType(v string) {
+	i.Type = &v
+}
+
+// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
+func (i *IntegrationGCPDeleteRequest) GetClientEmail() string {
+	if i == nil || i.ClientEmail == nil {
+		return ""
+	}
+	return *i.ClientEmail
+}
+
+// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPDeleteRequest) GetClientEmailOk() (string, bool) {
+	if i == nil || i.ClientEmail == nil {
+		return "", false
+	}
+	return *i.ClientEmail, true
+}
+
+// HasClientEmail returns a boolean if a field has been set.
+func (i *IntegrationGCPDeleteRequest) HasClientEmail() bool {
+	if i != nil && i.ClientEmail != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
+func (i *IntegrationGCPDeleteRequest) SetClientEmail(v string) {
+	i.ClientEmail = &v
+}
+
+// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
+func (i *IntegrationGCPDeleteRequest) GetProjectID() string {
+	if i == nil || i.ProjectID == nil {
+		return ""
+	}
+	return *i.ProjectID
+}
+
+// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPDeleteRequest) GetProjectIDOk() (string, bool) {
+	if i == nil || i.ProjectID == nil {
+		return "", false
+	}
+	return *i.ProjectID, true
+}
+
+// HasProjectID returns a boolean if a field has been set.
+func (i *IntegrationGCPDeleteRequest) HasProjectID() bool {
+	if i != nil && i.ProjectID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
+func (i *IntegrationGCPDeleteRequest) SetProjectID(v string) {
+	i.ProjectID = &v
+}
+
+// GetClientEmail returns the ClientEmail field if non-nil, zero value otherwise.
+func (i *IntegrationGCPUpdateRequest) GetClientEmail() string {
+	if i == nil || i.ClientEmail == nil {
+		return ""
+	}
+	return *i.ClientEmail
+}
+
+// GetClientEmailOk returns a tuple with the ClientEmail field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPUpdateRequest) GetClientEmailOk() (string, bool) {
+	if i == nil || i.ClientEmail == nil {
+		return "", false
+	}
+	return *i.ClientEmail, true
+}
+
+// HasClientEmail returns a boolean if a field has been set.
+func (i *IntegrationGCPUpdateRequest) HasClientEmail() bool {
+	if i != nil && i.ClientEmail != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClientEmail allocates a new i.ClientEmail and returns the pointer to it.
+func (i *IntegrationGCPUpdateRequest) SetClientEmail(v string) {
+	i.ClientEmail = &v
+}
+
+// GetHostFilters returns the HostFilters field if non-nil, zero value otherwise.
+func (i *IntegrationGCPUpdateRequest) GetHostFilters() string {
+	if i == nil || i.HostFilters == nil {
+		return ""
+	}
+	return *i.HostFilters
+}
+
+// GetHostFiltersOk returns a tuple with the HostFilters field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPUpdateRequest) GetHostFiltersOk() (string, bool) {
+	if i == nil || i.HostFilters == nil {
+		return "", false
+	}
+	return *i.HostFilters, true
+}
+
+// HasHostFilters returns a boolean if a field has been set.
+func (i *IntegrationGCPUpdateRequest) HasHostFilters() bool {
+	if i != nil && i.HostFilters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHostFilters allocates a new i.HostFilters and returns the pointer to it.
+func (i *IntegrationGCPUpdateRequest) SetHostFilters(v string) {
+	i.HostFilters = &v
+}
+
+// GetProjectID returns the ProjectID field if non-nil, zero value otherwise.
+func (i *IntegrationGCPUpdateRequest) GetProjectID() string {
+	if i == nil || i.ProjectID == nil {
+		return ""
+	}
+	return *i.ProjectID
+}
+
+// GetProjectIDOk returns a tuple with the ProjectID field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationGCPUpdateRequest) GetProjectIDOk() (string, bool) {
+	if i == nil || i.ProjectID == nil {
+		return "", false
+	}
+	return *i.ProjectID, true
+}
+
+// HasProjectID returns a boolean if a field has been set.
+func (i *IntegrationGCPUpdateRequest) HasProjectID() bool {
+	if i != nil && i.ProjectID != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectID allocates a new i.ProjectID and returns the pointer to it.
+func (i *IntegrationGCPUpdateRequest) SetProjectID(v string) {
+	i.ProjectID = &v
+}
+
+// GetAPIToken returns the APIToken field if non-nil, zero value otherwise.
+func (i *integrationPD) GetAPIToken() string {
+	if i == nil || i.APIToken == nil {
+		return ""
+	}
+	return *i.APIToken
+}
+
+// GetAPITokenOk returns a tuple with the APIToken field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *integrationPD) GetAPITokenOk() (string, bool) {
+	if i == nil || i.APIToken == nil {
+		return "", false
+	}
+	return *i.APIToken, true
+}
+
+// HasAPIToken returns a boolean if a field has been set.
+func (i *integrationPD) HasAPIToken() bool {
+	if i != nil && i.APIToken != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAPIToken allocates a new i.APIToken and returns the pointer to it.
+func (i *integrationPD) SetAPIToken(v string) {
+	i.APIToken = &v
+}
+
+// GetSubdomain returns the Subdomain field if non-nil, zero value otherwise.
+func (i *integrationPD) GetSubdomain() string {
+	if i == nil || i.Subdomain == nil {
+		return ""
+	}
+	return *i.Subdomain
+}
+
+// GetSubdomainOk returns a tuple with the Subdomain field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *integrationPD) GetSubdomainOk() (string, bool) {
+	if i == nil || i.Subdomain == nil {
+		return "", false
+	}
+	return *i.Subdomain, true
+}
+
+// HasSubdomain returns a boolean if a field has been set.
+func (i *integrationPD) HasSubdomain() bool {
+	if i != nil && i.Subdomain != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetSubdomain allocates a new i.Subdomain and returns the pointer to it.
+func (i *integrationPD) SetSubdomain(v string) {
+	i.Subdomain = &v
+}
+
+// GetAPIToken returns the APIToken field if non-nil, zero value otherwise.
+func (i *IntegrationPDRequest) GetAPIToken() string {
+	if i == nil || i.APIToken == nil {
+		return ""
+	}
+	return *i.APIToken
+}
+
+// GetAPITokenOk returns a tuple with the APIToken field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationPDRequest) GetAPITokenOk() (string, bool) {
+	if i == nil || i.APIToken == nil {
+		return "", false
+	}
+	return *i.APIToken, true
+}
+
+// HasAPIToken returns a boolean if a field has been set.
+func (i *IntegrationPDRequest) HasAPIToken() bool {
+	if i != nil && i.APIToken != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAPIToken allocates a new i.APIToken and returns the pointer to it.
+func (i *IntegrationPDRequest) SetAPIToken(v string) {
+	i.APIToken = &v
+}
+
+// GetRunCheck returns the RunCheck field if non-nil, zero value otherwise.
+func (i *IntegrationPDRequest) GetRunCheck() bool {
+	if i == nil || i.RunCheck == nil {
+		return false
+	}
+	return *i.RunCheck
+}
+
+// GetRunCheckOk returns a tuple with the RunCheck field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationPDRequest) GetRunCheckOk() (bool, bool) {
+	if i == nil || i.RunCheck == nil {
+		return false, false
+	}
+	return *i.RunCheck, true
+}
+
+// HasRunCheck returns a boolean if a field has been set.
+func (i *IntegrationPDRequest) HasRunCheck() bool {
+	if i != nil && i.RunCheck != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRunCheck allocates a new i.RunCheck and returns the pointer to it.
+func (i *IntegrationPDRequest) SetRunCheck(v bool) {
+	i.RunCheck = &v
+}
+
+// GetSubdomain returns the Subdomain field if non-nil, zero value otherwise.
+func (i *IntegrationPDRequest) GetSubdomain() string {
+	if i == nil || i.Subdomain == nil {
+		return ""
+	}
+	return *i.Subdomain
+}
+
+// GetSubdomainOk returns a tuple with the Subdomain field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationPDRequest) GetSubdomainOk() (string, bool) {
+	if i == nil || i.Subdomain == nil {
+		return "", false
+	}
+	return *i.Subdomain, true
+}
+
+// HasSubdomain returns a boolean if a field has been set.
+func (i *IntegrationPDRequest) HasSubdomain() bool {
+	if i != nil && i.Subdomain != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetSubdomain allocates a new i.Subdomain and returns the pointer to it.
+func (i *IntegrationPDRequest) SetSubdomain(v string) {
+	i.Subdomain = &v
+}
+
+// GetRunCheck returns the RunCheck field if non-nil, zero value otherwise.
+func (i *IntegrationSlackRequest) GetRunCheck() bool {
+	if i == nil || i.RunCheck == nil {
+		return false
+	}
+	return *i.RunCheck
+}
+
+// GetRunCheckOk returns a tuple with the RunCheck field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (i *IntegrationSlackRequest) GetRunCheckOk() (bool, bool) {
+	if i == nil || i.RunCheck == nil {
+		return false, false
+	}
+	return *i.RunCheck, true
+}
+
+// HasRunCheck returns a boolean if a field has been set.
+func (i *IntegrationSlackRequest) HasRunCheck() bool {
+	if i != nil && i.RunCheck != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRunCheck allocates a new i.RunCheck and returns the pointer to it.
+func (i *IntegrationSlackRequest) SetRunCheck(v bool) {
+	i.RunCheck = &v
+}
+
+// GetDailyLimit returns the DailyLimit field if non-nil, zero value otherwise.
+func (l *LogsIndex) GetDailyLimit() int {
+	if l == nil || l.DailyLimit == nil {
+		return 0
+	}
+	return *l.DailyLimit
+}
+
+// GetDailyLimitOk returns a tuple with the DailyLimit field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsIndex) GetDailyLimitOk() (int, bool) {
+	if l == nil || l.DailyLimit == nil {
+		return 0, false
+	}
+	return *l.DailyLimit, true
+}
+
+// HasDailyLimit returns a boolean if a field has been set.
+func (l *LogsIndex) HasDailyLimit() bool {
+	if l != nil && l.DailyLimit != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDailyLimit allocates a new l.DailyLimit and returns the pointer to it.
+func (l *LogsIndex) SetDailyLimit(v int) {
+	l.DailyLimit = &v
+}
+
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (l *LogsIndex) GetFilter() FilterConfiguration {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}
+	}
+	return *l.Filter
+}
+
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsIndex) GetFilterOk() (FilterConfiguration, bool) {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}, false
+	}
+	return *l.Filter, true
+}
+
+// HasFilter returns a boolean if a field has been set.
+func (l *LogsIndex) HasFilter() bool {
+	if l != nil && l.Filter != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFilter allocates a new l.Filter and returns the pointer to it.
+func (l *LogsIndex) SetFilter(v FilterConfiguration) {
+	l.Filter = &v
+}
+
+// GetIsRateLimited returns the IsRateLimited field if non-nil, zero value otherwise.
+func (l *LogsIndex) GetIsRateLimited() bool {
+	if l == nil || l.IsRateLimited == nil {
+		return false
+	}
+	return *l.IsRateLimited
+}
+
+// GetIsRateLimitedOk returns a tuple with the IsRateLimited field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsIndex) GetIsRateLimitedOk() (bool, bool) {
+	if l == nil || l.IsRateLimited == nil {
+		return false, false
+	}
+	return *l.IsRateLimited, true
+}
+
+// HasIsRateLimited returns a boolean if a field has been set.
+func (l *LogsIndex) HasIsRateLimited() bool {
+	if l != nil && l.IsRateLimited != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsRateLimited allocates a new l.IsRateLimited and returns the pointer to it.
+func (l *LogsIndex) SetIsRateLimited(v bool) {
+	l.IsRateLimited = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (l *LogsIndex) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsIndex) GetNameOk() (string, bool) {
+	if l == nil || l.Name == nil {
+		return "", false
+	}
+	return *l.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (l *LogsIndex) HasName() bool {
+	if l != nil && l.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new l.Name and returns the pointer to it.
+func (l *LogsIndex) SetName(v string) {
+	l.Name = &v
+}
+
+// GetNumRetentionDays returns the NumRetentionDays field if non-nil, zero value otherwise.
+func (l *LogsIndex) GetNumRetentionDays() int {
+	if l == nil || l.NumRetentionDays == nil {
+		return 0
+	}
+	return *l.NumRetentionDays
+}
+
+// GetNumRetentionDaysOk returns a tuple with the NumRetentionDays field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsIndex) GetNumRetentionDaysOk() (int, bool) {
+	if l == nil || l.NumRetentionDays == nil {
+		return 0, false
+	}
+	return *l.NumRetentionDays, true
+}
+
+// HasNumRetentionDays returns a boolean if a field has been set.
+func (l *LogsIndex) HasNumRetentionDays() bool {
+	if l != nil && l.NumRetentionDays != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetNumRetentionDays allocates a new l.NumRetentionDays and returns the pointer to it.
+func (l *LogsIndex) SetNumRetentionDays(v int) {
+	l.NumRetentionDays = &v
+}
+
+// GetCompute returns the Compute field if non-nil, zero value otherwise.
+func (l *LogsMetric) GetCompute() LogsMetricCompute {
+	if l == nil || l.Compute == nil {
+		return LogsMetricCompute{}
+	}
+	return *l.Compute
+}
+
+// GetComputeOk returns a tuple with the Compute field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetric) GetComputeOk() (LogsMetricCompute, bool) {
+	if l == nil || l.Compute == nil {
+		return LogsMetricCompute{}, false
+	}
+	return *l.Compute, true
+}
+
+// HasCompute returns a boolean if a field has been set.
+func (l *LogsMetric) HasCompute() bool {
+	if l != nil && l.Compute != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCompute allocates a new l.Compute and returns the pointer to it.
+func (l *LogsMetric) SetCompute(v LogsMetricCompute) {
+	l.Compute = &v
+}
+
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (l *LogsMetric) GetFilter() FilterConfiguration {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}
+	}
+	return *l.Filter
+}
+
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetric) GetFilterOk() (FilterConfiguration, bool) {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}, false
+	}
+	return *l.Filter, true
+}
+
+// HasFilter returns a boolean if a field has been set.
+func (l *LogsMetric) HasFilter() bool {
+	if l != nil && l.Filter != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFilter allocates a new l.Filter and returns the pointer to it.
+func (l *LogsMetric) SetFilter(v FilterConfiguration) {
+	l.Filter = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (l *LogsMetric) GetId() string {
+	if l == nil || l.Id == nil {
+		return ""
+	}
+	return *l.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetric) GetIdOk() (string, bool) {
+	if l == nil || l.Id == nil {
+		return "", false
+	}
+	return *l.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (l *LogsMetric) HasId() bool {
+	if l != nil && l.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new l.Id and returns the pointer to it.
+func (l *LogsMetric) SetId(v string) {
+	l.Id = &v
+}
+
+// GetAggregationType returns the AggregationType field if non-nil, zero value otherwise.
+func (l *LogsMetricCompute) GetAggregationType() string {
+	if l == nil || l.AggregationType == nil {
+		return ""
+	}
+	return *l.AggregationType
+}
+
+// GetAggregationTypeOk returns a tuple with the AggregationType field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetricCompute) GetAggregationTypeOk() (string, bool) {
+	if l == nil || l.AggregationType == nil {
+		return "", false
+	}
+	return *l.AggregationType, true
+}
+
+// HasAggregationType returns a boolean if a field has been set.
+func (l *LogsMetricCompute) HasAggregationType() bool {
+	if l != nil && l.AggregationType != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAggregationType allocates a new l.AggregationType and returns the pointer to it.
+func (l *LogsMetricCompute) SetAggregationType(v string) {
+	l.AggregationType = &v
+}
+
+// GetPath returns the Path field if non-nil, zero value otherwise.
+func (l *LogsMetricCompute) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetPathOk returns a tuple with the Path field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetricCompute) GetPathOk() (string, bool) {
+	if l == nil || l.Path == nil {
+		return "", false
+	}
+	return *l.Path, true
+}
+
+// HasPath returns a boolean if a field has been set.
+func (l *LogsMetricCompute) HasPath() bool {
+	if l != nil && l.Path != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPath allocates a new l.Path and returns the pointer to it.
+func (l *LogsMetricCompute) SetPath(v string) {
+	l.Path = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (l *logsMetricData) GetAttributes() LogsMetric {
+	if l == nil || l.Attributes == nil {
+		return LogsMetric{}
+	}
+	return *l.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *logsMetricData) GetAttributesOk() (LogsMetric, bool) {
+	if l == nil || l.Attributes == nil {
+		return LogsMetric{}, false
+	}
+	return *l.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (l *logsMetricData) HasAttributes() bool {
+	if l != nil && l.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new l.Attributes and returns the pointer to it.
+func (l *logsMetricData) SetAttributes(v LogsMetric) {
+	l.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (l *logsMetricData) GetId() string {
+	if l == nil || l.Id == nil {
+		return ""
+	}
+	return *l.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *logsMetricData) GetIdOk() (string, bool) {
+	if l == nil || l.Id == nil {
+		return "", false
+	}
+	return *l.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (l *logsMetricData) HasId() bool {
+	if l != nil && l.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new l.Id and returns the pointer to it.
+func (l *logsMetricData) SetId(v string) {
+	l.Id = &v
+}
+
+// GetPath returns the Path field if non-nil, zero value otherwise.
+func (l *LogsMetricGroupBy) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetPathOk returns a tuple with the Path field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetricGroupBy) GetPathOk() (string, bool) {
+	if l == nil || l.Path == nil {
+		return "", false
+	}
+	return *l.Path, true
+}
+
+// HasPath returns a boolean if a field has been set.
+func (l *LogsMetricGroupBy) HasPath() bool {
+	if l != nil && l.Path != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPath allocates a new l.Path and returns the pointer to it.
+func (l *LogsMetricGroupBy) SetPath(v string) {
+	l.Path = &v
+}
+
+// GetTagName returns the TagName field if non-nil, zero value otherwise.
+func (l *LogsMetricGroupBy) GetTagName() string {
+	if l == nil || l.TagName == nil {
+		return ""
+	}
+	return *l.TagName
+}
+
+// GetTagNameOk returns a tuple with the TagName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsMetricGroupBy) GetTagNameOk() (string, bool) {
+	if l == nil || l.TagName == nil {
+		return "", false
+	}
+	return *l.TagName, true
+}
+
+// HasTagName returns a boolean if a field has been set.
+func (l *LogsMetricGroupBy) HasTagName() bool {
+	if l != nil && l.TagName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTagName allocates a new l.TagName and returns the pointer to it.
+func (l *LogsMetricGroupBy) SetTagName(v string) {
+	l.TagName = &v
+}
+
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetFilter() FilterConfiguration {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}
+	}
+	return *l.Filter
+}
+
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetFilterOk() (FilterConfiguration, bool) {
+	if l == nil || l.Filter == nil {
+		return FilterConfiguration{}, false
+	}
+	return *l.Filter, true
+}
+
+// HasFilter returns a boolean if a field has been set.
+func (l *LogsPipeline) HasFilter() bool {
+	if l != nil && l.Filter != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFilter allocates a new l.Filter and returns the pointer to it.
+func (l *LogsPipeline) SetFilter(v FilterConfiguration) {
+	l.Filter = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetId() string {
+	if l == nil || l.Id == nil {
+		return ""
+	}
+	return *l.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetIdOk() (string, bool) {
+	if l == nil || l.Id == nil {
+		return "", false
+	}
+	return *l.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (l *LogsPipeline) HasId() bool {
+	if l != nil && l.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new l.Id and returns the pointer to it.
+func (l *LogsPipeline) SetId(v string) {
+	l.Id = &v
+}
+
+// GetIsEnabled returns the IsEnabled field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetIsEnabled() bool {
+	if l == nil || l.IsEnabled == nil {
+		return false
+	}
+	return *l.IsEnabled
+}
+
+// GetIsEnabledOk returns a tuple with the IsEnabled field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetIsEnabledOk() (bool, bool) {
+	if l == nil || l.IsEnabled == nil {
+		return false, false
+	}
+	return *l.IsEnabled, true
+}
+
+// HasIsEnabled returns a boolean if a field has been set.
+func (l *LogsPipeline) HasIsEnabled() bool {
+	if l != nil && l.IsEnabled != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsEnabled allocates a new l.IsEnabled and returns the pointer to it.
+func (l *LogsPipeline) SetIsEnabled(v bool) {
+	l.IsEnabled = &v
+}
+
+// GetIsReadOnly returns the IsReadOnly field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetIsReadOnly() bool {
+	if l == nil || l.IsReadOnly == nil {
+		return false
+	}
+	return *l.IsReadOnly
+}
+
+// GetIsReadOnlyOk returns a tuple with the IsReadOnly field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetIsReadOnlyOk() (bool, bool) {
+	if l == nil || l.IsReadOnly == nil {
+		return false, false
+	}
+	return *l.IsReadOnly, true
+}
+
+// HasIsReadOnly returns a boolean if a field has been set.
+func (l *LogsPipeline) HasIsReadOnly() bool {
+	if l != nil && l.IsReadOnly != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsReadOnly allocates a new l.IsReadOnly and returns the pointer to it.
+func (l *LogsPipeline) SetIsReadOnly(v bool) {
+	l.IsReadOnly = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetNameOk() (string, bool) {
+	if l == nil || l.Name == nil {
+		return "", false
+	}
+	return *l.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (l *LogsPipeline) HasName() bool {
+	if l != nil && l.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new l.Name and returns the pointer to it.
+func (l *LogsPipeline) SetName(v string) {
+	l.Name = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (l *LogsPipeline) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsPipeline) GetTypeOk() (string, bool) {
+	if l == nil || l.Type == nil {
+		return "", false
+	}
+	return *l.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (l *LogsPipeline) HasType() bool {
+	if l != nil && l.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new l.Type and returns the pointer to it.
+func (l *LogsPipeline) SetType(v string) {
+	l.Type = &v
+}
+
+// GetCategoryProcessor returns the CategoryProcessor field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetCategoryProcessor() CategoryProcessor {
+	if l == nil || l.CategoryProcessor == nil {
+		return CategoryProcessor{}
+	}
+	return *l.CategoryProcessor
+}
+
+// GetCategoryProcessorOk returns a tuple with the CategoryProcessor field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetCategoryProcessorOk() (CategoryProcessor, bool) {
+	if l == nil || l.CategoryProcessor == nil {
+		return CategoryProcessor{}, false
+	}
+	return *l.CategoryProcessor, true
+}
+
+// HasCategoryProcessor returns a boolean if a field has been set.
+func (l *LogsProcessor) HasCategoryProcessor() bool {
+	if l != nil && l.CategoryProcessor != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCategoryProcessor allocates a new l.CategoryProcessor and returns the pointer to it.
+func (l *LogsProcessor) SetCategoryProcessor(v CategoryProcessor) {
+	l.CategoryProcessor = &v
+}
+
+// GetDateRemapper returns the DateRemapper field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetDateRemapper() DateRemapper {
+	if l == nil || l.DateRemapper == nil {
+		return DateRemapper{}
+	}
+	return *l.DateRemapper
+}
+
+// GetDateRemapperOk returns a tuple with the DateRemapper field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetDateRemapperOk() (DateRemapper, bool) {
+	if l == nil || l.DateRemapper == nil {
+		return DateRemapper{}, false
+	}
+	return *l.DateRemapper, true
+}
+
+// HasDateRemapper returns a boolean if a field has been set.
+func (l *LogsProcessor) HasDateRemapper() bool {
+	if l != nil && l.DateRemapper != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDateRemapper allocates a new l.DateRemapper and returns the pointer to it.
+func (l *LogsProcessor) SetDateRemapper(v DateRemapper) {
+	l.DateRemapper = &v
+}
+
+// GetGrokParser returns the GrokParser field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetGrokParser() GrokParser {
+	if l == nil || l.GrokParser == nil {
+		return GrokParser{}
+	}
+	return *l.GrokParser
+}
+
+// GetGrokParserOk returns a tuple with the GrokParser field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetGrokParserOk() (GrokParser, bool) {
+	if l == nil || l.GrokParser == nil {
+		return GrokParser{}, false
+	}
+	return *l.GrokParser, true
+}
+
+// HasGrokParser returns a boolean if a field has been set.
+func (l *LogsProcessor) HasGrokParser() bool {
+	if l != nil && l.GrokParser != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetGrokParser allocates a new l.GrokParser and returns the pointer to it.
+func (l *LogsProcessor) SetGrokParser(v GrokParser) {
+	l.GrokParser = &v
+}
+
+// GetIsEnabled returns the IsEnabled field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetIsEnabled() bool {
+	if l == nil || l.IsEnabled == nil {
+		return false
+	}
+	return *l.IsEnabled
+}
+
+// GetIsEnabledOk returns a tuple with the IsEnabled field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetIsEnabledOk() (bool, bool) {
+	if l == nil || l.IsEnabled == nil {
+		return false, false
+	}
+	return *l.IsEnabled, true
+}
+
+// HasIsEnabled returns a boolean if a field has been set.
+func (l *LogsProcessor) HasIsEnabled() bool {
+	if l != nil && l.IsEnabled != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsEnabled allocates a new l.IsEnabled and returns the pointer to it.
+func (l *LogsProcessor) SetIsEnabled(v bool) {
+	l.IsEnabled = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetNameOk() (string, bool) {
+	if l == nil || l.Name == nil {
+		return "", false
+	}
+	return *l.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (l *LogsProcessor) HasName() bool {
+	if l != nil && l.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new l.Name and returns the pointer to it.
+func (l *LogsProcessor) SetName(v string) {
+	l.Name = &v
+}
+
+// GetNestedPipeline returns the NestedPipeline field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetNestedPipeline() NestedPipeline {
+	if l == nil || l.NestedPipeline == nil {
+		return NestedPipeline{}
+	}
+	return *l.NestedPipeline
+}
+
+// GetNestedPipelineOk returns a tuple with the NestedPipeline field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetNestedPipelineOk() (NestedPipeline, bool) {
+	if l == nil || l.NestedPipeline == nil {
+		return NestedPipeline{}, false
+	}
+	return *l.NestedPipeline, true
+}
+
+// HasNestedPipeline returns a boolean if a field has been set.
+func (l *LogsProcessor) HasNestedPipeline() bool {
+	if l != nil && l.NestedPipeline != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetNestedPipeline allocates a new l.NestedPipeline and returns the pointer to it.
+func (l *LogsProcessor) SetNestedPipeline(v NestedPipeline) {
+	l.NestedPipeline = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (l *LogsProcessor) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *LogsProcessor) GetTypeOk() (string, bool) {
+	if l == nil || l.Type == nil {
+		return "", false
+	}
+	return *l.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (l *LogsProcessor) HasType() bool {
+	if l != nil && l.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new l.Type and returns the pointer to it.
+func (l *LogsProcessor) SetType(v string) {
+	l.Type = &v
+}
+
+// GetIsEnabled returns the IsEnabled field if non-nil, zero value otherwise.
+func (l *logsProcessorCommon) GetIsEnabled() bool {
+	if l == nil || l.IsEnabled == nil {
+		return false
+	}
+	return *l.IsEnabled
+}
+
+// GetIsEnabledOk returns a tuple with the IsEnabled field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *logsProcessorCommon) GetIsEnabledOk() (bool, bool) {
+	if l == nil || l.IsEnabled == nil {
+		return false, false
+	}
+	return *l.IsEnabled, true
+}
+
+// HasIsEnabled returns a boolean if a field has been set.
+func (l *logsProcessorCommon) HasIsEnabled() bool {
+	if l != nil && l.IsEnabled != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsEnabled allocates a new l.IsEnabled and returns the pointer to it.
+func (l *logsProcessorCommon) SetIsEnabled(v bool) {
+	l.IsEnabled = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (l *logsProcessorCommon) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *logsProcessorCommon) GetNameOk() (string, bool) {
+	if l == nil || l.Name == nil {
+		return "", false
+	}
+	return *l.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (l *logsProcessorCommon) HasName() bool {
+	if l != nil && l.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new l.Name and returns the pointer to it.
+func (l *logsProcessorCommon) SetName(v string) {
+	l.Name = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (l *logsProcessorCommon) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (l *logsProcessorCommon) GetTypeOk() (string, bool) {
+	if l == nil || l.Type == nil {
+		return "", false
+	}
+	return *l.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (l *logsProcessorCommon) HasType() bool {
+	if l != nil && l.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new l.Type and returns the pointer to it.
+func (l *logsProcessorCommon) SetType(v string) {
+	l.Type = &v
+}
+
+// GetActive returns the Active field if non-nil, zero value otherwise.
+func (m *MatchingDowntime) GetActive() bool {
+	if m == nil || m.Active == nil {
+		return false
+	}
+	return *m.Active
+}
+
+// GetActiveOk returns a tuple with the Active field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MatchingDowntime) GetActiveOk() (bool, bool) {
+	if m == nil || m.Active == nil {
+		return false, false
+	}
+	return *m.Active, true
+}
+
+// HasActive returns a boolean if a field has been set.
+func (m *MatchingDowntime) HasActive() bool {
+	if m != nil && m.Active != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetActive allocates a new m.Active and returns the pointer to it.
+func (m *MatchingDowntime) SetActive(v bool) {
+	m.Active = &v
+}
+
+// GetEnd returns the End field if non-nil, zero value otherwise.
+func (m *MatchingDowntime) GetEnd() int {
+	if m == nil || m.End == nil {
+		return 0
+	}
+	return *m.End
+}
+
+// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MatchingDowntime) GetEndOk() (int, bool) {
+	if m == nil || m.End == nil {
+		return 0, false
+	}
+	return *m.End, true
+}
+
+// HasEnd returns a boolean if a field has been set.
+func (m *MatchingDowntime) HasEnd() bool {
+	if m != nil && m.End != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnd allocates a new m.End and returns the pointer to it.
+func (m *MatchingDowntime) SetEnd(v int) {
+	m.End = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (m *MatchingDowntime) GetId() int {
+	if m == nil || m.Id == nil {
+		return 0
+	}
+	return *m.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MatchingDowntime) GetIdOk() (int, bool) {
+	if m == nil || m.Id == nil {
+		return 0, false
+	}
+	return *m.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (m *MatchingDowntime) HasId() bool {
+	if m != nil && m.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new m.Id and returns the pointer to it.
+func (m *MatchingDowntime) SetId(v int) {
+	m.Id = &v
+}
+
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (m *MatchingDowntime) GetStart() int {
+	if m == nil || m.Start == nil {
+		return 0
+	}
+	return *m.Start
+}
+
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MatchingDowntime) GetStartOk() (int, bool) {
+	if m == nil || m.Start == nil {
+		return 0, false
+	}
+	return *m.Start, true
+}
+
+// HasStart returns a boolean if a field has been set.
+func (m *MatchingDowntime) HasStart() bool {
+	if m != nil && m.Start != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStart allocates a new m.Start and returns the pointer to it.
+func (m *MatchingDowntime) SetStart(v int) {
+	m.Start = &v
+}
+
+// GetHost returns the Host field if non-nil, zero value otherwise.
+func (m *Metric) GetHost() string {
+	if m == nil || m.Host == nil {
+		return ""
+	}
+	return *m.Host
+}
+
+// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Metric) GetHostOk() (string, bool) {
+	if m == nil || m.Host == nil {
+		return "", false
+	}
+	return *m.Host, true
+}
+
+// HasHost returns a boolean if a field has been set.
+func (m *Metric) HasHost() bool {
+	if m != nil && m.Host != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHost allocates a new m.Host and returns the pointer to it.
+func (m *Metric) SetHost(v string) {
+	m.Host = &v
+}
+
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (m *Metric) GetMetric() string {
+	if m == nil || m.Metric == nil {
+		return ""
+	}
+	return *m.Metric
+}
+
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Metric) GetMetricOk() (string, bool) {
+	if m == nil || m.Metric == nil {
+		return "", false
+	}
+	return *m.Metric, true
+}
+
+// HasMetric returns a boolean if a field has been set.
+func (m *Metric) HasMetric() bool {
+	if m != nil && m.Metric != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetric allocates a new m.Metric and returns the pointer to it.
+func (m *Metric) SetMetric(v string) {
+	m.Metric = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (m *Metric) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Metric) GetTypeOk() (string, bool) {
+	if m == nil || m.Type == nil {
+		return "", false
+	}
+	return *m.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (m *Metric) HasType() bool {
+	if m != nil && m.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new m.Type and returns the pointer to it.
+func (m *Metric) SetType(v string) {
+	m.Type = &v
+}
+
+// GetUnit returns the Unit field if non-nil, zero value otherwise.
+func (m *Metric) GetUnit() string {
+	if m == nil || m.Unit == nil {
+		return ""
+	}
+	return *m.Unit
+}
+
+// GetUnitOk returns a tuple with the Unit field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Metric) GetUnitOk() (string, bool) {
+	if m == nil || m.Unit == nil {
+		return "", false
+	}
+	return *m.Unit, true
+}
+
+// HasUnit returns a boolean if a field has been set.
+func (m *Metric) HasUnit() bool {
+	if m != nil && m.Unit != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUnit allocates a new m.Unit and returns the pointer to it.
+func (m *Metric) SetUnit(v string) {
+	m.Unit = &v
+}
+
+// GetSpace returns the Space field if non-nil, zero value otherwise.
+func (m *MetricAggregation) GetSpace() string {
+	if m == nil || m.Space == nil {
+		return ""
+	}
+	return *m.Space
+}
+
+// GetSpaceOk returns a tuple with the Space field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricAggregation) GetSpaceOk() (string, bool) {
+	if m == nil || m.Space == nil {
+		return "", false
+	}
+	return *m.Space, true
+}
+
+// HasSpace returns a boolean if a field has been set.
+func (m *MetricAggregation) HasSpace() bool {
+	if m != nil && m.Space != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetSpace allocates a new m.Space and returns the pointer to it.
+func (m *MetricAggregation) SetSpace(v string) {
+	m.Space = &v
+}
+
+// GetTime returns the Time field if non-nil, zero value otherwise.
+func (m *MetricAggregation) GetTime() string {
+	if m == nil || m.Time == nil {
+		return ""
+	}
+	return *m.Time
+}
+
+// GetTimeOk returns a tuple with the Time field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricAggregation) GetTimeOk() (string, bool) {
+	if m == nil || m.Time == nil {
+		return "", false
+	}
+	return *m.Time, true
+}
+
+// HasTime returns a boolean if a field has been set.
+func (m *MetricAggregation) HasTime() bool {
+	if m != nil && m.Time != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTime allocates a new m.Time and returns the pointer to it.
+func (m *MetricAggregation) SetTime(v string) {
+	m.Time = &v
+}
+
+// GetDescription returns the Description field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetDescriptionOk returns a tuple with the Description field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetDescriptionOk() (string, bool) {
+	if m == nil || m.Description == nil {
+		return "", false
+	}
+	return *m.Description, true
+}
+
+// HasDescription returns a boolean if a field has been set.
+func (m *MetricMetadata) HasDescription() bool {
+	if m != nil && m.Description != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDescription allocates a new m.Description and returns the pointer to it.
+func (m *MetricMetadata) SetDescription(v string) {
+	m.Description = &v
+}
+
+// GetPerUnit returns the PerUnit field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetPerUnit() string {
+	if m == nil || m.PerUnit == nil {
+		return ""
+	}
+	return *m.PerUnit
+}
+
+// GetPerUnitOk returns a tuple with the PerUnit field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetPerUnitOk() (string, bool) {
+	if m == nil || m.PerUnit == nil {
+		return "", false
+	}
+	return *m.PerUnit, true
+}
+
+// HasPerUnit returns a boolean if a field has been set.
+func (m *MetricMetadata) HasPerUnit() bool {
+	if m != nil && m.PerUnit != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPerUnit allocates a new m.PerUnit and returns the pointer to it.
+func (m *MetricMetadata) SetPerUnit(v string) {
+	m.PerUnit = &v
+}
+
+// GetShortName returns the ShortName field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetShortName() string {
+	if m == nil || m.ShortName == nil {
+		return ""
+	}
+	return *m.ShortName
+}
+
+// GetShortNameOk returns a tuple with the ShortName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetShortNameOk() (string, bool) {
+	if m == nil || m.ShortName == nil {
+		return "", false
+	}
+	return *m.ShortName, true
+}
+
+// HasShortName returns a boolean if a field has been set.
+func (m *MetricMetadata) HasShortName() bool {
+	if m != nil && m.ShortName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetShortName allocates a new m.ShortName and returns the pointer to it.
+func (m *MetricMetadata) SetShortName(v string) {
+	m.ShortName = &v
+}
+
+// GetStatsdInterval returns the StatsdInterval field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetStatsdInterval() int {
+	if m == nil || m.StatsdInterval == nil {
+		return 0
+	}
+	return *m.StatsdInterval
+}
+
+// GetStatsdIntervalOk returns a tuple with the StatsdInterval field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetStatsdIntervalOk() (int, bool) {
+	if m == nil || m.StatsdInterval == nil {
+		return 0, false
+	}
+	return *m.StatsdInterval, true
+}
+
+// HasStatsdInterval returns a boolean if a field has been set.
+func (m *MetricMetadata) HasStatsdInterval() bool {
+	if m != nil && m.StatsdInterval != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStatsdInterval allocates a new m.StatsdInterval and returns the pointer to it.
+func (m *MetricMetadata) SetStatsdInterval(v int) {
+	m.StatsdInterval = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetTypeOk() (string, bool) {
+	if m == nil || m.Type == nil {
+		return "", false
+	}
+	return *m.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (m *MetricMetadata) HasType() bool {
+	if m != nil && m.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new m.Type and returns the pointer to it.
+func (m *MetricMetadata) SetType(v string) {
+	m.Type = &v
+}
+
+// GetUnit returns the Unit field if non-nil, zero value otherwise.
+func (m *MetricMetadata) GetUnit() string {
+	if m == nil || m.Unit == nil {
+		return ""
+	}
+	return *m.Unit
+}
+
+// GetUnitOk returns a tuple with the Unit field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricMetadata) GetUnitOk() (string, bool) {
+	if m == nil || m.Unit == nil {
+		return "", false
+	}
+	return *m.Unit, true
+}
+
+// HasUnit returns a boolean if a field has been set.
+func (m *MetricMetadata) HasUnit() bool {
+	if m != nil && m.Unit != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUnit allocates a new m.Unit and returns the pointer to it.
+func (m *MetricMetadata) SetUnit(v string) {
+	m.Unit = &v
+}
+
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (m *MetricTagConfiguration) GetCreatedAt() string {
+	if m == nil || m.CreatedAt == nil {
+		return ""
+	}
+	return *m.CreatedAt
+}
+
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricTagConfiguration) GetCreatedAtOk() (string, bool) {
+	if m == nil || m.CreatedAt == nil {
+		return "", false
+	}
+	return *m.CreatedAt, true
+}
+
+// HasCreatedAt returns a boolean if a field has been set.
+func (m *MetricTagConfiguration) HasCreatedAt() bool {
+	if m != nil && m.CreatedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedAt allocates a new m.CreatedAt and returns the pointer to it.
+func (m *MetricTagConfiguration) SetCreatedAt(v string) {
+	m.CreatedAt = &v
+}
+
+// GetIncludePercentiles returns the IncludePercentiles field if non-nil, zero value otherwise.
+func (m *MetricTagConfiguration) GetIncludePercentiles() bool {
+	if m == nil || m.IncludePercentiles == nil {
+		return false
+	}
+	return *m.IncludePercentiles
+}
+
+// GetIncludePercentilesOk returns a tuple with the IncludePercentiles field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricTagConfiguration) GetIncludePercentilesOk() (bool, bool) {
+	if m == nil || m.IncludePercentiles == nil {
+		return false, false
+	}
+	return *m.IncludePercentiles, true
+}
+
+// HasIncludePercentiles returns a boolean if a field has been set.
+func (m *MetricTagConfiguration) HasIncludePercentiles() bool {
+	if m != nil && m.IncludePercentiles != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIncludePercentiles allocates a new m.IncludePercentiles and returns the pointer to it.
+func (m *MetricTagConfiguration) SetIncludePercentiles(v bool) {
+	m.IncludePercentiles = &v
+}
+
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (m *MetricTagConfiguration) GetMetric() string {
+	if m == nil || m.Metric == nil {
+		return ""
+	}
+	return *m.Metric
+}
+
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricTagConfiguration) GetMetricOk() (string, bool) {
+	if m == nil || m.Metric == nil {
+		return "", false
+	}
+	return *m.Metric, true
+}
+
+// HasMetric returns a boolean if a field has been set.
+func (m *MetricTagConfiguration) HasMetric() bool {
+	if m != nil && m.Metric != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetric allocates a new m.Metric and returns the pointer to it.
+func (m *MetricTagConfiguration) SetMetric(v string) {
+	m.Metric = &v
+}
+
+// GetMetricType returns the MetricType field if non-nil, zero value otherwise.
+func (m *MetricTagConfiguration) GetMetricType() string {
+	if m == nil || m.MetricType == nil {
+		return ""
+	}
+	return *m.MetricType
+}
+
+// GetMetricTypeOk returns a tuple with the MetricType field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricTagConfiguration) GetMetricTypeOk() (string, bool) {
+	if m == nil || m.MetricType == nil {
+		return "", false
+	}
+	return *m.MetricType, true
+}
+
+// HasMetricType returns a boolean if a field has been set.
+func (m *MetricTagConfiguration) HasMetricType() bool {
+	if m != nil && m.MetricType != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetricType allocates a new m.MetricType and returns the pointer to it.
+func (m *MetricTagConfiguration) SetMetricType(v string) {
+	m.MetricType = &v
+}
+
+// GetModifiedAt returns the ModifiedAt field if non-nil, zero value otherwise.
+func (m *MetricTagConfiguration) GetModifiedAt() string {
+	if m == nil || m.ModifiedAt == nil {
+		return ""
+	}
+	return *m.ModifiedAt
+}
+
+// GetModifiedAtOk returns a tuple with the ModifiedAt field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MetricTagConfiguration) GetModifiedAtOk() (string, bool) {
+	if m == nil || m.ModifiedAt == nil {
+		return "", false
+	}
+	return *m.ModifiedAt, true
+}
+
+// HasModifiedAt returns a boolean if a field has been set.
+func (m *MetricTagConfiguration) HasModifiedAt() bool {
+	if m != nil && m.ModifiedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetModifiedAt allocates a new m.ModifiedAt and returns the pointer to it.
+func (m *MetricTagConfiguration) SetModifiedAt(v string) {
+	m.ModifiedAt = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (m *metricTagConfigurationData) GetAttributes() MetricTagConfiguration {
+	if m == nil || m.Attributes == nil {
+		return MetricTagConfiguration{}
+	}
+	return *m.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *metricTagConfigurationData) GetAttributesOk() (MetricTagConfiguration, bool) {
+	if m == nil || m.Attributes == nil {
+		return MetricTagConfiguration{}, false
+	}
+	return *m.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (m *metricTagConfigurationData) HasAttributes() bool {
+	if m != nil && m.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new m.Attributes and returns the pointer to it.
+func (m *metricTagConfigurationData) SetAttributes(v MetricTagConfiguration) {
+	m.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (m *metricTagConfigurationData) GetId() string {
+	if m == nil || m.Id == nil {
+		return ""
+	}
+	return *m.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *metricTagConfigurationData) GetIdOk() (string, bool) {
+	if m == nil || m.Id == nil {
+		return "", false
+	}
+	return *m.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (m *metricTagConfigurationData) HasId() bool {
+	if m != nil && m.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new m.Id and returns the pointer to it.
+func (m *metricTagConfigurationData) SetId(v string) {
+	m.Id = &v
+}
+
+// GetCreator returns the Creator field if non-nil, zero value otherwise.
+func (m *Monitor) GetCreator() Creator {
+	if m == nil || m.Creator == nil {
+		return Creator{}
+	}
+	return *m.Creator
+}
+
+// GetCreatorOk returns a tuple with the Creator field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetCreatorOk() (Creator, bool) {
+	if m == nil || m.Creator == nil {
+		return Creator{}, false
+	}
+	return *m.Creator, true
+}
+
+// HasCreator returns a boolean if a field has been set.
+func (m *Monitor) HasCreator() bool {
+	if m != nil && m.Creator != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreator allocates a new m.Creator and returns the pointer to it.
+func (m *Monitor) SetCreator(v Creator) {
+	m.Creator = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (m *Monitor) GetId() int {
+	if m == nil || m.Id == nil {
+		return 0
+	}
+	return *m.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetIdOk() (int, bool) {
+	if m == nil || m.Id == nil {
+		return 0, false
+	}
+	return *m.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (m *Monitor) HasId() bool {
+	if m != nil && m.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new m.Id and returns the pointer to it.
+func (m *Monitor) SetId(v int) {
+	m.Id = &v
+}
+
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (m *Monitor) GetMessage() string {
+	if m == nil || m.Message == nil {
+		return ""
+	}
+	return *m.Message
+}
+
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetMessageOk() (string, bool) {
+	if m == nil || m.Message == nil {
+		return "", false
+	}
+	return *m.Message, true
+}
+
+// HasMessage returns a boolean if a field has been set.
+func (m *Monitor) HasMessage() bool {
+	if m != nil && m.Message != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMessage allocates a new m.Message and returns the pointer to it.
+func (m *Monitor) SetMessage(v string) {
+	m.Message = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (m *Monitor) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetNameOk() (string, bool) {
+	if m == nil || m.Name == nil {
+		return "", false
+	}
+	return *m.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (m *Monitor) HasName() bool {
+	if m != nil && m.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new m.Name and returns the pointer to it.
+func (m *Monitor) SetName(v string) {
+	m.Name = &v
+}
+
+// GetOptions returns the Options field if non-nil, zero value otherwise.
+func (m *Monitor) GetOptions() Options {
+	if m == nil || m.Options == nil {
+		return Options{}
+	}
+	return *m.Options
+}
+
+// GetOptionsOk returns a tuple with the Options field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetOptionsOk() (Options, bool) {
+	if m == nil || m.Options == nil {
+		return Options{}, false
+	}
+	return *m.Options, true
+}
+
+// HasOptions returns a boolean if a field has been set.
+func (m *Monitor) HasOptions() bool {
+	if m != nil && m.Options != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOptions allocates a new m.Options and returns the pointer to it.
+func (m *Monitor) SetOptions(v Options) {
+	m.Options = &v
+}
+
+// GetOverallState returns the OverallState field if non-nil, zero value otherwise.
+func (m *Monitor) GetOverallState() string {
+	if m == nil || m.OverallState == nil {
+		return ""
+	}
+	return *m.OverallState
+}
+
+// GetOverallStateOk returns a tuple with the OverallState field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetOverallStateOk() (string, bool) {
+	if m == nil || m.OverallState == nil {
+		return "", false
+	}
+	return *m.OverallState, true
+}
+
+// HasOverallState returns a boolean if a field has been set.
+func (m *Monitor) HasOverallState() bool {
+	if m != nil && m.OverallState != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOverallState allocates a new m.OverallState and returns the pointer to it.
+func (m *Monitor) SetOverallState(v string) {
+	m.OverallState = &v
+}
+
+// GetOverallStateModified returns the OverallStateModified field if non-nil, zero value otherwise.
+func (m *Monitor) GetOverallStateModified() string {
+	if m == nil || m.OverallStateModified == nil {
+		return ""
+	}
+	return *m.OverallStateModified
+}
+
+// GetOverallStateModifiedOk returns a tuple with the OverallStateModified field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetOverallStateModifiedOk() (string, bool) {
+	if m == nil || m.OverallStateModified == nil {
+		return "", false
+	}
+	return *m.OverallStateModified, true
+}
+
+// HasOverallStateModified returns a boolean if a field has been set.
+func (m *Monitor) HasOverallStateModified() bool {
+	if m != nil && m.OverallStateModified != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOverallStateModified allocates a new m.OverallStateModified and returns the pointer to it.
+func (m *Monitor) SetOverallStateModified(v string) {
+	m.OverallStateModified = &v
+}
+
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (m *Monitor) GetQuery() string {
+	if m == nil || m.Query == nil {
+		return ""
+	}
+	return *m.Query
+}
+
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetQueryOk() (string, bool) {
+	if m == nil || m.Query == nil {
+		return "", false
+	}
+	return *m.Query, true
+}
+
+// HasQuery returns a boolean if a field has been set.
+func (m *Monitor) HasQuery() bool {
+	if m != nil && m.Query != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQuery allocates a new m.Query and returns the pointer to it.
+func (m *Monitor) SetQuery(v string) {
+	m.Query = &v
+}
+
+// GetRestrictedRoles returns the RestrictedRoles field if non-nil, zero value otherwise.
+func (m *Monitor) GetRestrictedRoles() []string {
+	if m == nil || m.RestrictedRoles == nil {
+		return nil
+	}
+	return *m.RestrictedRoles
+}
+
+// GetRestrictedRolesOk returns a tuple with the RestrictedRoles field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetRestrictedRolesOk() ([]string, bool) {
+	if m == nil || m.RestrictedRoles == nil {
+		return nil, false
+	}
+	return *m.RestrictedRoles, true
+}
+
+// HasRestrictedRoles returns a boolean if a field has been set.
+func (m *Monitor) HasRestrictedRoles() bool {
+	if m != nil && m.RestrictedRoles != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRestrictedRoles allocates a new m.RestrictedRoles and returns the pointer to it.
+func (m *Monitor) SetRestrictedRoles(v []string) {
+	m.RestrictedRoles = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (m *Monitor) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *Monitor) GetTypeOk() (string, bool) {
+	if m == nil || m.Type == nil {
+		return "", false
+	}
+	return *m.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (m *Monitor) HasType() bool {
+	if m != nil && m.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new m.Type and returns the pointer to it.
+func (m *Monitor) SetType(v string) {
+	m.Type = &v
+}
+
+// GetCount returns the Count field if non-nil, zero value otherwise.
+func (m *MonitorSearchCount) GetCount() int {
+	if m == nil || m.Count == nil {
+		return 0
+	}
+	return *m.Count
+}
+
+// GetCountOk returns a tuple with the Count field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchCount) GetCountOk() (int, bool) {
+	if m == nil || m.Count == nil {
+		return 0, false
+	}
+	return *m.Count, true
+}
+
+// HasCount returns a boolean if a field has been set.
+func (m *MonitorSearchCount) HasCount() bool {
+	if m != nil && m.Count != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCount allocates a new m.Count and returns the pointer to it.
+func (m *MonitorSearchCount) SetCount(v int) {
+	m.Count = &v
+}
+
+// GetClassification returns the Classification field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetClassification() string {
+	if m == nil || m.Classification == nil {
+		return ""
+	}
+	return *m.Classification
+}
+
+// GetClassificationOk returns a tuple with the Classification field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetClassificationOk() (string, bool) {
+	if m == nil || m.Classification == nil {
+		return "", false
+	}
+	return *m.Classification, true
+}
+
+// HasClassification returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasClassification() bool {
+	if m != nil && m.Classification != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetClassification allocates a new m.Classification and returns the pointer to it.
+func (m *MonitorSearchItem) SetClassification(v string) {
+	m.Classification = &v
+}
+
+// GetCreator returns the Creator field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetCreator() Creator {
+	if m == nil || m.Creator == nil {
+		return Creator{}
+	}
+	return *m.Creator
+}
+
+// GetCreatorOk returns a tuple with the Creator field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetCreatorOk() (Creator, bool) {
+	if m == nil || m.Creator == nil {
+		return Creator{}, false
+	}
+	return *m.Creator, true
+}
+
+// HasCreator returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasCreator() bool {
+	if m != nil && m.Creator != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreator allocates a new m.Creator and returns the pointer to it.
+func (m *MonitorSearchItem) SetCreator(v Creator) {
+	m.Creator = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetId() int {
+	if m == nil || m.Id == nil {
+		return 0
+	}
+	return *m.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetIdOk() (int, bool) {
+	if m == nil || m.Id == nil {
+		return 0, false
+	}
+	return *m.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasId() bool {
+	if m != nil && m.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new m.Id and returns the pointer to it.
+func (m *MonitorSearchItem) SetId(v int) {
+	m.Id = &v
+}
+
+// GetLastTriggeredTs returns the LastTriggeredTs field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetLastTriggeredTs() int {
+	if m == nil || m.LastTriggeredTs == nil {
+		return 0
+	}
+	return *m.LastTriggeredTs
+}
+
+// GetLastTriggeredTsOk returns a tuple with the LastTriggeredTs field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetLastTriggeredTsOk() (int, bool) {
+	if m == nil || m.LastTriggeredTs == nil {
+		return 0, false
+	}
+	return *m.LastTriggeredTs, true
+}
+
+// HasLastTriggeredTs returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasLastTriggeredTs() bool {
+	if m != nil && m.LastTriggeredTs != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLastTriggeredTs allocates a new m.LastTriggeredTs and returns the pointer to it.
+func (m *MonitorSearchItem) SetLastTriggeredTs(v int) {
+	m.LastTriggeredTs = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetNameOk() (string, bool) {
+	if m == nil || m.Name == nil {
+		return "", false
+	}
+	return *m.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasName() bool {
+	if m != nil && m.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new m.Name and returns the pointer to it.
+func (m *MonitorSearchItem) SetName(v string) {
+	m.Name = &v
+}
+
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetQuery() string {
+	if m == nil || m.Query == nil {
+		return ""
+	}
+	return *m.Query
+}
+
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetQueryOk() (string, bool) {
+	if m == nil || m.Query == nil {
+		return "", false
+	}
+	return *m.Query, true
+}
+
+// HasQuery returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasQuery() bool {
+	if m != nil && m.Query != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQuery allocates a new m.Query and returns the pointer to it.
+func (m *MonitorSearchItem) SetQuery(v string) {
+	m.Query = &v
+}
+
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetStatus() string {
+	if m == nil || m.Status == nil {
+		return ""
+	}
+	return *m.Status
+}
+
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetStatusOk() (string, bool) {
+	if m == nil || m.Status == nil {
+		return "", false
+	}
+	return *m.Status, true
+}
+
+// HasStatus returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasStatus() bool {
+	if m != nil && m.Status != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStatus allocates a new m.Status and returns the pointer to it.
+func (m *MonitorSearchItem) SetStatus(v string) {
+	m.Status = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (m *MonitorSearchItem) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchItem) GetTypeOk() (string, bool) {
+	if m == nil || m.Type == nil {
+		return "", false
+	}
+	return *m.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (m *MonitorSearchItem) HasType() bool {
+	if m != nil && m.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new m.Type and returns the pointer to it.
+func (m *MonitorSearchItem) SetType(v string) {
+	m.Type = &v
+}
+
+// GetPage returns the Page field if non-nil, zero value otherwise.
+func (m *MonitorSearchMetadata) GetPage() int {
+	if m == nil || m.Page == nil {
+		return 0
+	}
+	return *m.Page
+}
+
+// GetPageOk returns a tuple with the Page field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchMetadata) GetPageOk() (int, bool) {
+	if m == nil || m.Page == nil {
+		return 0, false
+	}
+	return *m.Page, true
+}
+
+// HasPage returns a boolean if a field has been set.
+func (m *MonitorSearchMetadata) HasPage() bool {
+	if m != nil && m.Page != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPage allocates a new m.Page and returns the pointer to it.
+func (m *MonitorSearchMetadata) SetPage(v int) {
+	m.Page = &v
+}
+
+// GetPageCount returns the PageCount field if non-nil, zero value otherwise.
+func (m *MonitorSearchMetadata) GetPageCount() int {
+	if m == nil || m.PageCount == nil {
+		return 0
+	}
+	return *m.PageCount
+}
+
+// GetPageCountOk returns a tuple with the PageCount field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchMetadata) GetPageCountOk() (int, bool) {
+	if m == nil || m.PageCount == nil {
+		return 0, false
+	}
+	return *m.PageCount, true
+}
+
+// HasPageCount returns a boolean if a field has been set.
+func (m *MonitorSearchMetadata) HasPageCount() bool {
+	if m != nil && m.PageCount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPageCount allocates a new m.PageCount and returns the pointer to it.
+func (m *MonitorSearchMetadata) SetPageCount(v int) {
+	m.PageCount = &v
+}
+
+// GetPerPage returns the PerPage field if non-nil, zero value otherwise.
+func (m *MonitorSearchMetadata) GetPerPage() int {
+	if m == nil || m.PerPage == nil {
+		return 0
+	}
+	return *m.PerPage
+}
+
+// GetPerPageOk returns a tuple with the PerPage field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchMetadata) GetPerPageOk() (int, bool) {
+	if m == nil || m.PerPage == nil {
+		return 0, false
+	}
+	return *m.PerPage, true
+}
+
+// HasPerPage returns a boolean if a field has been set.
+func (m *MonitorSearchMetadata) HasPerPage() bool {
+	if m != nil && m.PerPage != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPerPage allocates a new m.PerPage and returns the pointer to it.
+func (m *MonitorSearchMetadata) SetPerPage(v int) {
+	m.PerPage = &v
+}
+
+// GetTotalCount returns the TotalCount field if non-nil, zero value otherwise.
+func (m *MonitorSearchMetadata) GetTotalCount() int {
+	if m == nil || m.TotalCount == nil {
+		return 0
+	}
+	return *m.TotalCount
+}
+
+// GetTotalCountOk returns a tuple with the TotalCount field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchMetadata) GetTotalCountOk() (int, bool) {
+	if m == nil || m.TotalCount == nil {
+		return 0, false
+	}
+	return *m.TotalCount, true
+}
+
+// HasTotalCount returns a boolean if a field has been set.
+func (m *MonitorSearchMetadata) HasTotalCount() bool {
+	if m != nil && m.TotalCount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTotalCount allocates a new m.TotalCount and returns the pointer to it.
+func (m *MonitorSearchMetadata) SetTotalCount(v int) {
+	m.TotalCount = &v
+}
+
+// GetCounts returns the Counts field if non-nil, zero value otherwise.
+func (m *MonitorSearchResult) GetCounts() MonitorSearchCounts {
+	if m == nil || m.Counts == nil {
+		return MonitorSearchCounts{}
+	}
+	return *m.Counts
+}
+
+// GetCountsOk returns a tuple with the Counts field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchResult) GetCountsOk() (MonitorSearchCounts, bool) {
+	if m == nil || m.Counts == nil {
+		return MonitorSearchCounts{}, false
+	}
+	return *m.Counts, true
+}
+
+// HasCounts returns a boolean if a field has been set.
+func (m *MonitorSearchResult) HasCounts() bool {
+	if m != nil && m.Counts != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCounts allocates a new m.Counts and returns the pointer to it.
+func (m *MonitorSearchResult) SetCounts(v MonitorSearchCounts) {
+	m.Counts = &v
+}
+
+// GetMetadata returns the Metadata field if non-nil, zero value otherwise.
+func (m *MonitorSearchResult) GetMetadata() MonitorSearchMetadata {
+	if m == nil || m.Metadata == nil {
+		return MonitorSearchMetadata{}
+	}
+	return *m.Metadata
+}
+
+// GetMetadataOk returns a tuple with the Metadata field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MonitorSearchResult) GetMetadataOk() (MonitorSearchMetadata, bool) {
+	if m == nil || m.Metadata == nil {
+		return MonitorSearchMetadata{}, false
+	}
+	return *m.Metadata, true
+}
+
+// HasMetadata returns a boolean if a field has been set.
+func (m *MonitorSearchResult) HasMetadata() bool {
+	if m != nil && m.Metadata != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadata allocates a new m.Metadata and returns the pointer to it.
+func (m *MonitorSearchResult) SetMetadata(v MonitorSearchMetadata) {
+	m.Metadata = &v
+}
+
+// GetEnd returns the End field if non-nil, zero value otherwise.
+func (m *MuteMonitorOptions) GetEnd() int {
+	if m == nil || m.End == nil {
+		return 0
+	}
+	return *m.End
+}
+
+// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MuteMonitorOptions) GetEndOk() (int, bool) {
+	if m == nil || m.End == nil {
+		return 0, false
+	}
+	return *m.End, true
+}
+
+// HasEnd returns a boolean if a field has been set.
+func (m *MuteMonitorOptions) HasEnd() bool {
+	if m != nil && m.End != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnd allocates a new m.End and returns the pointer to it.
+func (m *MuteMonitorOptions) SetEnd(v int) {
+	m.End = &v
+}
+
+// GetScope returns the Scope field if non-nil, zero value otherwise.
+func (m *MuteMonitorOptions) GetScope() string {
+	if m == nil || m.Scope == nil {
+		return ""
+	}
+	return *m.Scope
+}
+
+// GetScopeOk returns a tuple with the Scope field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (m *MuteMonitorOptions) GetScopeOk() (string, bool) {
+	if m == nil || m.Scope == nil {
+		return "", false
+	}
+	return *m.Scope, true
+}
+
+// HasScope returns a boolean if a field has been set.
+func (m *MuteMonitorOptions) HasScope() bool {
+	if m != nil && m.Scope != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetScope allocates a new m.Scope and returns the pointer to it.
+func (m *MuteMonitorOptions) SetScope(v string) {
+	m.Scope = &v
+}
+
+// GetFilter returns the Filter field if non-nil, zero value otherwise.
+func (n *NestedPipeline) GetFilter() FilterConfiguration {
+	if n == nil || n.Filter == nil {
+		return FilterConfiguration{}
+	}
+	return *n.Filter
+}
+
+// GetFilterOk returns a tuple with the Filter field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NestedPipeline) GetFilterOk() (FilterConfiguration, bool) {
+	if n == nil || n.Filter == nil {
+		return FilterConfiguration{}, false
+	}
+	return *n.Filter, true
+}
+
+// HasFilter returns a boolean if a field has been set.
+func (n *NestedPipeline) HasFilter() bool {
+	if n != nil && n.Filter != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFilter allocates a new n.Filter and returns the pointer to it.
+func (n *NestedPipeline) SetFilter(v FilterConfiguration) {
+	n.Filter = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (n *Notebook) GetId() int {
+	if n == nil || n.Id == nil {
+		return 0
+	}
+	return *n.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *Notebook) GetIdOk() (int, bool) {
+	if n == nil || n.Id == nil {
+		return 0, false
+	}
+	return *n.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (n *Notebook) HasId() bool {
+	if n != nil && n.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new n.Id and returns the pointer to it.
+func (n *Notebook) SetId(v int) {
+	n.Id = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (n *Notebook) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *Notebook) GetNameOk() (string, bool) {
+	if n == nil || n.Name == nil {
+		return "", false
+	}
+	return *n.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (n *Notebook) HasName() bool {
+	if n != nil && n.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new n.Name and returns the pointer to it.
+func (n *Notebook) SetName(v string) {
+	n.Name = &v
+}
+
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (n *Notebook) GetStatus() string {
+	if n == nil || n.Status == nil {
+		return ""
+	}
+	return *n.Status
+}
+
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *Notebook) GetStatusOk() (string, bool) {
+	if n == nil || n.Status == nil {
+		return "", false
+	}
+	return *n.Status, true
+}
+
+// HasStatus returns a boolean if a field has been set.
+func (n *Notebook) HasStatus() bool {
+	if n != nil && n.Status != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStatus allocates a new n.Status and returns the pointer to it.
+func (n *Notebook) SetStatus(v string) {
+	n.Status = &v
+}
+
+// GetTime returns the Time field if non-nil, zero value otherwise.
+func (n *Notebook) GetTime() NotebookTime {
+	if n == nil || n.Time == nil {
+		return NotebookTime{}
+	}
+	return *n.Time
+}
+
+// GetTimeOk returns a tuple with the Time field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *Notebook) GetTimeOk() (NotebookTime, bool) {
+	if n == nil || n.Time == nil {
+		return NotebookTime{}, false
+	}
+	return *n.Time, true
+}
+
+// HasTime returns a boolean if a field has been set.
+func (n *Notebook) HasTime() bool {
+	if n != nil && n.Time != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTime allocates a new n.Time and returns the pointer to it.
+func (n *Notebook) SetTime(v NotebookTime) {
+	n.Time = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetId() string {
+	if n == nil || n.Id == nil {
+		return ""
+	}
+	return *n.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetIdOk() (string, bool) {
+	if n == nil || n.Id == nil {
+		return "", false
+	}
+	return *n.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (n *NotebookCell) HasId() bool {
+	if n != nil && n.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new n.Id and returns the pointer to it.
+func (n *NotebookCell) SetId(v string) {
+	n.Id = &v
+}
+
+// GetLogStream returns the LogStream field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetLogStream() NotebookLogStreamDefinition {
+	if n == nil || n.LogStream == nil {
+		return NotebookLogStreamDefinition{}
+	}
+	return *n.LogStream
+}
+
+// GetLogStreamOk returns a tuple with the LogStream field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetLogStreamOk() (NotebookLogStreamDefinition, bool) {
+	if n == nil || n.LogStream == nil {
+		return NotebookLogStreamDefinition{}, false
+	}
+	return *n.LogStream, true
+}
+
+// HasLogStream returns a boolean if a field has been set.
+func (n *NotebookCell) HasLogStream() bool {
+	if n != nil && n.LogStream != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLogStream allocates a new n.LogStream and returns the pointer to it.
+func (n *NotebookCell) SetLogStream(v NotebookLogStreamDefinition) {
+	n.LogStream = &v
+}
+
+// GetMarkdown returns the Markdown field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetMarkdown() NotebookMarkdownDefinition {
+	if n == nil || n.Markdown == nil {
+		return NotebookMarkdownDefinition{}
+	}
+	return *n.Markdown
+}
+
+// GetMarkdownOk returns a tuple with the Markdown field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetMarkdownOk() (NotebookMarkdownDefinition, bool) {
+	if n == nil || n.Markdown == nil {
+		return NotebookMarkdownDefinition{}, false
+	}
+	return *n.Markdown, true
+}
+
+// HasMarkdown returns a boolean if a field has been set.
+func (n *NotebookCell) HasMarkdown() bool {
+	if n != nil && n.Markdown != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMarkdown allocates a new n.Markdown and returns the pointer to it.
+func (n *NotebookCell) SetMarkdown(v NotebookMarkdownDefinition) {
+	n.Markdown = &v
+}
+
+// GetTimeseries returns the Timeseries field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetTimeseries() NotebookGraphDefinition {
+	if n == nil || n.Timeseries == nil {
+		return NotebookGraphDefinition{}
+	}
+	return *n.Timeseries
+}
+
+// GetTimeseriesOk returns a tuple with the Timeseries field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetTimeseriesOk() (NotebookGraphDefinition, bool) {
+	if n == nil || n.Timeseries == nil {
+		return NotebookGraphDefinition{}, false
+	}
+	return *n.Timeseries, true
+}
+
+// HasTimeseries returns a boolean if a field has been set.
+func (n *NotebookCell) HasTimeseries() bool {
+	if n != nil && n.Timeseries != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTimeseries allocates a new n.Timeseries and returns the pointer to it.
+func (n *NotebookCell) SetTimeseries(v NotebookGraphDefinition) {
+	n.Timeseries = &v
+}
+
+// GetToplist returns the Toplist field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetToplist() NotebookGraphDefinition {
+	if n == nil || n.Toplist == nil {
+		return NotebookGraphDefinition{}
+	}
+	return *n.Toplist
+}
+
+// GetToplistOk returns a tuple with the Toplist field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetToplistOk() (NotebookGraphDefinition, bool) {
+	if n == nil || n.Toplist == nil {
+		return NotebookGraphDefinition{}, false
+	}
+	return *n.Toplist, true
+}
+
+// HasToplist returns a boolean if a field has been set.
+func (n *NotebookCell) HasToplist() bool {
+	if n != nil && n.Toplist != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetToplist allocates a new n.Toplist and returns the pointer to it.
+func (n *NotebookCell) SetToplist(v NotebookGraphDefinition) {
+	n.Toplist = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (n *NotebookCell) GetType() string {
+	if n == nil || n.Type == nil {
+		return ""
+	}
+	return *n.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookCell) GetTypeOk() (string, bool) {
+	if n == nil || n.Type == nil {
+		return "", false
+	}
+	return *n.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (n *NotebookCell) HasType() bool {
+	if n != nil && n.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new n.Type and returns the pointer to it.
+func (n *NotebookCell) SetType(v string) {
+	n.Type = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (n *notebookCellJSON) GetId() string {
+	if n == nil || n.Id == nil {
+		return ""
+	}
+	return *n.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *notebookCellJSON) GetIdOk() (string, bool) {
+	if n == nil || n.Id == nil {
+		return "", false
+	}
+	return *n.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (n *notebookCellJSON) HasId() bool {
+	if n != nil && n.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new n.Id and returns the pointer to it.
+func (n *notebookCellJSON) SetId(v string) {
+	n.Id = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (n *notebookData) GetAttributes() Notebook {
+	if n == nil || n.Attributes == nil {
+		return Notebook{}
+	}
+	return *n.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *notebookData) GetAttributesOk() (Notebook, bool) {
+	if n == nil || n.Attributes == nil {
+		return Notebook{}, false
+	}
+	return *n.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (n *notebookData) HasAttributes() bool {
+	if n != nil && n.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new n.Attributes and returns the pointer to it.
+func (n *notebookData) SetAttributes(v Notebook) {
+	n.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (n *notebookData) GetId() int {
+	if n == nil || n.Id == nil {
+		return 0
+	}
+	return *n.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *notebookData) GetIdOk() (int, bool) {
+	if n == nil || n.Id == nil {
+		return 0, false
+	}
+	return *n.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (n *notebookData) HasId() bool {
+	if n != nil && n.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new n.Id and returns the pointer to it.
+func (n *notebookData) SetId(v int) {
+	n.Id = &v
+}
+
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (n *NotebookGraphDefinition) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
+	}
+	return *n.Title
+}
+
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookGraphDefinition) GetTitleOk() (string, bool) {
+	if n == nil || n.Title == nil {
+		return "", false
+	}
+	return *n.Title, true
+}
+
+// HasTitle returns a boolean if a field has been set.
+func (n *NotebookGraphDefinition) HasTitle() bool {
+	if n != nil && n.Title != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTitle allocates a new n.Title and returns the pointer to it.
+func (n *NotebookGraphDefinition) SetTitle(v string) {
+	n.Title = &v
+}
+
+// GetDisplay returns the Display field if non-nil, zero value otherwise.
+func (n *NotebookGraphRequest) GetDisplay() string {
+	if n == nil || n.Display == nil {
+		return ""
+	}
+	return *n.Display
+}
+
+// GetDisplayOk returns a tuple with the Display field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookGraphRequest) GetDisplayOk() (string, bool) {
+	if n == nil || n.Display == nil {
+		return "", false
+	}
+	return *n.Display, true
+}
+
+// HasDisplay returns a boolean if a field has been set.
+func (n *NotebookGraphRequest) HasDisplay() bool {
+	if n != nil && n.Display != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDisplay allocates a new n.Display and returns the pointer to it.
+func (n *NotebookGraphRequest) SetDisplay(v string) {
+	n.Display = &v
+}
+
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (n *NotebookGraphRequest) GetQuery() string {
+	if n == nil || n.Query == nil {
+		return ""
+	}
+	return *n.Query
+}
+
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookGraphRequest) GetQueryOk() (string, bool) {
+	if n == nil || n.Query == nil {
+		return "", false
+	}
+	return *n.Query, true
+}
+
+// HasQuery returns a boolean if a field has been set.
+func (n *NotebookGraphRequest) HasQuery() bool {
+	if n != nil && n.Query != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQuery allocates a new n.Query and returns the pointer to it.
+func (n *NotebookGraphRequest) SetQuery(v string) {
+	n.Query = &v
+}
+
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (n *NotebookLogStreamDefinition) GetQuery() string {
+	if n == nil || n.Query == nil {
+		return ""
+	}
+	return *n.Query
+}
+
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookLogStreamDefinition) GetQueryOk() (string, bool) {
+	if n == nil || n.Query == nil {
+		return "", false
+	}
+	return *n.Query, true
+}
+
+// HasQuery returns a boolean if a field has been set.
+func (n *NotebookLogStreamDefinition) HasQuery() bool {
+	if n != nil && n.Query != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQuery allocates a new n.Query and returns the pointer to it.
+func (n *NotebookLogStreamDefinition) SetQuery(v string) {
+	n.Query = &v
+}
+
+// GetText returns the Text field if non-nil, zero value otherwise.
+func (n *NotebookMarkdownDefinition) GetText() string {
+	if n == nil || n.Text == nil {
+		return ""
+	}
+	return *n.Text
+}
+
+// GetTextOk returns a tuple with the Text field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookMarkdownDefinition) GetTextOk() (string, bool) {
+	if n == nil || n.Text == nil {
+		return "", false
+	}
+	return *n.Text, true
+}
+
+// HasText returns a boolean if a field has been set.
+func (n *NotebookMarkdownDefinition) HasText() bool {
+	if n != nil && n.Text != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetText allocates a new n.Text and returns the pointer to it.
+func (n *NotebookMarkdownDefinition) SetText(v string) {
+	n.Text = &v
+}
+
+// GetEnd returns the End field if non-nil, zero value otherwise.
+func (n *NotebookTime) GetEnd() string {
+	if n == nil || n.End == nil {
+		return ""
+	}
+	return *n.End
+}
+
+// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookTime) GetEndOk() (string, bool) {
+	if n == nil || n.End == nil {
+		return "", false
+	}
+	return *n.End, true
+}
+
+// HasEnd returns a boolean if a field has been set.
+func (n *NotebookTime) HasEnd() bool {
+	if n != nil && n.End != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnd allocates a new n.End and returns the pointer to it.
+func (n *NotebookTime) SetEnd(v string) {
+	n.End = &v
+}
+
+// GetLiveSpan returns the LiveSpan field if non-nil, zero value otherwise.
+func (n *NotebookTime) GetLiveSpan() string {
+	if n == nil || n.LiveSpan == nil {
+		return ""
+	}
+	return *n.LiveSpan
+}
+
+// GetLiveSpanOk returns a tuple with the LiveSpan field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookTime) GetLiveSpanOk() (string, bool) {
+	if n == nil || n.LiveSpan == nil {
+		return "", false
+	}
+	return *n.LiveSpan, true
+}
+
+// HasLiveSpan returns a boolean if a field has been set.
+func (n *NotebookTime) HasLiveSpan() bool {
+	if n != nil && n.LiveSpan != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLiveSpan allocates a new n.LiveSpan and returns the pointer to it.
+func (n *NotebookTime) SetLiveSpan(v string) {
+	n.LiveSpan = &v
+}
+
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (n *NotebookTime) GetStart() string {
+	if n == nil || n.Start == nil {
+		return ""
+	}
+	return *n.Start
+}
+
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (n *NotebookTime) GetStartOk() (string, bool) {
+	if n == nil || n.Start == nil {
+		return "", false
+	}
+	return *n.Start, true
+}
+
+// HasStart returns a boolean if a field has been set.
+func (n *NotebookTime) HasStart() bool {
+	if n != nil && n.Start != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStart allocates a new n.Start and returns the pointer to it.
+func (n *NotebookTime) SetStart(v string) {
+	n.Start = &v
+}
+
+// GetEnableLogsSample returns the EnableLogsSample field if non-nil, zero value otherwise.
+func (o *Options) GetEnableLogsSample() bool {
+	if o == nil || o.EnableLogsSample == nil {
+		return false
+	}
+	return *o.EnableLogsSample
+}
+
+// GetEnableLogsSampleOk returns a tuple with the EnableLogsSample field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetEnableLogsSampleOk() (bool, bool) {
+	if o == nil || o.EnableLogsSample == nil {
+		return false, false
+	}
+	return *o.EnableLogsSample, true
+}
+
+// HasEnableLogsSample returns a boolean if a field has been set.
+func (o *Options) HasEnableLogsSample() bool {
+	if o != nil && o.EnableLogsSample != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnableLogsSample allocates a new o.EnableLogsSample and returns the pointer to it.
+func (o *Options) SetEnableLogsSample(v bool) {
+	o.EnableLogsSample = &v
+}
+
+// GetEscalationMessage returns the EscalationMessage field if non-nil, zero value otherwise.
+func (o *Options) GetEscalationMessage() string {
+	if o == nil || o.EscalationMessage == nil {
+		return ""
+	}
+	return *o.EscalationMessage
+}
+
+// GetEscalationMessageOk returns a tuple with the EscalationMessage field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetEscalationMessageOk() (string, bool) {
+	if o == nil || o.EscalationMessage == nil {
+		return "", false
+	}
+	return *o.EscalationMessage, true
+}
+
+// HasEscalationMessage returns a boolean if a field has been set.
+func (o *Options) HasEscalationMessage() bool {
+	if o != nil && o.EscalationMessage != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEscalationMessage allocates a new o.EscalationMessage and returns the pointer to it.
+func (o *Options) SetEscalationMessage(v string) {
+	o.EscalationMessage = &v
+}
+
+// GetEvaluationDelay returns the EvaluationDelay field if non-nil, zero value otherwise.
+func (o *Options) GetEvaluationDelay() int {
+	if o == nil || o.EvaluationDelay == nil {
+		return 0
+	}
+	return *o.EvaluationDelay
+}
+
+// GetEvaluationDelayOk returns a tuple with the EvaluationDelay field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetEvaluationDelayOk() (int, bool) {
+	if o == nil || o.EvaluationDelay == nil {
+		return 0, false
+	}
+	return *o.EvaluationDelay, true
+}
+
+// HasEvaluationDelay returns a boolean if a field has been set.
+func (o *Options) HasEvaluationDelay() bool {
+	if o != nil && o.EvaluationDelay != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEvaluationDelay allocates a new o.EvaluationDelay and returns the pointer to it.
+func (o *Options) SetEvaluationDelay(v int) {
+	o.EvaluationDelay = &v
+}
+
+// GetIncludeTags returns the IncludeTags field if non-nil, zero value otherwise.
+func (o *Options) GetIncludeTags() bool {
+	if o == nil || o.IncludeTags == nil {
+		return false
+	}
+	return *o.IncludeTags
+}
+
+// GetIncludeTagsOk returns a tuple with the IncludeTags field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetIncludeTagsOk() (bool, bool) {
+	if o == nil || o.IncludeTags == nil {
+		return false, false
+	}
+	return *o.IncludeTags, true
+}
+
+// HasIncludeTags returns a boolean if a field has been set.
+func (o *Options) HasIncludeTags() bool {
+	if o != nil && o.IncludeTags != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIncludeTags allocates a new o.IncludeTags and returns the pointer to it.
+func (o *Options) SetIncludeTags(v bool) {
+	o.IncludeTags = &v
+}
+
+// GetLocked returns the Locked field if non-nil, zero value otherwise.
+func (o *Options) GetLocked() bool {
+	if o == nil || o.Locked == nil {
+		return false
+	}
+	return *o.Locked
+}
+
+// GetLockedOk returns a tuple with the Locked field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetLockedOk() (bool, bool) {
+	if o == nil || o.Locked == nil {
+		return false, false
+	}
+	return *o.Locked, true
+}
+
+// HasLocked returns a boolean if a field has been set.
+func (o *Options) HasLocked() bool {
+	if o != nil && o.Locked != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLocked allocates a new o.Locked and returns the pointer to it.
+func (o *Options) SetLocked(v bool) {
+	o.Locked = &v
+}
+
+// GetNewHostDelay returns the NewHostDelay field if non-nil, zero value otherwise.
+func (o *Options) GetNewHostDelay() int {
+	if o == nil || o.NewHostDelay == nil {
+		return 0
+	}
+	return *o.NewHostDelay
+}
+
+// GetNewHostDelayOk returns a tuple with the NewHostDelay field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetNewHostDelayOk() (int, bool) {
+	if o == nil || o.NewHostDelay == nil {
+		return 0, false
+	}
+	return *o.NewHostDelay, true
+}
+
+// HasNewHostDelay returns a boolean if a field has been set.
+func (o *Options) HasNewHostDelay() bool {
+	if o != nil && o.NewHostDelay != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetNewHostDelay allocates a new o.NewHostDelay and returns the pointer to it.
+func (o *Options) SetNewHostDelay(v int) {
+	o.NewHostDelay = &v
+}
+
+// GetNotifyAudit returns the NotifyAudit field if non-nil, zero value otherwise.
+func (o *Options) GetNotifyAudit() bool {
+	if o == nil || o.NotifyAudit == nil {
+		return false
+	}
+	return *o.NotifyAudit
+}
+
+// GetNotifyAuditOk returns a tuple with the NotifyAudit field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetNotifyAuditOk() (bool, bool) {
+	if o == nil || o.NotifyAudit == nil {
+		return false, false
+	}
+	return *o.NotifyAudit, true
+}
+
+// HasNotifyAudit returns a boolean if a field has been set.
+func (o *Options) HasNotifyAudit() bool {
+	if o != nil && o.NotifyAudit != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetNotifyAudit allocates a new o.NotifyAudit and returns the pointer to it.
+func (o *Options) SetNotifyAudit(v bool) {
+	o.NotifyAudit = &v
+}
+
+// GetNotifyNoData returns the NotifyNoData field if non-nil, zero value otherwise.
+func (o *Options) GetNotifyNoData() bool {
+	if o == nil || o.NotifyNoData == nil {
+		return false
+	}
+	return *o.NotifyNoData
+}
+
+// GetNotifyNoDataOk returns a tuple with the NotifyNoData field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetNotifyNoDataOk() (bool, bool) {
+	if o == nil || o.NotifyNoData == nil {
+		return false, false
+	}
+	return *o.NotifyNoData, true
+}
+
+// HasNotifyNoData returns a boolean if a field has been set.
+func (o *Options) HasNotifyNoData() bool {
+	if o != nil && o.NotifyNoData != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetNotifyNoData allocates a new o.NotifyNoData and returns the pointer to it.
+func (o *Options) SetNotifyNoData(v bool) {
+	o.NotifyNoData = &v
+}
+
+// GetRenotifyInterval returns the RenotifyInterval field if non-nil, zero value otherwise.
+func (o *Options) GetRenotifyInterval() int {
+	if o == nil || o.RenotifyInterval == nil {
+		return 0
+	}
+	return *o.RenotifyInterval
+}
+
+// GetRenotifyIntervalOk returns a tuple with the RenotifyInterval field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetRenotifyIntervalOk() (int, bool) {
+	if o == nil || o.RenotifyInterval == nil {
+		return 0, false
+	}
+	return *o.RenotifyInterval, true
+}
+
+// HasRenotifyInterval returns a boolean if a field has been set.
+func (o *Options) HasRenotifyInterval() bool {
+	if o != nil && o.RenotifyInterval != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRenotifyInterval allocates a new o.RenotifyInterval and returns the pointer to it.
+func (o *Options) SetRenotifyInterval(v int) {
+	o.RenotifyInterval = &v
+}
+
+// GetRenotifyOccurrences returns the RenotifyOccurrences field if non-nil, zero value otherwise.
+func (o *Options) GetRenotifyOccurrences() int {
+	if o == nil || o.RenotifyOccurrences == nil {
+		return 0
+	}
+	return *o.RenotifyOccurrences
+}
+
+// GetRenotifyOccurrencesOk returns a tuple with the RenotifyOccurrences field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetRenotifyOccurrencesOk() (int, bool) {
+	if o == nil || o.RenotifyOccurrences == nil {
+		return 0, false
+	}
+	return *o.RenotifyOccurrences, true
+}
+
+// HasRenotifyOccurrences returns a boolean if a field has been set.
+func (o *Options) HasRenotifyOccurrences() bool {
+	if o != nil && o.RenotifyOccurrences != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRenotifyOccurrences allocates a new o.RenotifyOccurrences and returns the pointer to it.
+func (o *Options) SetRenotifyOccurrences(v int) {
+	o.RenotifyOccurrences = &v
+}
+
+// GetRenotifyStatuses returns the RenotifyStatuses field if non-nil, zero value otherwise.
+func (o *Options) GetRenotifyStatuses() []string {
+	if o == nil || o.RenotifyStatuses == nil {
+		return nil
+	}
+	return *o.RenotifyStatuses
+}
+
+// GetRenotifyStatusesOk returns a tuple with the RenotifyStatuses field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetRenotifyStatusesOk() ([]string, bool) {
+	if o == nil || o.RenotifyStatuses == nil {
+		return nil, false
+	}
+	return *o.RenotifyStatuses, true
+}
+
+// HasRenotifyStatuses returns a boolean if a field has been set.
+func (o *Options) HasRenotifyStatuses() bool {
+	if o != nil && o.RenotifyStatuses != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRenotifyStatuses allocates a new o.RenotifyStatuses and returns the pointer to it.
+func (o *Options) SetRenotifyStatuses(v []string) {
+	o.RenotifyStatuses = &v
+}
+
+// GetRequireFullWindow returns the RequireFullWindow field if non-nil, zero value otherwise.
+func (o *Options) GetRequireFullWindow() bool {
+	if o == nil || o.RequireFullWindow == nil {
+		return false
+	}
+	return *o.RequireFullWindow
+}
+
+// GetRequireFullWindowOk returns a tuple with the RequireFullWindow field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetRequireFullWindowOk() (bool, bool) {
+	if o == nil || o.RequireFullWindow == nil {
+		return false, false
 	}
 	return *o.RequireFullWindow, true
 }
 
-// HasRequireFullWindow returns a boolean if a field has been set.
-func (o *Options) HasRequireFullWindow() bool {
-	if o != nil && o.RequireFullWindow != nil {
+// HasRequireFullWindow returns a boolean if a field has been set.
+func (o *Options) HasRequireFullWindow() bool {
+	if o != nil && o.RequireFullWindow != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRequireFullWindow allocates a new o.RequireFullWindow and returns the pointer to it.
+func (o *Options) SetRequireFullWindow(v bool) {
+	o.RequireFullWindow = &v
+}
+
+// GetThresholds returns the Thresholds field if non-nil, zero value otherwise.
+func (o *Options) GetThresholds() ThresholdCount {
+	if o == nil || o.Thresholds == nil {
+		return ThresholdCount{}
+	}
+	return *o.Thresholds
+}
+
+// GetThresholdsOk returns a tuple with the Thresholds field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetThresholdsOk() (ThresholdCount, bool) {
+	if o == nil || o.Thresholds == nil {
+		return ThresholdCount{}, false
+	}
+	return *o.Thresholds, true
+}
+
+// HasThresholds returns a boolean if a field has been set.
+func (o *Options) HasThresholds() bool {
+	if o != nil && o.Thresholds != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetThresholds allocates a new o.Thresholds and returns the pointer to it.
+func (o *Options) SetThresholds(v ThresholdCount) {
+	o.Thresholds = &v
+}
+
+// GetThresholdWindows returns the ThresholdWindows field if non-nil, zero value otherwise.
+func (o *Options) GetThresholdWindows() ThresholdWindows {
+	if o == nil || o.ThresholdWindows == nil {
+		return ThresholdWindows{}
+	}
+	return *o.ThresholdWindows
+}
+
+// GetThresholdWindowsOk returns a tuple with the ThresholdWindows field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetThresholdWindowsOk() (ThresholdWindows, bool) {
+	if o == nil || o.ThresholdWindows == nil {
+		return ThresholdWindows{}, false
+	}
+	return *o.ThresholdWindows, true
+}
+
+// HasThresholdWindows returns a boolean if a field has been set.
+func (o *Options) HasThresholdWindows() bool {
+	if o != nil && o.ThresholdWindows != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetThresholdWindows allocates a new o.ThresholdWindows and returns the pointer to it.
+func (o *Options) SetThresholdWindows(v ThresholdWindows) {
+	o.ThresholdWindows = &v
+}
+
+// GetTimeoutH returns the TimeoutH field if non-nil, zero value otherwise.
+func (o *Options) GetTimeoutH() int {
+	if o == nil || o.TimeoutH == nil {
+		return 0
+	}
+	return *o.TimeoutH
+}
+
+// GetTimeoutHOk returns a tuple with the TimeoutH field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (o *Options) GetTimeoutHOk() (int, bool) {
+	if o == nil || o.TimeoutH == nil {
+		return 0, false
+	}
+	return *o.TimeoutH, true
+}
+
+// HasTimeoutH returns a boolean if a field has been set.
+func (o *Options) HasTimeoutH() bool {
+	if o != nil && o.TimeoutH != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTimeoutH allocates a new o.TimeoutH and returns the pointer to it.
+func (o *Options) SetTimeoutH(v int) {
+	o.TimeoutH = &v
+}
+
+// GetCount returns the Count field if non-nil, zero value otherwise.
+func (p *Params) GetCount() string {
+	if p == nil || p.Count == nil {
+		return ""
+	}
+	return *p.Count
+}
+
+// GetCountOk returns a tuple with the Count field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *Params) GetCountOk() (string, bool) {
+	if p == nil || p.Count == nil {
+		return "", false
+	}
+	return *p.Count, true
+}
+
+// HasCount returns a boolean if a field has been set.
+func (p *Params) HasCount() bool {
+	if p != nil && p.Count != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCount allocates a new p.Count and returns the pointer to it.
+func (p *Params) SetCount(v string) {
+	p.Count = &v
+}
+
+// GetSort returns the Sort field if non-nil, zero value otherwise.
+func (p *Params) GetSort() string {
+	if p == nil || p.Sort == nil {
+		return ""
+	}
+	return *p.Sort
+}
+
+// GetSortOk returns a tuple with the Sort field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *Params) GetSortOk() (string, bool) {
+	if p == nil || p.Sort == nil {
+		return "", false
+	}
+	return *p.Sort, true
+}
+
+// HasSort returns a boolean if a field has been set.
+func (p *Params) HasSort() bool {
+	if p != nil && p.Sort != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetSort allocates a new p.Sort and returns the pointer to it.
+func (p *Params) SetSort(v string) {
+	p.Sort = &v
+}
+
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (p *Params) GetStart() string {
+	if p == nil || p.Start == nil {
+		return ""
+	}
+	return *p.Start
+}
+
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *Params) GetStartOk() (string, bool) {
+	if p == nil || p.Start == nil {
+		return "", false
+	}
+	return *p.Start, true
+}
+
+// HasStart returns a boolean if a field has been set.
+func (p *Params) HasStart() bool {
+	if p != nil && p.Start != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStart allocates a new p.Start and returns the pointer to it.
+func (p *Params) SetStart(v string) {
+	p.Start = &v
+}
+
+// GetText returns the Text field if non-nil, zero value otherwise.
+func (p *Params) GetText() string {
+	if p == nil || p.Text == nil {
+		return ""
+	}
+	return *p.Text
+}
+
+// GetTextOk returns a tuple with the Text field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *Params) GetTextOk() (string, bool) {
+	if p == nil || p.Text == nil {
+		return "", false
+	}
+	return *p.Text, true
+}
+
+// HasText returns a boolean if a field has been set.
+func (p *Params) HasText() bool {
+	if p != nil && p.Text != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetText allocates a new p.Text and returns the pointer to it.
+func (p *Params) SetText(v string) {
+	p.Text = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (p *processData) GetAttributes() ProcessSummary {
+	if p == nil || p.Attributes == nil {
+		return ProcessSummary{}
+	}
+	return *p.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *processData) GetAttributesOk() (ProcessSummary, bool) {
+	if p == nil || p.Attributes == nil {
+		return ProcessSummary{}, false
+	}
+	return *p.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (p *processData) HasAttributes() bool {
+	if p != nil && p.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new p.Attributes and returns the pointer to it.
+func (p *processData) SetAttributes(v ProcessSummary) {
+	p.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (p *processData) GetId() string {
+	if p == nil || p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *processData) GetIdOk() (string, bool) {
+	if p == nil || p.Id == nil {
+		return "", false
+	}
+	return *p.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (p *processData) HasId() bool {
+	if p != nil && p.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new p.Id and returns the pointer to it.
+func (p *processData) SetId(v string) {
+	p.Id = &v
+}
+
+// GetCmdline returns the Cmdline field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetCmdline() string {
+	if p == nil || p.Cmdline == nil {
+		return ""
+	}
+	return *p.Cmdline
+}
+
+// GetCmdlineOk returns a tuple with the Cmdline field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetCmdlineOk() (string, bool) {
+	if p == nil || p.Cmdline == nil {
+		return "", false
+	}
+	return *p.Cmdline, true
+}
+
+// HasCmdline returns a boolean if a field has been set.
+func (p *ProcessSummary) HasCmdline() bool {
+	if p != nil && p.Cmdline != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCmdline allocates a new p.Cmdline and returns the pointer to it.
+func (p *ProcessSummary) SetCmdline(v string) {
+	p.Cmdline = &v
+}
+
+// GetHost returns the Host field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetHost() string {
+	if p == nil || p.Host == nil {
+		return ""
+	}
+	return *p.Host
+}
+
+// GetHostOk returns a tuple with the Host field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetHostOk() (string, bool) {
+	if p == nil || p.Host == nil {
+		return "", false
+	}
+	return *p.Host, true
+}
+
+// HasHost returns a boolean if a field has been set.
+func (p *ProcessSummary) HasHost() bool {
+	if p != nil && p.Host != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHost allocates a new p.Host and returns the pointer to it.
+func (p *ProcessSummary) SetHost(v string) {
+	p.Host = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetId() string {
+	if p == nil || p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetIdOk() (string, bool) {
+	if p == nil || p.Id == nil {
+		return "", false
+	}
+	return *p.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (p *ProcessSummary) HasId() bool {
+	if p != nil && p.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new p.Id and returns the pointer to it.
+func (p *ProcessSummary) SetId(v string) {
+	p.Id = &v
+}
+
+// GetPid returns the Pid field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetPid() int {
+	if p == nil || p.Pid == nil {
+		return 0
+	}
+	return *p.Pid
+}
+
+// GetPidOk returns a tuple with the Pid field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetPidOk() (int, bool) {
+	if p == nil || p.Pid == nil {
+		return 0, false
+	}
+	return *p.Pid, true
+}
+
+// HasPid returns a boolean if a field has been set.
+func (p *ProcessSummary) HasPid() bool {
+	if p != nil && p.Pid != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPid allocates a new p.Pid and returns the pointer to it.
+func (p *ProcessSummary) SetPid(v int) {
+	p.Pid = &v
+}
+
+// GetPpid returns the Ppid field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetPpid() int {
+	if p == nil || p.Ppid == nil {
+		return 0
+	}
+	return *p.Ppid
+}
+
+// GetPpidOk returns a tuple with the Ppid field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetPpidOk() (int, bool) {
+	if p == nil || p.Ppid == nil {
+		return 0, false
+	}
+	return *p.Ppid, true
+}
+
+// HasPpid returns a boolean if a field has been set.
+func (p *ProcessSummary) HasPpid() bool {
+	if p != nil && p.Ppid != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPpid allocates a new p.Ppid and returns the pointer to it.
+func (p *ProcessSummary) SetPpid(v int) {
+	p.Ppid = &v
+}
+
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetStart() string {
+	if p == nil || p.Start == nil {
+		return ""
+	}
+	return *p.Start
+}
+
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetStartOk() (string, bool) {
+	if p == nil || p.Start == nil {
+		return "", false
+	}
+	return *p.Start, true
+}
+
+// HasStart returns a boolean if a field has been set.
+func (p *ProcessSummary) HasStart() bool {
+	if p != nil && p.Start != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStart allocates a new p.Start and returns the pointer to it.
+func (p *ProcessSummary) SetStart(v string) {
+	p.Start = &v
+}
+
+// GetTimestamp returns the Timestamp field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetTimestamp() string {
+	if p == nil || p.Timestamp == nil {
+		return ""
+	}
+	return *p.Timestamp
+}
+
+// GetTimestampOk returns a tuple with the Timestamp field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetTimestampOk() (string, bool) {
+	if p == nil || p.Timestamp == nil {
+		return "", false
+	}
+	return *p.Timestamp, true
+}
+
+// HasTimestamp returns a boolean if a field has been set.
+func (p *ProcessSummary) HasTimestamp() bool {
+	if p != nil && p.Timestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTimestamp allocates a new p.Timestamp and returns the pointer to it.
+func (p *ProcessSummary) SetTimestamp(v string) {
+	p.Timestamp = &v
+}
+
+// GetUser returns the User field if non-nil, zero value otherwise.
+func (p *ProcessSummary) GetUser() string {
+	if p == nil || p.User == nil {
+		return ""
+	}
+	return *p.User
+}
+
+// GetUserOk returns a tuple with the User field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (p *ProcessSummary) GetUserOk() (string, bool) {
+	if p == nil || p.User == nil {
+		return "", false
+	}
+	return *p.User, true
+}
+
+// HasUser returns a boolean if a field has been set.
+func (p *ProcessSummary) HasUser() bool {
+	if p != nil && p.User != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUser allocates a new p.User and returns the pointer to it.
+func (p *ProcessSummary) SetUser(v string) {
+	p.User = &v
+}
+
+// GetPeriod returns the Period field if non-nil, zero value otherwise.
+func (r *Recurrence) GetPeriod() int {
+	if r == nil || r.Period == nil {
+		return 0
+	}
+	return *r.Period
+}
+
+// GetPeriodOk returns a tuple with the Period field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Recurrence) GetPeriodOk() (int, bool) {
+	if r == nil || r.Period == nil {
+		return 0, false
+	}
+	return *r.Period, true
+}
+
+// HasPeriod returns a boolean if a field has been set.
+func (r *Recurrence) HasPeriod() bool {
+	if r != nil && r.Period != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPeriod allocates a new r.Period and returns the pointer to it.
+func (r *Recurrence) SetPeriod(v int) {
+	r.Period = &v
+}
+
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (r *Recurrence) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Recurrence) GetTypeOk() (string, bool) {
+	if r == nil || r.Type == nil {
+		return "", false
+	}
+	return *r.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (r *Recurrence) HasType() bool {
+	if r != nil && r.Type != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetType allocates a new r.Type and returns the pointer to it.
+func (r *Recurrence) SetType(v string) {
+	r.Type = &v
+}
+
+// GetUntilDate returns the UntilDate field if non-nil, zero value otherwise.
+func (r *Recurrence) GetUntilDate() int {
+	if r == nil || r.UntilDate == nil {
+		return 0
+	}
+	return *r.UntilDate
+}
+
+// GetUntilDateOk returns a tuple with the UntilDate field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Recurrence) GetUntilDateOk() (int, bool) {
+	if r == nil || r.UntilDate == nil {
+		return 0, false
+	}
+	return *r.UntilDate, true
+}
+
+// HasUntilDate returns a boolean if a field has been set.
+func (r *Recurrence) HasUntilDate() bool {
+	if r != nil && r.UntilDate != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUntilDate allocates a new r.UntilDate and returns the pointer to it.
+func (r *Recurrence) SetUntilDate(v int) {
+	r.UntilDate = &v
+}
+
+// GetUntilOccurrences returns the UntilOccurrences field if non-nil, zero value otherwise.
+func (r *Recurrence) GetUntilOccurrences() int {
+	if r == nil || r.UntilOccurrences == nil {
+		return 0
+	}
+	return *r.UntilOccurrences
+}
+
+// GetUntilOccurrencesOk returns a tuple with the UntilOccurrences field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Recurrence) GetUntilOccurrencesOk() (int, bool) {
+	if r == nil || r.UntilOccurrences == nil {
+		return 0, false
+	}
+	return *r.UntilOccurrences, true
+}
+
+// HasUntilOccurrences returns a boolean if a field has been set.
+func (r *Recurrence) HasUntilOccurrences() bool {
+	if r != nil && r.UntilOccurrences != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUntilOccurrences allocates a new r.UntilOccurrences and returns the pointer to it.
+func (r *Recurrence) SetUntilOccurrences(v int) {
+	r.UntilOccurrences = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqAPIKey) GetData() apiKeyData {
+	if r == nil || r.Data == nil {
+		return apiKeyData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqAPIKey) GetDataOk() (apiKeyData, bool) {
+	if r == nil || r.Data == nil {
+		return apiKeyData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqAPIKey) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqAPIKey) SetData(v apiKeyData) {
+	r.Data = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqApplicationKey) GetData() applicationKeyData {
+	if r == nil || r.Data == nil {
+		return applicationKeyData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqApplicationKey) GetDataOk() (applicationKeyData, bool) {
+	if r == nil || r.Data == nil {
+		return applicationKeyData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqApplicationKey) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqApplicationKey) SetData(v applicationKeyData) {
+	r.Data = &v
+}
+
+// GetComment returns the Comment field if non-nil, zero value otherwise.
+func (r *reqComment) GetComment() Comment {
+	if r == nil || r.Comment == nil {
+		return Comment{}
+	}
+	return *r.Comment
+}
+
+// GetCommentOk returns a tuple with the Comment field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqComment) GetCommentOk() (Comment, bool) {
+	if r == nil || r.Comment == nil {
+		return Comment{}, false
+	}
+	return *r.Comment, true
+}
+
+// HasComment returns a boolean if a field has been set.
+func (r *reqComment) HasComment() bool {
+	if r != nil && r.Comment != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetComment allocates a new r.Comment and returns the pointer to it.
+func (r *reqComment) SetComment(v Comment) {
+	r.Comment = &v
+}
+
+// GetDashboard returns the Dashboard field if non-nil, zero value otherwise.
+func (r *reqGetDashboard) GetDashboard() Dashboard {
+	if r == nil || r.Dashboard == nil {
+		return Dashboard{}
+	}
+	return *r.Dashboard
+}
+
+// GetDashboardOk returns a tuple with the Dashboard field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetDashboard) GetDashboardOk() (Dashboard, bool) {
+	if r == nil || r.Dashboard == nil {
+		return Dashboard{}, false
+	}
+	return *r.Dashboard, true
+}
+
+// HasDashboard returns a boolean if a field has been set.
+func (r *reqGetDashboard) HasDashboard() bool {
+	if r != nil && r.Dashboard != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDashboard allocates a new r.Dashboard and returns the pointer to it.
+func (r *reqGetDashboard) SetDashboard(v Dashboard) {
+	r.Dashboard = &v
+}
+
+// GetResource returns the Resource field if non-nil, zero value otherwise.
+func (r *reqGetDashboard) GetResource() string {
+	if r == nil || r.Resource == nil {
+		return ""
+	}
+	return *r.Resource
+}
+
+// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetDashboard) GetResourceOk() (string, bool) {
+	if r == nil || r.Resource == nil {
+		return "", false
+	}
+	return *r.Resource, true
+}
+
+// HasResource returns a boolean if a field has been set.
+func (r *reqGetDashboard) HasResource() bool {
+	if r != nil && r.Resource != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResource allocates a new r.Resource and returns the pointer to it.
+func (r *reqGetDashboard) SetResource(v string) {
+	r.Resource = &v
+}
+
+// GetUrl returns the Url field if non-nil, zero value otherwise.
+func (r *reqGetDashboard) GetUrl() string {
+	if r == nil || r.Url == nil {
+		return ""
+	}
+	return *r.Url
+}
+
+// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetDashboard) GetUrlOk() (string, bool) {
+	if r == nil || r.Url == nil {
+		return "", false
+	}
+	return *r.Url, true
+}
+
+// HasUrl returns a boolean if a field has been set.
+func (r *reqGetDashboard) HasUrl() bool {
+	if r != nil && r.Url != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUrl allocates a new r.Url and returns the pointer to it.
+func (r *reqGetDashboard) SetUrl(v string) {
+	r.Url = &v
+}
+
+// GetTotal returns the Total field if non-nil, zero value otherwise.
+func (r *reqGetDashboards) GetTotal() int {
+	if r == nil || r.Total == nil {
+		return 0
+	}
+	return *r.Total
+}
+
+// GetTotalOk returns a tuple with the Total field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetDashboards) GetTotalOk() (int, bool) {
+	if r == nil || r.Total == nil {
+		return 0, false
+	}
+	return *r.Total, true
+}
+
+// HasTotal returns a boolean if a field has been set.
+func (r *reqGetDashboards) HasTotal() bool {
+	if r != nil && r.Total != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTotal allocates a new r.Total and returns the pointer to it.
+func (r *reqGetDashboards) SetTotal(v int) {
+	r.Total = &v
+}
+
+// GetEvent returns the Event field if non-nil, zero value otherwise.
+func (r *reqGetEvent) GetEvent() Event {
+	if r == nil || r.Event == nil {
+		return Event{}
+	}
+	return *r.Event
+}
+
+// GetEventOk returns a tuple with the Event field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetEvent) GetEventOk() (Event, bool) {
+	if r == nil || r.Event == nil {
+		return Event{}, false
+	}
+	return *r.Event, true
+}
+
+// HasEvent returns a boolean if a field has been set.
+func (r *reqGetEvent) HasEvent() bool {
+	if r != nil && r.Event != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEvent allocates a new r.Event and returns the pointer to it.
+func (r *reqGetEvent) SetEvent(v Event) {
+	r.Event = &v
+}
+
+// GetTags returns the Tags field if non-nil, zero value otherwise.
+func (r *reqGetTags) GetTags() TagMap {
+	if r == nil || r.Tags == nil {
+		return TagMap{}
+	}
+	return *r.Tags
+}
+
+// GetTagsOk returns a tuple with the Tags field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqGetTags) GetTagsOk() (TagMap, bool) {
+	if r == nil || r.Tags == nil {
+		return TagMap{}, false
+	}
+	return *r.Tags, true
+}
+
+// HasTags returns a boolean if a field has been set.
+func (r *reqGetTags) HasTags() bool {
+	if r != nil && r.Tags != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTags allocates a new r.Tags and returns the pointer to it.
+func (r *reqGetTags) SetTags(v TagMap) {
+	r.Tags = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqIncident) GetData() incidentData {
+	if r == nil || r.Data == nil {
+		return incidentData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqIncident) GetDataOk() (incidentData, bool) {
+	if r == nil || r.Data == nil {
+		return incidentData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqIncident) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqIncident) SetData(v incidentData) {
+	r.Data = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqLogsMetric) GetData() logsMetricData {
+	if r == nil || r.Data == nil {
+		return logsMetricData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqLogsMetric) GetDataOk() (logsMetricData, bool) {
+	if r == nil || r.Data == nil {
+		return logsMetricData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqLogsMetric) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqLogsMetric) SetData(v logsMetricData) {
+	r.Data = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqMetricTagConfiguration) GetData() metricTagConfigurationData {
+	if r == nil || r.Data == nil {
+		return metricTagConfigurationData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqMetricTagConfiguration) GetDataOk() (metricTagConfigurationData, bool) {
+	if r == nil || r.Data == nil {
+		return metricTagConfigurationData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqMetricTagConfiguration) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqMetricTagConfiguration) SetData(v metricTagConfigurationData) {
+	r.Data = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqNotebook) GetData() notebookData {
+	if r == nil || r.Data == nil {
+		return notebookData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqNotebook) GetDataOk() (notebookData, bool) {
+	if r == nil || r.Data == nil {
+		return notebookData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqNotebook) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqNotebook) SetData(v notebookData) {
+	r.Data = &v
+}
+
+// GetData returns the Data field if non-nil, zero value otherwise.
+func (r *reqRole) GetData() roleData {
+	if r == nil || r.Data == nil {
+		return roleData{}
+	}
+	return *r.Data
+}
+
+// GetDataOk returns a tuple with the Data field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *reqRole) GetDataOk() (roleData, bool) {
+	if r == nil || r.Data == nil {
+		return roleData{}, false
+	}
+	return *r.Data, true
+}
+
+// HasData returns a boolean if a field has been set.
+func (r *reqRole) HasData() bool {
+	if r != nil && r.Data != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetData allocates a new r.Data and returns the pointer to it.
+func (r *reqRole) SetData(v roleData) {
+	r.Data = &v
+}
+
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (r *Role) GetCreatedAt() string {
+	if r == nil || r.CreatedAt == nil {
+		return ""
+	}
+	return *r.CreatedAt
+}
+
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Role) GetCreatedAtOk() (string, bool) {
+	if r == nil || r.CreatedAt == nil {
+		return "", false
+	}
+	return *r.CreatedAt, true
+}
+
+// HasCreatedAt returns a boolean if a field has been set.
+func (r *Role) HasCreatedAt() bool {
+	if r != nil && r.CreatedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedAt allocates a new r.CreatedAt and returns the pointer to it.
+func (r *Role) SetCreatedAt(v string) {
+	r.CreatedAt = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (r *Role) GetId() string {
+	if r == nil || r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Role) GetIdOk() (string, bool) {
+	if r == nil || r.Id == nil {
+		return "", false
+	}
+	return *r.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (r *Role) HasId() bool {
+	if r != nil && r.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new r.Id and returns the pointer to it.
+func (r *Role) SetId(v string) {
+	r.Id = &v
+}
+
+// GetModifiedAt returns the ModifiedAt field if non-nil, zero value otherwise.
+func (r *Role) GetModifiedAt() string {
+	if r == nil || r.ModifiedAt == nil {
+		return ""
+	}
+	return *r.ModifiedAt
+}
+
+// GetModifiedAtOk returns a tuple with the ModifiedAt field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Role) GetModifiedAtOk() (string, bool) {
+	if r == nil || r.ModifiedAt == nil {
+		return "", false
+	}
+	return *r.ModifiedAt, true
+}
+
+// HasModifiedAt returns a boolean if a field has been set.
+func (r *Role) HasModifiedAt() bool {
+	if r != nil && r.ModifiedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetModifiedAt allocates a new r.ModifiedAt and returns the pointer to it.
+func (r *Role) SetModifiedAt(v string) {
+	r.ModifiedAt = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (r *Role) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Role) GetNameOk() (string, bool) {
+	if r == nil || r.Name == nil {
+		return "", false
+	}
+	return *r.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (r *Role) HasName() bool {
+	if r != nil && r.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new r.Name and returns the pointer to it.
+func (r *Role) SetName(v string) {
+	r.Name = &v
+}
+
+// GetUserCount returns the UserCount field if non-nil, zero value otherwise.
+func (r *Role) GetUserCount() int {
+	if r == nil || r.UserCount == nil {
+		return 0
+	}
+	return *r.UserCount
+}
+
+// GetUserCountOk returns a tuple with the UserCount field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Role) GetUserCountOk() (int, bool) {
+	if r == nil || r.UserCount == nil {
+		return 0, false
+	}
+	return *r.UserCount, true
+}
+
+// HasUserCount returns a boolean if a field has been set.
+func (r *Role) HasUserCount() bool {
+	if r != nil && r.UserCount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUserCount allocates a new r.UserCount and returns the pointer to it.
+func (r *Role) SetUserCount(v int) {
+	r.UserCount = &v
+}
+
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (r *roleData) GetAttributes() Role {
+	if r == nil || r.Attributes == nil {
+		return Role{}
+	}
+	return *r.Attributes
+}
+
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *roleData) GetAttributesOk() (Role, bool) {
+	if r == nil || r.Attributes == nil {
+		return Role{}, false
+	}
+	return *r.Attributes, true
+}
+
+// HasAttributes returns a boolean if a field has been set.
+func (r *roleData) HasAttributes() bool {
+	if r != nil && r.Attributes != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAttributes allocates a new r.Attributes and returns the pointer to it.
+func (r *roleData) SetAttributes(v Role) {
+	r.Attributes = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (r *roleData) GetId() string {
+	if r == nil || r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *roleData) GetIdOk() (string, bool) {
+	if r == nil || r.Id == nil {
+		return "", false
+	}
+	return *r.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (r *roleData) HasId() bool {
+	if r != nil && r.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new r.Id and returns the pointer to it.
+func (r *roleData) SetId(v string) {
+	r.Id = &v
+}
+
+// GetRelationships returns the Relationships field if non-nil, zero value otherwise.
+func (r *roleData) GetRelationships() roleRelationships {
+	if r == nil || r.Relationships == nil {
+		return roleRelationships{}
+	}
+	return *r.Relationships
+}
+
+// GetRelationshipsOk returns a tuple with the Relationships field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *roleData) GetRelationshipsOk() (roleRelationships, bool) {
+	if r == nil || r.Relationships == nil {
+		return roleRelationships{}, false
+	}
+	return *r.Relationships, true
+}
+
+// HasRelationships returns a boolean if a field has been set.
+func (r *roleData) HasRelationships() bool {
+	if r != nil && r.Relationships != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRelationships allocates a new r.Relationships and returns the pointer to it.
+func (r *roleData) SetRelationships(v roleRelationships) {
+	r.Relationships = &v
+}
+
+// GetColor returns the Color field if non-nil, zero value otherwise.
+func (r *Rule) GetColor() string {
+	if r == nil || r.Color == nil {
+		return ""
+	}
+	return *r.Color
+}
+
+// GetColorOk returns a tuple with the Color field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Rule) GetColorOk() (string, bool) {
+	if r == nil || r.Color == nil {
+		return "", false
+	}
+	return *r.Color, true
+}
+
+// HasColor returns a boolean if a field has been set.
+func (r *Rule) HasColor() bool {
+	if r != nil && r.Color != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetColor allocates a new r.Color and returns the pointer to it.
+func (r *Rule) SetColor(v string) {
+	r.Color = &v
+}
+
+// GetThreshold returns the Threshold field if non-nil, zero value otherwise.
+func (r *Rule) GetThreshold() json.Number {
+	if r == nil || r.Threshold == nil {
+		return ""
+	}
+	return *r.Threshold
+}
+
+// GetThresholdOk returns a tuple with the Threshold field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Rule) GetThresholdOk() (json.Number, bool) {
+	if r == nil || r.Threshold == nil {
+		return "", false
+	}
+	return *r.Threshold, true
+}
+
+// HasThreshold returns a boolean if a field has been set.
+func (r *Rule) HasThreshold() bool {
+	if r != nil && r.Threshold != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetThreshold allocates a new r.Threshold and returns the pointer to it.
+func (r *Rule) SetThreshold(v json.Number) {
+	r.Threshold = &v
+}
+
+// GetTimeframe returns the Timeframe field if non-nil, zero value otherwise.
+func (r *Rule) GetTimeframe() string {
+	if r == nil || r.Timeframe == nil {
+		return ""
+	}
+	return *r.Timeframe
+}
+
+// GetTimeframeOk returns a tuple with the Timeframe field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (r *Rule) GetTimeframeOk() (string, bool) {
+	if r == nil || r.Timeframe == nil {
+		return "", false
+	}
+	return *r.Timeframe, true
+}
+
+// HasTimeframe returns a boolean if a field has been set.
+func (r *Rule) HasTimeframe() bool {
+	if r != nil && r.Timeframe != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTimeframe allocates a new r.Timeframe and returns the pointer to it.
+func (r *Rule) SetTimeframe(v string) {
+	r.Timeframe = &v
+}
+
+// GetHeight returns the Height field if non-nil, zero value otherwise.
+func (s *Screenboard) GetHeight() int {
+	if s == nil || s.Height == nil {
+		return 0
+	}
+	return *s.Height
+}
+
+// GetHeightOk returns a tuple with the Height field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetHeightOk() (int, bool) {
+	if s == nil || s.Height == nil {
+		return 0, false
+	}
+	return *s.Height, true
+}
+
+// HasHeight returns a boolean if a field has been set.
+func (s *Screenboard) HasHeight() bool {
+	if s != nil && s.Height != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHeight allocates a new s.Height and returns the pointer to it.
+func (s *Screenboard) SetHeight(v int) {
+	s.Height = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (s *Screenboard) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetIdOk() (int, bool) {
+	if s == nil || s.Id == nil {
+		return 0, false
+	}
+	return *s.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (s *Screenboard) HasId() bool {
+	if s != nil && s.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new s.Id and returns the pointer to it.
+func (s *Screenboard) SetId(v int) {
+	s.Id = &v
+}
+
+// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
+func (s *Screenboard) GetReadOnly() bool {
+	if s == nil || s.ReadOnly == nil {
+		return false
+	}
+	return *s.ReadOnly
+}
+
+// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetReadOnlyOk() (bool, bool) {
+	if s == nil || s.ReadOnly == nil {
+		return false, false
+	}
+	return *s.ReadOnly, true
+}
+
+// HasReadOnly returns a boolean if a field has been set.
+func (s *Screenboard) HasReadOnly() bool {
+	if s != nil && s.ReadOnly != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReadOnly allocates a new s.ReadOnly and returns the pointer to it.
+func (s *Screenboard) SetReadOnly(v bool) {
+	s.ReadOnly = &v
+}
+
+// GetShared returns the Shared field if non-nil, zero value otherwise.
+func (s *Screenboard) GetShared() bool {
+	if s == nil || s.Shared == nil {
+		return false
+	}
+	return *s.Shared
+}
+
+// GetSharedOk returns a tuple with the Shared field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetSharedOk() (bool, bool) {
+	if s == nil || s.Shared == nil {
+		return false, false
+	}
+	return *s.Shared, true
+}
+
+// HasShared returns a boolean if a field has been set.
+func (s *Screenboard) HasShared() bool {
+	if s != nil && s.Shared != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetShared allocates a new s.Shared and returns the pointer to it.
+func (s *Screenboard) SetShared(v bool) {
+	s.Shared = &v
+}
+
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (s *Screenboard) GetTitle() string {
+	if s == nil || s.Title == nil {
+		return ""
+	}
+	return *s.Title
+}
+
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetTitleOk() (string, bool) {
+	if s == nil || s.Title == nil {
+		return "", false
+	}
+	return *s.Title, true
+}
+
+// HasTitle returns a boolean if a field has been set.
+func (s *Screenboard) HasTitle() bool {
+	if s != nil && s.Title != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTitle allocates a new s.Title and returns the pointer to it.
+func (s *Screenboard) SetTitle(v string) {
+	s.Title = &v
+}
+
+// GetWidth returns the Width field if non-nil, zero value otherwise.
+func (s *Screenboard) GetWidth() int {
+	if s == nil || s.Width == nil {
+		return 0
+	}
+	return *s.Width
+}
+
+// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Screenboard) GetWidthOk() (int, bool) {
+	if s == nil || s.Width == nil {
+		return 0, false
+	}
+	return *s.Width, true
+}
+
+// HasWidth returns a boolean if a field has been set.
+func (s *Screenboard) HasWidth() bool {
+	if s != nil && s.Width != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetWidth allocates a new s.Width and returns the pointer to it.
+func (s *Screenboard) SetWidth(v int) {
+	s.Width = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (s *ScreenboardLite) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ScreenboardLite) GetIdOk() (int, bool) {
+	if s == nil || s.Id == nil {
+		return 0, false
+	}
+	return *s.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (s *ScreenboardLite) HasId() bool {
+	if s != nil && s.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new s.Id and returns the pointer to it.
+func (s *ScreenboardLite) SetId(v int) {
+	s.Id = &v
+}
+
+// GetResource returns the Resource field if non-nil, zero value otherwise.
+func (s *ScreenboardLite) GetResource() string {
+	if s == nil || s.Resource == nil {
+		return ""
+	}
+	return *s.Resource
+}
+
+// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ScreenboardLite) GetResourceOk() (string, bool) {
+	if s == nil || s.Resource == nil {
+		return "", false
+	}
+	return *s.Resource, true
+}
+
+// HasResource returns a boolean if a field has been set.
+func (s *ScreenboardLite) HasResource() bool {
+	if s != nil && s.Resource != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResource allocates a new s.Resource and returns the pointer to it.
+func (s *ScreenboardLite) SetResource(v string) {
+	s.Resource = &v
+}
+
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (s *ScreenboardLite) GetTitle() string {
+	if s == nil || s.Title == nil {
+		return ""
+	}
+	return *s.Title
+}
+
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ScreenboardLite) GetTitleOk() (string, bool) {
+	if s == nil || s.Title == nil {
+		return "", false
+	}
+	return *s.Title, true
+}
+
+// HasTitle returns a boolean if a field has been set.
+func (s *ScreenboardLite) HasTitle() bool {
+	if s != nil && s.Title != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTitle allocates a new s.Title and returns the pointer to it.
+func (s *ScreenboardLite) SetTitle(v string) {
+	s.Title = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (s *ScreenboardMonitor) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ScreenboardMonitor) GetIdOk() (int, bool) {
+	if s == nil || s.Id == nil {
+		return 0, false
+	}
+	return *s.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (s *ScreenboardMonitor) HasId() bool {
+	if s != nil && s.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new s.Id and returns the pointer to it.
+func (s *ScreenboardMonitor) SetId(v int) {
+	s.Id = &v
+}
+
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetCreatedAt() int {
+	if s == nil || s.CreatedAt == nil {
+		return 0
+	}
+	return *s.CreatedAt
+}
+
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetCreatedAtOk() (int, bool) {
+	if s == nil || s.CreatedAt == nil {
+		return 0, false
+	}
+	return *s.CreatedAt, true
+}
+
+// HasCreatedAt returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasCreatedAt() bool {
+	if s != nil && s.CreatedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedAt allocates a new s.CreatedAt and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetCreatedAt(v int) {
+	s.CreatedAt = &v
+}
+
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetId() string {
+	if s == nil || s.Id == nil {
+		return ""
+	}
+	return *s.Id
+}
+
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetIdOk() (string, bool) {
+	if s == nil || s.Id == nil {
+		return "", false
+	}
+	return *s.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasId() bool {
+	if s != nil && s.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId allocates a new s.Id and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetId(v string) {
+	s.Id = &v
+}
+
+// GetIsDefault returns the IsDefault field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetIsDefault() bool {
+	if s == nil || s.IsDefault == nil {
+		return false
+	}
+	return *s.IsDefault
+}
+
+// GetIsDefaultOk returns a tuple with the IsDefault field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetIsDefaultOk() (bool, bool) {
+	if s == nil || s.IsDefault == nil {
+		return false, false
+	}
+	return *s.IsDefault, true
+}
+
+// HasIsDefault returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasIsDefault() bool {
+	if s != nil && s.IsDefault != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsDefault allocates a new s.IsDefault and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetIsDefault(v bool) {
+	s.IsDefault = &v
+}
+
+// GetIsEnabled returns the IsEnabled field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetIsEnabled() bool {
+	if s == nil || s.IsEnabled == nil {
+		return false
+	}
+	return *s.IsEnabled
+}
+
+// GetIsEnabledOk returns a tuple with the IsEnabled field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetIsEnabledOk() (bool, bool) {
+	if s == nil || s.IsEnabled == nil {
+		return false, false
+	}
+	return *s.IsEnabled, true
+}
+
+// HasIsEnabled returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasIsEnabled() bool {
+	if s != nil && s.IsEnabled != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIsEnabled allocates a new s.IsEnabled and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetIsEnabled(v bool) {
+	s.IsEnabled = &v
+}
+
+// GetMessage returns the Message field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetMessage() string {
+	if s == nil || s.Message == nil {
+		return ""
+	}
+	return *s.Message
+}
+
+// GetMessageOk returns a tuple with the Message field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetMessageOk() (string, bool) {
+	if s == nil || s.Message == nil {
+		return "", false
+	}
+	return *s.Message, true
+}
+
+// HasMessage returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasMessage() bool {
+	if s != nil && s.Message != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMessage allocates a new s.Message and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetMessage(v string) {
+	s.Message = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetNameOk() (string, bool) {
+	if s == nil || s.Name == nil {
+		return "", false
+	}
+	return *s.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasName() bool {
+	if s != nil && s.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new s.Name and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetName(v string) {
+	s.Name = &v
+}
+
+// GetOptions returns the Options field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRule) GetOptions() SecurityMonitoringRuleOptions {
+	if s == nil || s.Options == nil {
+		return SecurityMonitoringRuleOptions{}
+	}
+	return *s.Options
+}
+
+// GetOptionsOk returns a tuple with the Options field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRule) GetOptionsOk() (SecurityMonitoringRuleOptions, bool) {
+	if s == nil || s.Options == nil {
+		return SecurityMonitoringRuleOptions{}, false
+	}
+	return *s.Options, true
+}
+
+// HasOptions returns a boolean if a field has been set.
+func (s *SecurityMonitoringRule) HasOptions() bool {
+	if s != nil && s.Options != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOptions allocates a new s.Options and returns the pointer to it.
+func (s *SecurityMonitoringRule) SetOptions(v SecurityMonitoringRuleOptions) {
+	s.Options = &v
+}
+
+// GetCondition returns the Condition field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleCase) GetCondition() string {
+	if s == nil || s.Condition == nil {
+		return ""
+	}
+	return *s.Condition
+}
+
+// GetConditionOk returns a tuple with the Condition field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleCase) GetConditionOk() (string, bool) {
+	if s == nil || s.Condition == nil {
+		return "", false
+	}
+	return *s.Condition, true
+}
+
+// HasCondition returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleCase) HasCondition() bool {
+	if s != nil && s.Condition != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCondition allocates a new s.Condition and returns the pointer to it.
+func (s *SecurityMonitoringRuleCase) SetCondition(v string) {
+	s.Condition = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleCase) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleCase) GetNameOk() (string, bool) {
+	if s == nil || s.Name == nil {
+		return "", false
+	}
+	return *s.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleCase) HasName() bool {
+	if s != nil && s.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new s.Name and returns the pointer to it.
+func (s *SecurityMonitoringRuleCase) SetName(v string) {
+	s.Name = &v
+}
+
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleCase) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleCase) GetStatusOk() (string, bool) {
+	if s == nil || s.Status == nil {
+		return "", false
+	}
+	return *s.Status, true
+}
+
+// HasStatus returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleCase) HasStatus() bool {
+	if s != nil && s.Status != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStatus allocates a new s.Status and returns the pointer to it.
+func (s *SecurityMonitoringRuleCase) SetStatus(v string) {
+	s.Status = &v
+}
+
+// GetEvaluationWindow returns the EvaluationWindow field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleOptions) GetEvaluationWindow() int {
+	if s == nil || s.EvaluationWindow == nil {
+		return 0
+	}
+	return *s.EvaluationWindow
+}
+
+// GetEvaluationWindowOk returns a tuple with the EvaluationWindow field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleOptions) GetEvaluationWindowOk() (int, bool) {
+	if s == nil || s.EvaluationWindow == nil {
+		return 0, false
+	}
+	return *s.EvaluationWindow, true
+}
+
+// HasEvaluationWindow returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleOptions) HasEvaluationWindow() bool {
+	if s != nil && s.EvaluationWindow != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEvaluationWindow allocates a new s.EvaluationWindow and returns the pointer to it.
+func (s *SecurityMonitoringRuleOptions) SetEvaluationWindow(v int) {
+	s.EvaluationWindow = &v
+}
+
+// GetKeepAlive returns the KeepAlive field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleOptions) GetKeepAlive() int {
+	if s == nil || s.KeepAlive == nil {
+		return 0
+	}
+	return *s.KeepAlive
+}
+
+// GetKeepAliveOk returns a tuple with the KeepAlive field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleOptions) GetKeepAliveOk() (int, bool) {
+	if s == nil || s.KeepAlive == nil {
+		return 0, false
+	}
+	return *s.KeepAlive, true
+}
+
+// HasKeepAlive returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleOptions) HasKeepAlive() bool {
+	if s != nil && s.KeepAlive != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetKeepAlive allocates a new s.KeepAlive and returns the pointer to it.
+func (s *SecurityMonitoringRuleOptions) SetKeepAlive(v int) {
+	s.KeepAlive = &v
+}
+
+// GetMaxSignalDuration returns the MaxSignalDuration field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleOptions) GetMaxSignalDuration() int {
+	if s == nil || s.MaxSignalDuration == nil {
+		return 0
+	}
+	return *s.MaxSignalDuration
+}
+
+// GetMaxSignalDurationOk returns a tuple with the MaxSignalDuration field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleOptions) GetMaxSignalDurationOk() (int, bool) {
+	if s == nil || s.MaxSignalDuration == nil {
+		return 0, false
+	}
+	return *s.MaxSignalDuration, true
+}
+
+// HasMaxSignalDuration returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleOptions) HasMaxSignalDuration() bool {
+	if s != nil && s.MaxSignalDuration != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxSignalDuration allocates a new s.MaxSignalDuration and returns the pointer to it.
+func (s *SecurityMonitoringRuleOptions) SetMaxSignalDuration(v int) {
+	s.MaxSignalDuration = &v
+}
+
+// GetAggregation returns the Aggregation field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleQuery) GetAggregation() string {
+	if s == nil || s.Aggregation == nil {
+		return ""
+	}
+	return *s.Aggregation
+}
+
+// GetAggregationOk returns a tuple with the Aggregation field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleQuery) GetAggregationOk() (string, bool) {
+	if s == nil || s.Aggregation == nil {
+		return "", false
+	}
+	return *s.Aggregation, true
+}
+
+// HasAggregation returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleQuery) HasAggregation() bool {
+	if s != nil && s.Aggregation != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAggregation allocates a new s.Aggregation and returns the pointer to it.
+func (s *SecurityMonitoringRuleQuery) SetAggregation(v string) {
+	s.Aggregation = &v
+}
+
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleQuery) GetMetric() string {
+	if s == nil || s.Metric == nil {
+		return ""
+	}
+	return *s.Metric
+}
+
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleQuery) GetMetricOk() (string, bool) {
+	if s == nil || s.Metric == nil {
+		return "", false
+	}
+	return *s.Metric, true
+}
+
+// HasMetric returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleQuery) HasMetric() bool {
+	if s != nil && s.Metric != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetric allocates a new s.Metric and returns the pointer to it.
+func (s *SecurityMonitoringRuleQuery) SetMetric(v string) {
+	s.Metric = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleQuery) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleQuery) GetNameOk() (string, bool) {
+	if s == nil || s.Name == nil {
+		return "", false
+	}
+	return *s.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleQuery) HasName() bool {
+	if s != nil && s.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName allocates a new s.Name and returns the pointer to it.
+func (s *SecurityMonitoringRuleQuery) SetName(v string) {
+	s.Name = &v
+}
+
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (s *SecurityMonitoringRuleQuery) GetQuery() string {
+	if s == nil || s.Query == nil {
+		return ""
+	}
+	return *s.Query
+}
+
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *SecurityMonitoringRuleQuery) GetQueryOk() (string, bool) {
+	if s == nil || s.Query == nil {
+		return "", false
+	}
+	return *s.Query, true
+}
+
+// HasQuery returns a boolean if a field has been set.
+func (s *SecurityMonitoringRuleQuery) HasQuery() bool {
+	if s != nil && s.Query != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQuery allocates a new s.Query and returns the pointer to it.
+func (s *SecurityMonitoringRuleQuery) SetQuery(v string) {
+	s.Query = &v
+}
+
+// GetAggr returns the Aggr field if non-nil, zero value otherwise.
+func (s *Series) GetAggr() string {
+	if s == nil || s.Aggr == nil {
+		return ""
+	}
+	return *s.Aggr
+}
+
+// GetAggrOk returns a tuple with the Aggr field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetAggrOk() (string, bool) {
+	if s == nil || s.Aggr == nil {
+		return "", false
+	}
+	return *s.Aggr, true
+}
+
+// HasAggr returns a boolean if a field has been set.
+func (s *Series) HasAggr() bool {
+	if s != nil && s.Aggr != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAggr allocates a new s.Aggr and returns the pointer to it.
+func (s *Series) SetAggr(v string) {
+	s.Aggr = &v
+}
+
+// GetDisplayName returns the DisplayName field if non-nil, zero value otherwise.
+func (s *Series) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetDisplayNameOk returns a tuple with the DisplayName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetDisplayNameOk() (string, bool) {
+	if s == nil || s.DisplayName == nil {
+		return "", false
+	}
+	return *s.DisplayName, true
+}
+
+// HasDisplayName returns a boolean if a field has been set.
+func (s *Series) HasDisplayName() bool {
+	if s != nil && s.DisplayName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDisplayName allocates a new s.DisplayName and returns the pointer to it.
+func (s *Series) SetDisplayName(v string) {
+	s.DisplayName = &v
+}
+
+// GetEnd returns the End field if non-nil, zero value otherwise.
+func (s *Series) GetEnd() float64 {
+	if s == nil || s.End == nil {
+		return 0
+	}
+	return *s.End
+}
+
+// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetEndOk() (float64, bool) {
+	if s == nil || s.End == nil {
+		return 0, false
+	}
+	return *s.End, true
+}
+
+// HasEnd returns a boolean if a field has been set.
+func (s *Series) HasEnd() bool {
+	if s != nil && s.End != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnd allocates a new s.End and returns the pointer to it.
+func (s *Series) SetEnd(v float64) {
+	s.End = &v
+}
+
+// GetExpression returns the Expression field if non-nil, zero value otherwise.
+func (s *Series) GetExpression() string {
+	if s == nil || s.Expression == nil {
+		return ""
+	}
+	return *s.Expression
+}
+
+// GetExpressionOk returns a tuple with the Expression field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetExpressionOk() (string, bool) {
+	if s == nil || s.Expression == nil {
+		return "", false
+	}
+	return *s.Expression, true
+}
+
+// HasExpression returns a boolean if a field has been set.
+func (s *Series) HasExpression() bool {
+	if s != nil && s.Expression != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExpression allocates a new s.Expression and returns the pointer to it.
+func (s *Series) SetExpression(v string) {
+	s.Expression = &v
+}
+
+// GetInterval returns the Interval field if non-nil, zero value otherwise.
+func (s *Series) GetInterval() int {
+	if s == nil || s.Interval == nil {
+		return 0
+	}
+	return *s.Interval
+}
+
+// GetIntervalOk returns a tuple with the Interval field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetIntervalOk() (int, bool) {
+	if s == nil || s.Interval == nil {
+		return 0, false
+	}
+	return *s.Interval, true
+}
+
+// HasInterval returns a boolean if a field has been set.
+func (s *Series) HasInterval() bool {
+	if s != nil && s.Interval != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetInterval allocates a new s.Interval and returns the pointer to it.
+func (s *Series) SetInterval(v int) {
+	s.Interval = &v
+}
+
+// GetLength returns the Length field if non-nil, zero value otherwise.
+func (s *Series) GetLength() int {
+	if s == nil || s.Length == nil {
+		return 0
+	}
+	return *s.Length
+}
+
+// GetLengthOk returns a tuple with the Length field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetLengthOk() (int, bool) {
+	if s == nil || s.Length == nil {
+		return 0, false
+	}
+	return *s.Length, true
+}
+
+// HasLength returns a boolean if a field has been set.
+func (s *Series) HasLength() bool {
+	if s != nil && s.Length != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLength allocates a new s.Length and returns the pointer to it.
+func (s *Series) SetLength(v int) {
+	s.Length = &v
+}
+
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (s *Series) GetMetric() string {
+	if s == nil || s.Metric == nil {
+		return ""
+	}
+	return *s.Metric
+}
+
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetMetricOk() (string, bool) {
+	if s == nil || s.Metric == nil {
+		return "", false
+	}
+	return *s.Metric, true
+}
+
+// HasMetric returns a boolean if a field has been set.
+func (s *Series) HasMetric() bool {
+	if s != nil && s.Metric != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetric allocates a new s.Metric and returns the pointer to it.
+func (s *Series) SetMetric(v string) {
+	s.Metric = &v
+}
+
+// GetScope returns the Scope field if non-nil, zero value otherwise.
+func (s *Series) GetScope() string {
+	if s == nil || s.Scope == nil {
+		return ""
+	}
+	return *s.Scope
+}
+
+// GetScopeOk returns a tuple with the Scope field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetScopeOk() (string, bool) {
+	if s == nil || s.Scope == nil {
+		return "", false
+	}
+	return *s.Scope, true
+}
+
+// HasScope returns a boolean if a field has been set.
+func (s *Series) HasScope() bool {
+	if s != nil && s.Scope != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetScope allocates a new s.Scope and returns the pointer to it.
+func (s *Series) SetScope(v string) {
+	s.Scope = &v
+}
+
+// GetStart returns the Start field if non-nil, zero value otherwise.
+func (s *Series) GetStart() float64 {
+	if s == nil || s.Start == nil {
+		return 0
+	}
+	return *s.Start
+}
+
+// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetStartOk() (float64, bool) {
+	if s == nil || s.Start == nil {
+		return 0, false
+	}
+	return *s.Start, true
+}
+
+// HasStart returns a boolean if a field has been set.
+func (s *Series) HasStart() bool {
+	if s != nil && s.Start != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStart allocates a new s.Start and returns the pointer to it.
+func (s *Series) SetStart(v float64) {
+	s.Start = &v
+}
+
+// GetUnits returns the Units field if non-nil, zero value otherwise.
+func (s *Series) GetUnits() UnitPair {
+	if s == nil || s.Units == nil {
+		return UnitPair{}
+	}
+	return *s.Units
+}
+
+// GetUnitsOk returns a tuple with the Units field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Series) GetUnitsOk() (UnitPair, bool) {
+	if s == nil || s.Units == nil {
+		return UnitPair{}, false
+	}
+	return *s.Units, true
+}
+
+// HasUnits returns a boolean if a field has been set.
+func (s *Series) HasUnits() bool {
+	if s != nil && s.Units != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUnits allocates a new s.Units and returns the pointer to it.
+func (s *Series) SetUnits(v UnitPair) {
+	s.Units = &v
+}
+
+// GetAccount returns the Account field if non-nil, zero value otherwise.
+func (s *ServiceHookSlackRequest) GetAccount() string {
+	if s == nil || s.Account == nil {
+		return ""
+	}
+	return *s.Account
+}
+
+// GetAccountOk returns a tuple with the Account field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ServiceHookSlackRequest) GetAccountOk() (string, bool) {
+	if s == nil || s.Account == nil {
+		return "", false
+	}
+	return *s.Account, true
+}
+
+// HasAccount returns a boolean if a field has been set.
+func (s *ServiceHookSlackRequest) HasAccount() bool {
+	if s != nil && s.Account != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAccount allocates a new s.Account and returns the pointer to it.
+func (s *ServiceHookSlackRequest) SetAccount(v string) {
+	s.Account = &v
+}
+
+// GetUrl returns the Url field if non-nil, zero value otherwise.
+func (s *ServiceHookSlackRequest) GetUrl() string {
+	if s == nil || s.Url == nil {
+		return ""
+	}
+	return *s.Url
+}
+
+// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ServiceHookSlackRequest) GetUrlOk() (string, bool) {
+	if s == nil || s.Url == nil {
+		return "", false
+	}
+	return *s.Url, true
+}
+
+// HasUrl returns a boolean if a field has been set.
+func (s *ServiceHookSlackRequest) HasUrl() bool {
+	if s != nil && s.Url != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUrl allocates a new s.Url and returns the pointer to it.
+func (s *ServiceHookSlackRequest) SetUrl(v string) {
+	s.Url = &v
+}
+
+// GetServiceKey returns the ServiceKey field if non-nil, zero value otherwise.
+func (s *servicePD) GetServiceKey() string {
+	if s == nil || s.ServiceKey == nil {
+		return ""
+	}
+	return *s.ServiceKey
+}
+
+// GetServiceKeyOk returns a tuple with the ServiceKey field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *servicePD) GetServiceKeyOk() (string, bool) {
+	if s == nil || s.ServiceKey == nil {
+		return "", false
+	}
+	return *s.ServiceKey, true
+}
+
+// HasServiceKey returns a boolean if a field has been set.
+func (s *servicePD) HasServiceKey() bool {
+	if s != nil && s.ServiceKey != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRequireFullWindow allocates a new o.RequireFullWindow and returns the pointer to it.
-func (o *Options) SetRequireFullWindow(v bool) {
-	o.RequireFullWindow = &v
+// SetServiceKey allocates a new s.ServiceKey and returns the pointer to it.
+func (s *servicePD) SetServiceKey(v string) {
+	s.ServiceKey = &v
 }
 
-// GetThresholds returns the Thresholds field if non-nil, zero value otherwise.
-func (o *Options) GetThresholds() ThresholdCount {
-	if o == nil || o.Thresholds == nil {
-		return ThresholdCount{}
+// GetServiceName returns the ServiceName field if non-nil, zero value otherwise.
+func (s *servicePD) GetServiceName() string {
+	if s == nil || s.ServiceName == nil {
+		return ""
 	}
-	return *o.Thresholds
+	return *s.ServiceName
 }
 
-// GetThresholdsOk returns a tuple with the Thresholds field if it's non-nil, zero value otherwise
+// GetServiceNameOk returns a tuple with the ServiceName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetThresholdsOk() (ThresholdCount, bool) {
-	if o == nil || o.Thresholds == nil {
-		return ThresholdCount{}, false
+func (s *servicePD) GetServiceNameOk() (string, bool) {
+	if s == nil || s.ServiceName == nil {
+		return "", false
 	}
-	return *o.Thresholds, true
+	return *s.ServiceName, true
 }
 
-// HasThresholds returns a boolean if a field has been set.
-func (o *Options) HasThresholds() bool {
-	if o != nil && o.Thresholds != nil {
+// HasServiceName returns a boolean if a field has been set.
+func (s *servicePD) HasServiceName() bool {
+	if s != nil && s.ServiceName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetThresholds allocates a new o.Thresholds and returns the pointer to it.
-func (o *Options) SetThresholds(v ThresholdCount) {
-	o.Thresholds = &v
+// SetServiceName allocates a new s.ServiceName and returns the pointer to it.
+func (s *servicePD) SetServiceName(v string) {
+	s.ServiceName = &v
 }
 
-// GetThresholdWindows returns the ThresholdWindows field if non-nil, zero value otherwise.
-func (o *Options) GetThresholdWindows() ThresholdWindows {
-	if o == nil || o.ThresholdWindows == nil {
-		return ThresholdWindows{}
+// GetServiceKey returns the ServiceKey field if non-nil, zero value otherwise.
+func (s *ServicePDRequest) GetServiceKey() string {
+	if s == nil || s.ServiceKey == nil {
+		return ""
 	}
-	return *o.ThresholdWindows
+	return *s.ServiceKey
 }
 
-// GetThresholdWindowsOk returns a tuple with the ThresholdWindows field if it's non-nil, zero value otherwise
+// GetServiceKeyOk returns a tuple with the ServiceKey field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetThresholdWindowsOk() (ThresholdWindows, bool) {
-	if o == nil || o.ThresholdWindows == nil {
-		return ThresholdWindows{}, false
+func (s *ServicePDRequest) GetServiceKeyOk() (string, bool) {
+	if s == nil || s.ServiceKey == nil {
+		return "", false
 	}
-	return *o.ThresholdWindows, true
+	return *s.ServiceKey, true
 }
 
-// HasThresholdWindows returns a boolean if a field has been set.
-func (o *Options) HasThresholdWindows() bool {
-	if o != nil && o.ThresholdWindows != nil {
+// HasServiceKey returns a boolean if a field has been set.
+func (s *ServicePDRequest) HasServiceKey() bool {
+	if s != nil && s.ServiceKey != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetThresholdWindows allocates a new o.ThresholdWindows and returns the pointer to it.
-func (o *Options) SetThresholdWindows(v ThresholdWindows) {
-	o.ThresholdWindows = &v
+// SetServiceKey allocates a new s.ServiceKey and returns the pointer to it.
+func (s *ServicePDRequest) SetServiceKey(v string) {
+	s.ServiceKey = &v
+}
+
+// GetServiceName returns the ServiceName field if non-nil, zero value otherwise.
+func (s *ServicePDRequest) GetServiceName() string {
+	if s == nil || s.ServiceName == nil {
+		return ""
+	}
+	return *s.ServiceName
+}
+
+// GetServiceNameOk returns a tuple with the ServiceName field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *ServicePDRequest) GetServiceNameOk() (string, bool) {
+	if s == nil || s.ServiceName == nil {
+		return "", false
+	}
+	return *s.ServiceName, true
+}
+
+// HasServiceName returns a boolean if a field has been set.
+func (s *ServicePDRequest) HasServiceName() bool {
+	if s != nil && s.ServiceName != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetServiceName allocates a new s.ServiceName and returns the pointer to it.
+func (s *ServicePDRequest) SetServiceName(v string) {
+	s.ServiceName = &v
+}
+
+// GetFillMax returns the FillMax field if non-nil, zero value otherwise.
+func (s *Style) GetFillMax() json.Number {
+	if s == nil || s.FillMax == nil {
+		return ""
+	}
+	return *s.FillMax
+}
+
+// GetFillMaxOk returns a tuple with the FillMax field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Style) GetFillMaxOk() (json.Number, bool) {
+	if s == nil || s.FillMax == nil {
+		return "", false
+	}
+	return *s.FillMax, true
+}
+
+// HasFillMax returns a boolean if a field has been set.
+func (s *Style) HasFillMax() bool {
+	if s != nil && s.FillMax != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFillMax allocates a new s.FillMax and returns the pointer to it.
+func (s *Style) SetFillMax(v json.Number) {
+	s.FillMax = &v
+}
+
+// GetFillMin returns the FillMin field if non-nil, zero value otherwise.
+func (s *Style) GetFillMin() json.Number {
+	if s == nil || s.FillMin == nil {
+		return ""
+	}
+	return *s.FillMin
+}
+
+// GetFillMinOk returns a tuple with the FillMin field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Style) GetFillMinOk() (json.Number, bool) {
+	if s == nil || s.FillMin == nil {
+		return "", false
+	}
+	return *s.FillMin, true
+}
+
+// HasFillMin returns a boolean if a field has been set.
+func (s *Style) HasFillMin() bool {
+	if s != nil && s.FillMin != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetFillMin allocates a new s.FillMin and returns the pointer to it.
+func (s *Style) SetFillMin(v json.Number) {
+	s.FillMin = &v
+}
+
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (s *Style) GetPalette() string {
+	if s == nil || s.Palette == nil {
+		return ""
+	}
+	return *s.Palette
+}
+
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Style) GetPaletteOk() (string, bool) {
+	if s == nil || s.Palette == nil {
+		return "", false
+	}
+	return *s.Palette, true
+}
+
+// HasPalette returns a boolean if a field has been set.
+func (s *Style) HasPalette() bool {
+	if s != nil && s.Palette != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPalette allocates a new s.Palette and returns the pointer to it.
+func (s *Style) SetPalette(v string) {
+	s.Palette = &v
+}
+
+// GetPaletteFlip returns the PaletteFlip field if non-nil, zero value otherwise.
+func (s *Style) GetPaletteFlip() bool {
+	if s == nil || s.PaletteFlip == nil {
+		return false
+	}
+	return *s.PaletteFlip
+}
+
+// GetPaletteFlipOk returns a tuple with the PaletteFlip field if it's non-nil, zero value otherwise
+// and a boolean to check if the value has been set.
+func (s *Style) GetPaletteFlipOk() (bool, bool) {
+	if s == nil || s.PaletteFlip == nil {
+		return false, false
+	}
+	return *s.PaletteFlip, true
+}
+
+// HasPaletteFlip returns a boolean if a field has been set.
+func (s *Style) HasPaletteFlip() bool {
+	if s != nil && s.PaletteFlip != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPaletteFlip allocates a new s.PaletteFlip and returns the pointer to it.
+func (s *Style) SetPaletteFlip(v bool) {
+	s.PaletteFlip = &v
 }
 
-// GetTimeoutH returns the TimeoutH field if non-nil, zero value otherwise.
-func (o *Options) GetTimeoutH() int {
-	if o == nil || o.TimeoutH == nil {
+// GetCheckTime returns the CheckTime field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResult) GetCheckTime() float64 {
+	if s == nil || s.CheckTime == nil {
 		return 0
 	}
-	return *o.TimeoutH
+	return *s.CheckTime
 }
 
-// GetTimeoutHOk returns a tuple with the TimeoutH field if it's non-nil, zero value otherwise
+// GetCheckTimeOk returns a tuple with the CheckTime field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (o *Options) GetTimeoutHOk() (int, bool) {
-	if o == nil || o.TimeoutH == nil {
+func (s *SyntheticsTestResult) GetCheckTimeOk() (float64, bool) {
+	if s == nil || s.CheckTime == nil {
 		return 0, false
 	}
-	return *o.TimeoutH, true
+	return *s.CheckTime, true
 }
 
-// HasTimeoutH returns a boolean if a field has been set.
-func (o *Options) HasTimeoutH() bool {
-	if o != nil && o.TimeoutH != nil {
+// HasCheckTime returns a boolean if a field has been set.
+func (s *SyntheticsTestResult) HasCheckTime() bool {
+	if s != nil && s.CheckTime != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTimeoutH allocates a new o.TimeoutH and returns the pointer to it.
-func (o *Options) SetTimeoutH(v int) {
-	o.TimeoutH = &v
+// SetCheckTime allocates a new s.CheckTime and returns the pointer to it.
+func (s *SyntheticsTestResult) SetCheckTime(v float64) {
+	s.CheckTime = &v
 }
 
-// GetCount returns the Count field if non-nil, zero value otherwise.
-func (p *Params) GetCount() string {
-	if p == nil || p.Count == nil {
+// GetProbeDC returns the ProbeDC field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResult) GetProbeDC() string {
+	if s == nil || s.ProbeDC == nil {
 		return ""
 	}
-	return *p.Count
+	return *s.ProbeDC
 }
 
-// GetCountOk returns a tuple with the Count field if it's non-nil, zero value otherwise
+// GetProbeDCOk returns a tuple with the ProbeDC field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (p *Params) GetCountOk() (string, bool) {
-	if p == nil || p.Count == nil {
+func (s *SyntheticsTestResult) GetProbeDCOk() (string, bool) {
+	if s == nil || s.ProbeDC == nil {
 		return "", false
 	}
-	return *p.Count, true
+	return *s.ProbeDC, true
 }
 
-// HasCount returns a boolean if a field has been set.
-func (p *Params) HasCount() bool {
-	if p != nil && p.Count != nil {
+// HasProbeDC returns a boolean if a field has been set.
+func (s *SyntheticsTestResult) HasProbeDC() bool {
+	if s != nil && s.ProbeDC != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCount allocates a new p.Count and returns the pointer to it.
-func (p *Params) SetCount(v string) {
-	p.Count = &v
+// SetProbeDC allocates a new s.ProbeDC and returns the pointer to it.
+func (s *SyntheticsTestResult) SetProbeDC(v string) {
+	s.ProbeDC = &v
 }
 
-// GetSort returns the Sort field if non-nil, zero value otherwise.
-func (p *Params) GetSort() string {
-	if p == nil || p.Sort == nil {
-		return ""
+// GetResult returns the Result field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResult) GetResult() SyntheticsTestResultDetails {
+	if s == nil || s.Result == nil {
+		return SyntheticsTestResultDetails{}
 	}
-	return *p.Sort
+	return *s.Result
 }
 
-// GetSortOk returns a tuple with the Sort field if it's non-nil, zero value otherwise
+// GetResultOk returns a tuple with the Result field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (p *Params) GetSortOk() (string, bool) {
-	if p == nil || p.Sort == nil {
-		return "", false
+func (s *SyntheticsTestResult) GetResultOk() (SyntheticsTestResultDetails, bool) {
+	if s == nil || s.Result == nil {
+		return SyntheticsTestResultDetails{}, false
 	}
-	return *p.Sort, true
+	return *s.Result, true
 }
 
-// HasSort returns a boolean if a field has been set.
-func (p *Params) HasSort() bool {
-	if p != nil && p.Sort != nil {
+// HasResult returns a boolean if a field has been set.
+func (s *SyntheticsTestResult) HasResult() bool {
+	if s != nil && s.Result != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetSort allocates a new p.Sort and returns the pointer to it.
-func (p *Params) SetSort(v string) {
-	p.Sort = &v
+// SetResult allocates a new s.Result and returns the pointer to it.
+func (s *SyntheticsTestResult) SetResult(v SyntheticsTestResultDetails) {
+	s.Result = &v
 }
 
-// GetStart returns the Start field if non-nil, zero value otherwise.
-func (p *Params) GetStart() string {
-	if p == nil || p.Start == nil {
+// GetResultId returns the ResultId field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResult) GetResultId() string {
+	if s == nil || s.ResultId == nil {
 		return ""
 	}
-	return *p.Start
+	return *s.ResultId
 }
 
-// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// GetResultIdOk returns a tuple with the ResultId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (p *Params) GetStartOk() (string, bool) {
-	if p == nil || p.Start == nil {
+func (s *SyntheticsTestResult) GetResultIdOk() (string, bool) {
+	if s == nil || s.ResultId == nil {
 		return "", false
 	}
-	return *p.Start, true
+	return *s.ResultId, true
 }
 
-// HasStart returns a boolean if a field has been set.
-func (p *Params) HasStart() bool {
-	if p != nil && p.Start != nil {
+// HasResultId returns a boolean if a field has been set.
+func (s *SyntheticsTestResult) HasResultId() bool {
+	if s != nil && s.ResultId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStart allocates a new p.Start and returns the pointer to it.
-func (p *Params) SetStart(v string) {
-	p.Start = &v
+// SetResultId allocates a new s.ResultId and returns the pointer to it.
+func (s *SyntheticsTestResult) SetResultId(v string) {
+	s.ResultId = &v
 }
 
-// GetText returns the Text field if non-nil, zero value otherwise.
-func (p *Params) GetText() string {
-	if p == nil || p.Text == nil {
-		return ""
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResult) GetStatus() int {
+	if s == nil || s.Status == nil {
+		return 0
 	}
-	return *p.Text
+	return *s.Status
 }
 
-// GetTextOk returns a tuple with the Text field if it's non-nil, zero value otherwise
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (p *Params) GetTextOk() (string, bool) {
-	if p == nil || p.Text == nil {
-		return "", false
+func (s *SyntheticsTestResult) GetStatusOk() (int, bool) {
+	if s == nil || s.Status == nil {
+		return 0, false
 	}
-	return *p.Text, true
+	return *s.Status, true
 }
 
-// HasText returns a boolean if a field has been set.
-func (p *Params) HasText() bool {
-	if p != nil && p.Text != nil {
+// HasStatus returns a boolean if a field has been set.
+func (s *SyntheticsTestResult) HasStatus() bool {
+	if s != nil && s.Status != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetText allocates a new p.Text and returns the pointer to it.
-func (p *Params) SetText(v string) {
-	p.Text = &v
+// SetStatus allocates a new s.Status and returns the pointer to it.
+func (s *SyntheticsTestResult) SetStatus(v int) {
+	s.Status = &v
 }
 
-// GetPeriod returns the Period field if non-nil, zero value otherwise.
-func (r *Recurrence) GetPeriod() int {
-	if r == nil || r.Period == nil {
-		return 0
+// GetErrorCode returns the ErrorCode field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResultDetails) GetErrorCode() string {
+	if s == nil || s.ErrorCode == nil {
+		return ""
 	}
-	return *r.Period
+	return *s.ErrorCode
 }
 
-// GetPeriodOk returns a tuple with the Period field if it's non-nil, zero value otherwise
+// GetErrorCodeOk returns a tuple with the ErrorCode field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Recurrence) GetPeriodOk() (int, bool) {
-	if r == nil || r.Period == nil {
-		return 0, false
+func (s *SyntheticsTestResultDetails) GetErrorCodeOk() (string, bool) {
+	if s == nil || s.ErrorCode == nil {
+		return "", false
 	}
-	return *r.Period, true
+	return *s.ErrorCode, true
 }
 
-// HasPeriod returns a boolean if a field has been set.
-func (r *Recurrence) HasPeriod() bool {
-	if r != nil && r.Period != nil {
+// HasErrorCode returns a boolean if a field has been set.
+func (s *SyntheticsTestResultDetails) HasErrorCode() bool {
+	if s != nil && s.ErrorCode != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPeriod allocates a new r.Period and returns the pointer to it.
-func (r *Recurrence) SetPeriod(v int) {
-	r.Period = &v
+// SetErrorCode allocates a new s.ErrorCode and returns the pointer to it.
+func (s *SyntheticsTestResultDetails) SetErrorCode(v string) {
+	s.ErrorCode = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (r *Recurrence) GetType() string {
-	if r == nil || r.Type == nil {
+// GetErrorMessage returns the ErrorMessage field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResultDetails) GetErrorMessage() string {
+	if s == nil || s.ErrorMessage == nil {
 		return ""
 	}
-	return *r.Type
+	return *s.ErrorMessage
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetErrorMessageOk returns a tuple with the ErrorMessage field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Recurrence) GetTypeOk() (string, bool) {
-	if r == nil || r.Type == nil {
+func (s *SyntheticsTestResultDetails) GetErrorMessageOk() (string, bool) {
+	if s == nil || s.ErrorMessage == nil {
 		return "", false
 	}
-	return *r.Type, true
+	return *s.ErrorMessage, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (r *Recurrence) HasType() bool {
-	if r != nil && r.Type != nil {
+// HasErrorMessage returns a boolean if a field has been set.
+func (s *SyntheticsTestResultDetails) HasErrorMessage() bool {
+	if s != nil && s.ErrorMessage != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new r.Type and returns the pointer to it.
-func (r *Recurrence) SetType(v string) {
-	r.Type = &v
+// SetErrorMessage allocates a new s.ErrorMessage and returns the pointer to it.
+func (s *SyntheticsTestResultDetails) SetErrorMessage(v string) {
+	s.ErrorMessage = &v
 }
 
-// GetUntilDate returns the UntilDate field if non-nil, zero value otherwise.
-func (r *Recurrence) GetUntilDate() int {
-	if r == nil || r.UntilDate == nil {
-		return 0
+// GetPassed returns the Passed field if non-nil, zero value otherwise.
+func (s *SyntheticsTestResultDetails) GetPassed() bool {
+	if s == nil || s.Passed == nil {
+		return false
 	}
-	return *r.UntilDate
+	return *s.Passed
 }
 
-// GetUntilDateOk returns a tuple with the UntilDate field if it's non-nil, zero value otherwise
+// GetPassedOk returns a tuple with the Passed field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Recurrence) GetUntilDateOk() (int, bool) {
-	if r == nil || r.UntilDate == nil {
-		return 0, false
+func (s *SyntheticsTestResultDetails) GetPassedOk() (bool, bool) {
+	if s == nil || s.Passed == nil {
+		return false, false
 	}
-	return *r.UntilDate, true
+	return *s.Passed, true
 }
 
-// HasUntilDate returns a boolean if a field has been set.
-func (r *Recurrence) HasUntilDate() bool {
-	if r != nil && r.UntilDate != nil {
+// HasPassed returns a boolean if a field has been set.
+func (s *SyntheticsTestResultDetails) HasPassed() bool {
+	if s != nil && s.Passed != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUntilDate allocates a new r.UntilDate and returns the pointer to it.
-func (r *Recurrence) SetUntilDate(v int) {
-	r.UntilDate = &v
+// SetPassed allocates a new s.Passed and returns the pointer to it.
+func (s *SyntheticsTestResultDetails) SetPassed(v bool) {
+	s.Passed = &v
 }
 
-// GetUntilOccurrences returns the UntilOccurrences field if non-nil, zero value otherwise.
-func (r *Recurrence) GetUntilOccurrences() int {
-	if r == nil || r.UntilOccurrences == nil {
-		return 0
+// GetDevice returns the Device field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggeredTest) GetDevice() string {
+	if s == nil || s.Device == nil {
+		return ""
 	}
-	return *r.UntilOccurrences
+	return *s.Device
 }
 
-// GetUntilOccurrencesOk returns a tuple with the UntilOccurrences field if it's non-nil, zero value otherwise
+// GetDeviceOk returns a tuple with the Device field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Recurrence) GetUntilOccurrencesOk() (int, bool) {
-	if r == nil || r.UntilOccurrences == nil {
-		return 0, false
+func (s *SyntheticsTriggeredTest) GetDeviceOk() (string, bool) {
+	if s == nil || s.Device == nil {
+		return "", false
 	}
-	return *r.UntilOccurrences, true
+	return *s.Device, true
 }
 
-// HasUntilOccurrences returns a boolean if a field has been set.
-func (r *Recurrence) HasUntilOccurrences() bool {
-	if r != nil && r.UntilOccurrences != nil {
+// HasDevice returns a boolean if a field has been set.
+func (s *SyntheticsTriggeredTest) HasDevice() bool {
+	if s != nil && s.Device != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUntilOccurrences allocates a new r.UntilOccurrences and returns the pointer to it.
-func (r *Recurrence) SetUntilOccurrences(v int) {
-	r.UntilOccurrences = &v
+// SetDevice allocates a new s.Device and returns the pointer to it.
+func (s *SyntheticsTriggeredTest) SetDevice(v string) {
+	s.Device = &v
 }
 
-// GetComment returns the Comment field if non-nil, zero value otherwise.
-func (r *reqComment) GetComment() Comment {
-	if r == nil || r.Comment == nil {
-		return Comment{}
+// GetLocation returns the Location field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggeredTest) GetLocation() int {
+	if s == nil || s.Location == nil {
+		return 0
 	}
-	return *r.Comment
+	return *s.Location
 }
 
-// GetCommentOk returns a tuple with the Comment field if it's non-nil, zero value otherwise
+// GetLocationOk returns a tuple with the Location field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqComment) GetCommentOk() (Comment, bool) {
-	if r == nil || r.Comment == nil {
-		return Comment{}, false
+func (s *SyntheticsTriggeredTest) GetLocationOk() (int, bool) {
+	if s == nil || s.Location == nil {
+		return 0, false
 	}
-	return *r.Comment, true
+	return *s.Location, true
 }
 
-// HasComment returns a boolean if a field has been set.
-func (r *reqComment) HasComment() bool {
-	if r != nil && r.Comment != nil {
+// HasLocation returns a boolean if a field has been set.
+func (s *SyntheticsTriggeredTest) HasLocation() bool {
+	if s != nil && s.Location != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetComment allocates a new r.Comment and returns the pointer to it.
-func (r *reqComment) SetComment(v Comment) {
-	r.Comment = &v
+// SetLocation allocates a new s.Location and returns the pointer to it.
+func (s *SyntheticsTriggeredTest) SetLocation(v int) {
+	s.Location = &v
 }
 
-// GetDashboard returns the Dashboard field if non-nil, zero value otherwise.
-func (r *reqGetDashboard) GetDashboard() Dashboard {
-	if r == nil || r.Dashboard == nil {
-		return Dashboard{}
+// GetPublicId returns the PublicId field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggeredTest) GetPublicId() string {
+	if s == nil || s.PublicId == nil {
+		return ""
 	}
-	return *r.Dashboard
+	return *s.PublicId
 }
 
-// GetDashboardOk returns a tuple with the Dashboard field if it's non-nil, zero value otherwise
+// GetPublicIdOk returns a tuple with the PublicId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqGetDashboard) GetDashboardOk() (Dashboard, bool) {
-	if r == nil || r.Dashboard == nil {
-		return Dashboard{}, false
+func (s *SyntheticsTriggeredTest) GetPublicIdOk() (string, bool) {
+	if s == nil || s.PublicId == nil {
+		return "", false
 	}
-	return *r.Dashboard, true
+	return *s.PublicId, true
 }
 
-// HasDashboard returns a boolean if a field has been set.
-func (r *reqGetDashboard) HasDashboard() bool {
-	if r != nil && r.Dashboard != nil {
+// HasPublicId returns a boolean if a field has been set.
+func (s *SyntheticsTriggeredTest) HasPublicId() bool {
+	if s != nil && s.PublicId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDashboard allocates a new r.Dashboard and returns the pointer to it.
-func (r *reqGetDashboard) SetDashboard(v Dashboard) {
-	r.Dashboard = &v
+// SetPublicId allocates a new s.PublicId and returns the pointer to it.
+func (s *SyntheticsTriggeredTest) SetPublicId(v string) {
+	s.PublicId = &v
 }
 
-// GetResource returns the Resource field if non-nil, zero value otherwise.
-func (r *reqGetDashboard) GetResource() string {
-	if r == nil || r.Resource == nil {
+// GetResultId returns the ResultId field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggeredTest) GetResultId() string {
+	if s == nil || s.ResultId == nil {
 		return ""
 	}
-	return *r.Resource
+	return *s.ResultId
 }
 
-// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// GetResultIdOk returns a tuple with the ResultId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqGetDashboard) GetResourceOk() (string, bool) {
-	if r == nil || r.Resource == nil {
+func (s *SyntheticsTriggeredTest) GetResultIdOk() (string, bool) {
+	if s == nil || s.ResultId == nil {
 		return "", false
 	}
-	return *r.Resource, true
+	return *s.ResultId, true
 }
 
-// HasResource returns a boolean if a field has been set.
-func (r *reqGetDashboard) HasResource() bool {
-	if r != nil && r.Resource != nil {
+// HasResultId returns a boolean if a field has been set.
+func (s *SyntheticsTriggeredTest) HasResultId() bool {
+	if s != nil && s.ResultId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetResource allocates a new r.Resource and returns the pointer to it.
-func (r *reqGetDashboard) SetResource(v string) {
-	r.Resource = &v
+// SetResultId allocates a new s.ResultId and returns the pointer to it.
+func (s *SyntheticsTriggeredTest) SetResultId(v string) {
+	s.ResultId = &v
 }
 
-// GetUrl returns the Url field if non-nil, zero value otherwise.
-func (r *reqGetDashboard) GetUrl() string {
-	if r == nil || r.Url == nil {
+// GetDisplayName returns the DisplayName field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggerLocation) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
 		return ""
 	}
-	return *r.Url
+	return *s.DisplayName
 }
 
-// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// GetDisplayNameOk returns a tuple with the DisplayName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqGetDashboard) GetUrlOk() (string, bool) {
-	if r == nil || r.Url == nil {
+func (s *SyntheticsTriggerLocation) GetDisplayNameOk() (string, bool) {
+	if s == nil || s.DisplayName == nil {
 		return "", false
 	}
-	return *r.Url, true
+	return *s.DisplayName, true
 }
 
-// HasUrl returns a boolean if a field has been set.
-func (r *reqGetDashboard) HasUrl() bool {
-	if r != nil && r.Url != nil {
+// HasDisplayName returns a boolean if a field has been set.
+func (s *SyntheticsTriggerLocation) HasDisplayName() bool {
+	if s != nil && s.DisplayName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUrl allocates a new r.Url and returns the pointer to it.
-func (r *reqGetDashboard) SetUrl(v string) {
-	r.Url = &v
+// SetDisplayName allocates a new s.DisplayName and returns the pointer to it.
+func (s *SyntheticsTriggerLocation) SetDisplayName(v string) {
+	s.DisplayName = &v
 }
 
-// GetEvent returns the Event field if non-nil, zero value otherwise.
-func (r *reqGetEvent) GetEvent() Event {
-	if r == nil || r.Event == nil {
-		return Event{}
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggerLocation) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
 	}
-	return *r.Event
+	return *s.Id
 }
 
-// GetEventOk returns a tuple with the Event field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqGetEvent) GetEventOk() (Event, bool) {
-	if r == nil || r.Event == nil {
-		return Event{}, false
+func (s *SyntheticsTriggerLocation) GetIdOk() (int, bool) {
+	if s == nil || s.Id == nil {
+		return 0, false
 	}
-	return *r.Event, true
+	return *s.Id, true
 }
 
-// HasEvent returns a boolean if a field has been set.
-func (r *reqGetEvent) HasEvent() bool {
-	if r != nil && r.Event != nil {
+// HasId returns a boolean if a field has been set.
+func (s *SyntheticsTriggerLocation) HasId() bool {
+	if s != nil && s.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEvent allocates a new r.Event and returns the pointer to it.
-func (r *reqGetEvent) SetEvent(v Event) {
-	r.Event = &v
-}
-
-// GetTags returns the Tags field if non-nil, zero value otherwise.
-func (r *reqGetTags) GetTags() TagMap {
-	if r == nil || r.Tags == nil {
-		return TagMap{}
+// SetId allocates a new s.Id and returns the pointer to it.
+func (s *SyntheticsTriggerLocation) SetId(v int) {
+	s.Id = &v
+}
+
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggerLocation) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
 	}
-	return *r.Tags
+	return *s.Name
 }
 
-// GetTagsOk returns a tuple with the Tags field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *reqGetTags) GetTagsOk() (TagMap, bool) {
-	if r == nil || r.Tags == nil {
-		return TagMap{}, false
+func (s *SyntheticsTriggerLocation) GetNameOk() (string, bool) {
+	if s == nil || s.Name == nil {
+		return "", false
 	}
-	return *r.Tags, true
+	return *s.Name, true
 }
 
-// HasTags returns a boolean if a field has been set.
-func (r *reqGetTags) HasTags() bool {
-	if r != nil && r.Tags != nil {
+// HasName returns a boolean if a field has been set.
+func (s *SyntheticsTriggerLocation) HasName() bool {
+	if s != nil && s.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTags allocates a new r.Tags and returns the pointer to it.
-func (r *reqGetTags) SetTags(v TagMap) {
-	r.Tags = &v
+// SetName allocates a new s.Name and returns the pointer to it.
+func (s *SyntheticsTriggerLocation) SetName(v string) {
+	s.Name = &v
 }
 
-// GetColor returns the Color field if non-nil, zero value otherwise.
-func (r *Rule) GetColor() string {
-	if r == nil || r.Color == nil {
+// GetRegion returns the Region field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggerLocation) GetRegion() string {
+	if s == nil || s.Region == nil {
 		return ""
 	}
-	return *r.Color
+	return *s.Region
 }
 
-// GetColorOk returns a tuple with the Color field if it's non-nil, zero value otherwise
+// GetRegionOk returns a tuple with the Region field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Rule) GetColorOk() (string, bool) {
-	if r == nil || r.Color == nil {
+func (s *SyntheticsTriggerLocation) GetRegionOk() (string, bool) {
+	if s == nil || s.Region == nil {
 		return "", false
 	}
-	return *r.Color, true
+	return *s.Region, true
 }
 
-// HasColor returns a boolean if a field has been set.
-func (r *Rule) HasColor() bool {
-	if r != nil && r.Color != nil {
+// HasRegion returns a boolean if a field has been set.
+func (s *SyntheticsTriggerLocation) HasRegion() bool {
+	if s != nil && s.Region != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetColor allocates a new r.Color and returns the pointer to it.
-func (r *Rule) SetColor(v string) {
-	r.Color = &v
+// SetRegion allocates a new s.Region and returns the pointer to it.
+func (s *SyntheticsTriggerLocation) SetRegion(v string) {
+	s.Region = &v
 }
 
-// GetThreshold returns the Threshold field if non-nil, zero value otherwise.
-func (r *Rule) GetThreshold() json.Number {
-	if r == nil || r.Threshold == nil {
+// GetBatchId returns the BatchId field if non-nil, zero value otherwise.
+func (s *SyntheticsTriggerResult) GetBatchId() string {
+	if s == nil || s.BatchId == nil {
 		return ""
 	}
-	return *r.Threshold
+	return *s.BatchId
 }
 
-// GetThresholdOk returns a tuple with the Threshold field if it's non-nil, zero value otherwise
+// GetBatchIdOk returns a tuple with the BatchId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Rule) GetThresholdOk() (json.Number, bool) {
-	if r == nil || r.Threshold == nil {
+func (s *SyntheticsTriggerResult) GetBatchIdOk() (string, bool) {
+	if s == nil || s.BatchId == nil {
 		return "", false
 	}
-	return *r.Threshold, true
+	return *s.BatchId, true
 }
 
-// HasThreshold returns a boolean if a field has been set.
-func (r *Rule) HasThreshold() bool {
-	if r != nil && r.Threshold != nil {
+// HasBatchId returns a boolean if a field has been set.
+func (s *SyntheticsTriggerResult) HasBatchId() bool {
+	if s != nil && s.BatchId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetThreshold allocates a new r.Threshold and returns the pointer to it.
-func (r *Rule) SetThreshold(v json.Number) {
-	r.Threshold = &v
+// SetBatchId allocates a new s.BatchId and returns the pointer to it.
+func (s *SyntheticsTriggerResult) SetBatchId(v string) {
+	s.BatchId = &v
 }
 
-// GetTimeframe returns the Timeframe field if non-nil, zero value otherwise.
-func (r *Rule) GetTimeframe() string {
-	if r == nil || r.Timeframe == nil {
+// GetDefault returns the Default field if non-nil, zero value otherwise.
+func (t *TemplateVariable) GetDefault() string {
+	if t == nil || t.Default == nil {
 		return ""
 	}
-	return *r.Timeframe
+	return *t.Default
 }
 
-// GetTimeframeOk returns a tuple with the Timeframe field if it's non-nil, zero value otherwise
+// GetDefaultOk returns a tuple with the Default field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (r *Rule) GetTimeframeOk() (string, bool) {
-	if r == nil || r.Timeframe == nil {
+func (t *TemplateVariable) GetDefaultOk() (string, bool) {
+	if t == nil || t.Default == nil {
 		return "", false
 	}
-	return *r.Timeframe, true
+	return *t.Default, true
 }
 
-// HasTimeframe returns a boolean if a field has been set.
-func (r *Rule) HasTimeframe() bool {
-	if r != nil && r.Timeframe != nil {
+// HasDefault returns a boolean if a field has been set.
+func (t *TemplateVariable) HasDefault() bool {
+	if t != nil && t.Default != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTimeframe allocates a new r.Timeframe and returns the pointer to it.
-func (r *Rule) SetTimeframe(v string) {
-	r.Timeframe = &v
+// SetDefault allocates a new t.Default and returns the pointer to it.
+func (t *TemplateVariable) SetDefault(v string) {
+	t.Default = &v
 }
 
-// GetHeight returns the Height field if non-nil, zero value otherwise.
-func (s *Screenboard) GetHeight() int {
-	if s == nil || s.Height == nil {
-		return 0
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (t *TemplateVariable) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
 	}
-	return *s.Height
+	return *t.Name
 }
 
-// GetHeightOk returns a tuple with the Height field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetHeightOk() (int, bool) {
-	if s == nil || s.Height == nil {
-		return 0, false
+func (t *TemplateVariable) GetNameOk() (string, bool) {
+	if t == nil || t.Name == nil {
+		return "", false
 	}
-	return *s.Height, true
+	return *t.Name, true
 }
 
-// HasHeight returns a boolean if a field has been set.
-func (s *Screenboard) HasHeight() bool {
-	if s != nil && s.Height != nil {
+// HasName returns a boolean if a field has been set.
+func (t *TemplateVariable) HasName() bool {
+	if t != nil && t.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHeight allocates a new s.Height and returns the pointer to it.
-func (s *Screenboard) SetHeight(v int) {
-	s.Height = &v
+// SetName allocates a new t.Name and returns the pointer to it.
+func (t *TemplateVariable) SetName(v string) {
+	t.Name = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (s *Screenboard) GetId() int {
-	if s == nil || s.Id == nil {
-		return 0
+// GetPrefix returns the Prefix field if non-nil, zero value otherwise.
+func (t *TemplateVariable) GetPrefix() string {
+	if t == nil || t.Prefix == nil {
+		return ""
 	}
-	return *s.Id
+	return *t.Prefix
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetPrefixOk returns a tuple with the Prefix field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetIdOk() (int, bool) {
-	if s == nil || s.Id == nil {
-		return 0, false
+func (t *TemplateVariable) GetPrefixOk() (string, bool) {
+	if t == nil || t.Prefix == nil {
+		return "", false
 	}
-	return *s.Id, true
+	return *t.Prefix, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (s *Screenboard) HasId() bool {
-	if s != nil && s.Id != nil {
+// HasPrefix returns a boolean if a field has been set.
+func (t *TemplateVariable) HasPrefix() bool {
+	if t != nil && t.Prefix != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new s.Id and returns the pointer to it.
-func (s *Screenboard) SetId(v int) {
-	s.Id = &v
+// SetPrefix allocates a new t.Prefix and returns the pointer to it.
+func (t *TemplateVariable) SetPrefix(v string) {
+	t.Prefix = &v
 }
 
-// GetReadOnly returns the ReadOnly field if non-nil, zero value otherwise.
-func (s *Screenboard) GetReadOnly() bool {
-	if s == nil || s.ReadOnly == nil {
-		return false
+// GetCritical returns the Critical field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetCritical() json.Number {
+	if t == nil || t.Critical == nil {
+		return ""
 	}
-	return *s.ReadOnly
+	return *t.Critical
 }
 
-// GetReadOnlyOk returns a tuple with the ReadOnly field if it's non-nil, zero value otherwise
+// GetCriticalOk returns a tuple with the Critical field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetReadOnlyOk() (bool, bool) {
-	if s == nil || s.ReadOnly == nil {
-		return false, false
+func (t *ThresholdCount) GetCriticalOk() (json.Number, bool) {
+	if t == nil || t.Critical == nil {
+		return "", false
 	}
-	return *s.ReadOnly, true
+	return *t.Critical, true
 }
 
-// HasReadOnly returns a boolean if a field has been set.
-func (s *Screenboard) HasReadOnly() bool {
-	if s != nil && s.ReadOnly != nil {
+// HasCritical returns a boolean if a field has been set.
+func (t *ThresholdCount) HasCritical() bool {
+	if t != nil && t.Critical != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetReadOnly allocates a new s.ReadOnly and returns the pointer to it.
-func (s *Screenboard) SetReadOnly(v bool) {
-	s.ReadOnly = &v
+// SetCritical allocates a new t.Critical and returns the pointer to it.
+func (t *ThresholdCount) SetCritical(v json.Number) {
+	t.Critical = &v
 }
 
-// GetShared returns the Shared field if non-nil, zero value otherwise.
-func (s *Screenboard) GetShared() bool {
-	if s == nil || s.Shared == nil {
-		return false
+// GetCriticalRecovery returns the CriticalRecovery field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetCriticalRecovery() json.Number {
+	if t == nil || t.CriticalRecovery == nil {
+		return ""
 	}
-	return *s.Shared
+	return *t.CriticalRecovery
 }
 
-// GetSharedOk returns a tuple with the Shared field if it's non-nil, zero value otherwise
+// GetCriticalRecoveryOk returns a tuple with the CriticalRecovery field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetSharedOk() (bool, bool) {
-	if s == nil || s.Shared == nil {
-		return false, false
+func (t *ThresholdCount) GetCriticalRecoveryOk() (json.Number, bool) {
+	if t == nil || t.CriticalRecovery == nil {
+		return "", false
 	}
-	return *s.Shared, true
+	return *t.CriticalRecovery, true
 }
 
-// HasShared returns a boolean if a field has been set.
-func (s *Screenboard) HasShared() bool {
-	if s != nil && s.Shared != nil {
+// HasCriticalRecovery returns a boolean if a field has been set.
+func (t *ThresholdCount) HasCriticalRecovery() bool {
+	if t != nil && t.CriticalRecovery != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetShared allocates a new s.Shared and returns the pointer to it.
-func (s *Screenboard) SetShared(v bool) {
-	s.Shared = &v
+// SetCriticalRecovery allocates a new t.CriticalRecovery and returns the pointer to it.
+func (t *ThresholdCount) SetCriticalRecovery(v json.Number) {
+	t.CriticalRecovery = &v
 }
 
-// GetTitle returns the Title field if non-nil, zero value otherwise.
-func (s *Screenboard) GetTitle() string {
-	if s == nil || s.Title == nil {
+// GetOk returns the Ok field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetOk() json.Number {
+	if t == nil || t.Ok == nil {
 		return ""
 	}
-	return *s.Title
+	return *t.Ok
 }
 
-// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// GetOkOk returns a tuple with the Ok field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetTitleOk() (string, bool) {
-	if s == nil || s.Title == nil {
+func (t *ThresholdCount) GetOkOk() (json.Number, bool) {
+	if t == nil || t.Ok == nil {
 		return "", false
 	}
-	return *s.Title, true
+	return *t.Ok, true
 }
 
-// HasTitle returns a boolean if a field has been set.
-func (s *Screenboard) HasTitle() bool {
-	if s != nil && s.Title != nil {
+// HasOk returns a boolean if a field has been set.
+func (t *ThresholdCount) HasOk() bool {
+	if t != nil && t.Ok != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new s.Title and returns the pointer to it.
-func (s *Screenboard) SetTitle(v string) {
-	s.Title = &v
+// SetOk allocates a new t.Ok and returns the pointer to it.
+func (t *ThresholdCount) SetOk(v json.Number) {
+	t.Ok = &v
 }
 
-// GetWidth returns the Width field if non-nil, zero value otherwise.
-func (s *Screenboard) GetWidth() int {
-	if s == nil || s.Width == nil {
-		return 0
+// GetUnknown returns the Unknown field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetUnknown() json.Number {
+	if t == nil || t.Unknown == nil {
+		return ""
 	}
-	return *s.Width
+	return *t.Unknown
 }
 
-// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
+// GetUnknownOk returns a tuple with the Unknown field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Screenboard) GetWidthOk() (int, bool) {
-	if s == nil || s.Width == nil {
-		return 0, false
+func (t *ThresholdCount) GetUnknownOk() (json.Number, bool) {
+	if t == nil || t.Unknown == nil {
+		return "", false
 	}
-	return *s.Width, true
+	return *t.Unknown, true
 }
 
-// HasWidth returns a boolean if a field has been set.
-func (s *Screenboard) HasWidth() bool {
-	if s != nil && s.Width != nil {
+// HasUnknown returns a boolean if a field has been set.
+func (t *ThresholdCount) HasUnknown() bool {
+	if t != nil && t.Unknown != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetWidth allocates a new s.Width and returns the pointer to it.
-func (s *Screenboard) SetWidth(v int) {
-	s.Width = &v
+// SetUnknown allocates a new t.Unknown and returns the pointer to it.
+func (t *ThresholdCount) SetUnknown(v json.Number) {
+	t.Unknown = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (s *ScreenboardLite) GetId() int {
-	if s == nil || s.Id == nil {
-		return 0
+// GetWarning returns the Warning field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetWarning() json.Number {
+	if t == nil || t.Warning == nil {
+		return ""
 	}
-	return *s.Id
+	return *t.Warning
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetWarningOk returns a tuple with the Warning field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ScreenboardLite) GetIdOk() (int, bool) {
-	if s == nil || s.Id == nil {
-		return 0, false
+func (t *ThresholdCount) GetWarningOk() (json.Number, bool) {
+	if t == nil || t.Warning == nil {
+		return "", false
 	}
-	return *s.Id, true
+	return *t.Warning, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (s *ScreenboardLite) HasId() bool {
-	if s != nil && s.Id != nil {
+// HasWarning returns a boolean if a field has been set.
+func (t *ThresholdCount) HasWarning() bool {
+	if t != nil && t.Warning != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new s.Id and returns the pointer to it.
-func (s *ScreenboardLite) SetId(v int) {
-	s.Id = &v
+// SetWarning allocates a new t.Warning and returns the pointer to it.
+func (t *ThresholdCount) SetWarning(v json.Number) {
+	t.Warning = &v
 }
 
-// GetResource returns the Resource field if non-nil, zero value otherwise.
-func (s *ScreenboardLite) GetResource() string {
-	if s == nil || s.Resource == nil {
+// GetWarningRecovery returns the WarningRecovery field if non-nil, zero value otherwise.
+func (t *ThresholdCount) GetWarningRecovery() json.Number {
+	if t == nil || t.WarningRecovery == nil {
 		return ""
 	}
-	return *s.Resource
+	return *t.WarningRecovery
 }
 
-// GetResourceOk returns a tuple with the Resource field if it's non-nil, zero value otherwise
+// GetWarningRecoveryOk returns a tuple with the WarningRecovery field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ScreenboardLite) GetResourceOk() (string, bool) {
-	if s == nil || s.Resource == nil {
+func (t *ThresholdCount) GetWarningRecoveryOk() (json.Number, bool) {
+	if t == nil || t.WarningRecovery == nil {
 		return "", false
 	}
-	return *s.Resource, true
+	return *t.WarningRecovery, true
 }
 
-// HasResource returns a boolean if a field has been set.
-func (s *ScreenboardLite) HasResource() bool {
-	if s != nil && s.Resource != nil {
+// HasWarningRecovery returns a boolean if a field has been set.
+func (t *ThresholdCount) HasWarningRecovery() bool {
+	if t != nil && t.WarningRecovery != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetResource allocates a new s.Resource and returns the pointer to it.
-func (s *ScreenboardLite) SetResource(v string) {
-	s.Resource = &v
+// SetWarningRecovery allocates a new t.WarningRecovery and returns the pointer to it.
+func (t *ThresholdCount) SetWarningRecovery(v json.Number) {
+	t.WarningRecovery = &v
 }
 
-// GetTitle returns the Title field if non-nil, zero value otherwise.
-func (s *ScreenboardLite) GetTitle() string {
-	if s == nil || s.Title == nil {
+// GetRecoveryWindow returns the RecoveryWindow field if non-nil, zero value otherwise.
+func (t *ThresholdWindows) GetRecoveryWindow() string {
+	if t == nil || t.RecoveryWindow == nil {
 		return ""
 	}
-	return *s.Title
+	return *t.RecoveryWindow
 }
 
-// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
+// GetRecoveryWindowOk returns a tuple with the RecoveryWindow field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ScreenboardLite) GetTitleOk() (string, bool) {
-	if s == nil || s.Title == nil {
+func (t *ThresholdWindows) GetRecoveryWindowOk() (string, bool) {
+	if t == nil || t.RecoveryWindow == nil {
 		return "", false
 	}
-	return *s.Title, true
+	return *t.RecoveryWindow, true
 }
 
-// HasTitle returns a boolean if a field has been set.
-func (s *ScreenboardLite) HasTitle() bool {
-	if s != nil && s.Title != nil {
+// HasRecoveryWindow returns a boolean if a field has been set.
+func (t *ThresholdWindows) HasRecoveryWindow() bool {
+	if t != nil && t.RecoveryWindow != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTitle allocates a new s.Title and returns the pointer to it.
-func (s *ScreenboardLite) SetTitle(v string) {
-	s.Title = &v
+// SetRecoveryWindow allocates a new t.RecoveryWindow and returns the pointer to it.
+func (t *ThresholdWindows) SetRecoveryWindow(v string) {
+	t.RecoveryWindow = &v
 }
 
-// GetId returns the Id field if non-nil, zero value otherwise.
-func (s *ScreenboardMonitor) GetId() int {
-	if s == nil || s.Id == nil {
-		return 0
+// GetTriggerWindow returns the TriggerWindow field if non-nil, zero value otherwise.
+func (t *ThresholdWindows) GetTriggerWindow() string {
+	if t == nil || t.TriggerWindow == nil {
+		return ""
 	}
-	return *s.Id
+	return *t.TriggerWindow
 }
 
-// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
+// GetTriggerWindowOk returns a tuple with the TriggerWindow field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ScreenboardMonitor) GetIdOk() (int, bool) {
-	if s == nil || s.Id == nil {
-		return 0, false
+func (t *ThresholdWindows) GetTriggerWindowOk() (string, bool) {
+	if t == nil || t.TriggerWindow == nil {
+		return "", false
 	}
-	return *s.Id, true
+	return *t.TriggerWindow, true
 }
 
-// HasId returns a boolean if a field has been set.
-func (s *ScreenboardMonitor) HasId() bool {
-	if s != nil && s.Id != nil {
+// HasTriggerWindow returns a boolean if a field has been set.
+func (t *ThresholdWindows) HasTriggerWindow() bool {
+	if t != nil && t.TriggerWindow != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetId allocates a new s.Id and returns the pointer to it.
-func (s *ScreenboardMonitor) SetId(v int) {
-	s.Id = &v
+// SetTriggerWindow allocates a new t.TriggerWindow and returns the pointer to it.
+func (t *ThresholdWindows) SetTriggerWindow(v string) {
+	t.TriggerWindow = &v
 }
 
-// GetAggr returns the Aggr field if non-nil, zero value otherwise.
-func (s *Series) GetAggr() string {
-	if s == nil || s.Aggr == nil {
-		return ""
+// GetAutoscale returns the Autoscale field if non-nil, zero value otherwise.
+func (t *TileDef) GetAutoscale() bool {
+	if t == nil || t.Autoscale == nil {
+		return false
 	}
-	return *s.Aggr
+	return *t.Autoscale
 }
 
-// GetAggrOk returns a tuple with the Aggr field if it's non-nil, zero value otherwise
+// GetAutoscaleOk returns a tuple with the Autoscale field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetAggrOk() (string, bool) {
-	if s == nil || s.Aggr == nil {
-		return "", false
+func (t *TileDef) GetAutoscaleOk() (bool, bool) {
+	if t == nil || t.Autoscale == nil {
+		return false, false
 	}
-	return *s.Aggr, true
+	return *t.Autoscale, true
 }
 
-// HasAggr returns a boolean if a field has been set.
-func (s *Series) HasAggr() bool {
-	if s != nil && s.Aggr != nil {
+// HasAutoscale returns a boolean if a field has been set.
+func (t *TileDef) HasAutoscale() bool {
+	if t != nil && t.Autoscale != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAggr allocates a new s.Aggr and returns the pointer to it.
-func (s *Series) SetAggr(v string) {
-	s.Aggr = &v
+// SetAutoscale allocates a new t.Autoscale and returns the pointer to it.
+func (t *TileDef) SetAutoscale(v bool) {
+	t.Autoscale = &v
 }
 
-// GetDisplayName returns the DisplayName field if non-nil, zero value otherwise.
-func (s *Series) GetDisplayName() string {
-	if s == nil || s.DisplayName == nil {
+// GetCustomUnit returns the CustomUnit field if non-nil, zero value otherwise.
+func (t *TileDef) GetCustomUnit() string {
+	if t == nil || t.CustomUnit == nil {
 		return ""
 	}
-	return *s.DisplayName
+	return *t.CustomUnit
 }
 
-// GetDisplayNameOk returns a tuple with the DisplayName field if it's non-nil, zero value otherwise
+// GetCustomUnitOk returns a tuple with the CustomUnit field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetDisplayNameOk() (string, bool) {
-	if s == nil || s.DisplayName == nil {
+func (t *TileDef) GetCustomUnitOk() (string, bool) {
+	if t == nil || t.CustomUnit == nil {
 		return "", false
 	}
-	return *s.DisplayName, true
+	return *t.CustomUnit, true
 }
 
-// HasDisplayName returns a boolean if a field has been set.
-func (s *Series) HasDisplayName() bool {
-	if s != nil && s.DisplayName != nil {
+// HasCustomUnit returns a boolean if a field has been set.
+func (t *TileDef) HasCustomUnit() bool {
+	if t != nil && t.CustomUnit != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDisplayName allocates a new s.DisplayName and returns the pointer to it.
-func (s *Series) SetDisplayName(v string) {
-	s.DisplayName = &v
+// SetCustomUnit allocates a new t.CustomUnit and returns the pointer to it.
+func (t *TileDef) SetCustomUnit(v string) {
+	t.CustomUnit = &v
 }
 
-// GetEnd returns the End field if non-nil, zero value otherwise.
-func (s *Series) GetEnd() float64 {
-	if s == nil || s.End == nil {
-		return 0
+// GetNodeType returns the NodeType field if non-nil, zero value otherwise.
+func (t *TileDef) GetNodeType() string {
+	if t == nil || t.NodeType == nil {
+		return ""
 	}
-	return *s.End
+	return *t.NodeType
 }
 
-// GetEndOk returns a tuple with the End field if it's non-nil, zero value otherwise
+// GetNodeTypeOk returns a tuple with the NodeType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetEndOk() (float64, bool) {
-	if s == nil || s.End == nil {
-		return 0, false
+func (t *TileDef) GetNodeTypeOk() (string, bool) {
+	if t == nil || t.NodeType == nil {
+		return "", false
 	}
-	return *s.End, true
+	return *t.NodeType, true
 }
 
-// HasEnd returns a boolean if a field has been set.
-func (s *Series) HasEnd() bool {
-	if s != nil && s.End != nil {
+// HasNodeType returns a boolean if a field has been set.
+func (t *TileDef) HasNodeType() bool {
+	if t != nil && t.NodeType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEnd allocates a new s.End and returns the pointer to it.
-func (s *Series) SetEnd(v float64) {
-	s.End = &v
+// SetNodeType allocates a new t.NodeType and returns the pointer to it.
+func (t *TileDef) SetNodeType(v string) {
+	t.NodeType = &v
 }
 
-// GetExpression returns the Expression field if non-nil, zero value otherwise.
-func (s *Series) GetExpression() string {
-	if s == nil || s.Expression == nil {
-		return ""
+// GetNoGroupHosts returns the NoGroupHosts field if non-nil, zero value otherwise.
+func (t *TileDef) GetNoGroupHosts() bool {
+	if t == nil || t.NoGroupHosts == nil {
+		return false
 	}
-	return *s.Expression
+	return *t.NoGroupHosts
 }
 
-// GetExpressionOk returns a tuple with the Expression field if it's non-nil, zero value otherwise
+// GetNoGroupHostsOk returns a tuple with the NoGroupHosts field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetExpressionOk() (string, bool) {
-	if s == nil || s.Expression == nil {
-		return "", false
+func (t *TileDef) GetNoGroupHostsOk() (bool, bool) {
+	if t == nil || t.NoGroupHosts == nil {
+		return false, false
 	}
-	return *s.Expression, true
+	return *t.NoGroupHosts, true
 }
 
-// HasExpression returns a boolean if a field has been set.
-func (s *Series) HasExpression() bool {
-	if s != nil && s.Expression != nil {
+// HasNoGroupHosts returns a boolean if a field has been set.
+func (t *TileDef) HasNoGroupHosts() bool {
+	if t != nil && t.NoGroupHosts != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetExpression allocates a new s.Expression and returns the pointer to it.
-func (s *Series) SetExpression(v string) {
-	s.Expression = &v
+// SetNoGroupHosts allocates a new t.NoGroupHosts and returns the pointer to it.
+func (t *TileDef) SetNoGroupHosts(v bool) {
+	t.NoGroupHosts = &v
 }
 
-// GetInterval returns the Interval field if non-nil, zero value otherwise.
-func (s *Series) GetInterval() int {
-	if s == nil || s.Interval == nil {
-		return 0
+// GetNoMetricHosts returns the NoMetricHosts field if non-nil, zero value otherwise.
+func (t *TileDef) GetNoMetricHosts() bool {
+	if t == nil || t.NoMetricHosts == nil {
+		return false
 	}
-	return *s.Interval
+	return *t.NoMetricHosts
 }
 
-// GetIntervalOk returns a tuple with the Interval field if it's non-nil, zero value otherwise
+// GetNoMetricHostsOk returns a tuple with the NoMetricHosts field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetIntervalOk() (int, bool) {
-	if s == nil || s.Interval == nil {
-		return 0, false
+func (t *TileDef) GetNoMetricHostsOk() (bool, bool) {
+	if t == nil || t.NoMetricHosts == nil {
+		return false, false
 	}
-	return *s.Interval, true
+	return *t.NoMetricHosts, true
 }
 
-// HasInterval returns a boolean if a field has been set.
-func (s *Series) HasInterval() bool {
-	if s != nil && s.Interval != nil {
+// HasNoMetricHosts returns a boolean if a field has been set.
+func (t *TileDef) HasNoMetricHosts() bool {
+	if t != nil && t.NoMetricHosts != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetInterval allocates a new s.Interval and returns the pointer to it.
-func (s *Series) SetInterval(v int) {
-	s.Interval = &v
+// SetNoMetricHosts allocates a new t.NoMetricHosts and returns the pointer to it.
+func (t *TileDef) SetNoMetricHosts(v bool) {
+	t.NoMetricHosts = &v
 }
 
-// GetLength returns the Length field if non-nil, zero value otherwise.
-func (s *Series) GetLength() int {
-	if s == nil || s.Length == nil {
-		return 0
+// GetPrecision returns the Precision field if non-nil, zero value otherwise.
+func (t *TileDef) GetPrecision() PrecisionT {
+	if t == nil || t.Precision == nil {
+		return ""
 	}
-	return *s.Length
+	return *t.Precision
 }
 
-// GetLengthOk returns a tuple with the Length field if it's non-nil, zero value otherwise
+// GetPrecisionOk returns a tuple with the Precision field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetLengthOk() (int, bool) {
-	if s == nil || s.Length == nil {
-		return 0, false
+func (t *TileDef) GetPrecisionOk() (PrecisionT, bool) {
+	if t == nil || t.Precision == nil {
+		return "", false
 	}
-	return *s.Length, true
+	return *t.Precision, true
 }
 
-// HasLength returns a boolean if a field has been set.
-func (s *Series) HasLength() bool {
-	if s != nil && s.Length != nil {
+// HasPrecision returns a boolean if a field has been set.
+func (t *TileDef) HasPrecision() bool {
+	if t != nil && t.Precision != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLength allocates a new s.Length and returns the pointer to it.
-func (s *Series) SetLength(v int) {
-	s.Length = &v
+// SetPrecision allocates a new t.Precision and returns the pointer to it.
+func (t *TileDef) SetPrecision(v PrecisionT) {
+	t.Precision = &v
 }
 
-// GetMetric returns the Metric field if non-nil, zero value otherwise.
-func (s *Series) GetMetric() string {
-	if s == nil || s.Metric == nil {
-		return ""
+// GetStyle returns the Style field if non-nil, zero value otherwise.
+func (t *TileDef) GetStyle() TileDefStyle {
+	if t == nil || t.Style == nil {
+		return TileDefStyle{}
 	}
-	return *s.Metric
+	return *t.Style
 }
 
-// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetMetricOk() (string, bool) {
-	if s == nil || s.Metric == nil {
-		return "", false
+func (t *TileDef) GetStyleOk() (TileDefStyle, bool) {
+	if t == nil || t.Style == nil {
+		return TileDefStyle{}, false
 	}
-	return *s.Metric, true
+	return *t.Style, true
 }
 
-// HasMetric returns a boolean if a field has been set.
-func (s *Series) HasMetric() bool {
-	if s != nil && s.Metric != nil {
+// HasStyle returns a boolean if a field has been set.
+func (t *TileDef) HasStyle() bool {
+	if t != nil && t.Style != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMetric allocates a new s.Metric and returns the pointer to it.
-func (s *Series) SetMetric(v string) {
-	s.Metric = &v
+// SetStyle allocates a new t.Style and returns the pointer to it.
+func (t *TileDef) SetStyle(v TileDefStyle) {
+	t.Style = &v
 }
 
-// GetScope returns the Scope field if non-nil, zero value otherwise.
-func (s *Series) GetScope() string {
-	if s == nil || s.Scope == nil {
+// GetTextAlign returns the TextAlign field if non-nil, zero value otherwise.
+func (t *TileDef) GetTextAlign() string {
+	if t == nil || t.TextAlign == nil {
 		return ""
 	}
-	return *s.Scope
+	return *t.TextAlign
 }
 
-// GetScopeOk returns a tuple with the Scope field if it's non-nil, zero value otherwise
+// GetTextAlignOk returns a tuple with the TextAlign field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetScopeOk() (string, bool) {
-	if s == nil || s.Scope == nil {
+func (t *TileDef) GetTextAlignOk() (string, bool) {
+	if t == nil || t.TextAlign == nil {
 		return "", false
 	}
-	return *s.Scope, true
+	return *t.TextAlign, true
 }
 
-// HasScope returns a boolean if a field has been set.
-func (s *Series) HasScope() bool {
-	if s != nil && s.Scope != nil {
+// HasTextAlign returns a boolean if a field has been set.
+func (t *TileDef) HasTextAlign() bool {
+	if t != nil && t.TextAlign != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetScope allocates a new s.Scope and returns the pointer to it.
-func (s *Series) SetScope(v string) {
-	s.Scope = &v
+// SetTextAlign allocates a new t.TextAlign and returns the pointer to it.
+func (t *TileDef) SetTextAlign(v string) {
+	t.TextAlign = &v
 }
 
-// GetStart returns the Start field if non-nil, zero value otherwise.
-func (s *Series) GetStart() float64 {
-	if s == nil || s.Start == nil {
-		return 0
+// GetViz returns the Viz field if non-nil, zero value otherwise.
+func (t *TileDef) GetViz() string {
+	if t == nil || t.Viz == nil {
+		return ""
 	}
-	return *s.Start
+	return *t.Viz
 }
 
-// GetStartOk returns a tuple with the Start field if it's non-nil, zero value otherwise
+// GetVizOk returns a tuple with the Viz field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetStartOk() (float64, bool) {
-	if s == nil || s.Start == nil {
-		return 0, false
+func (t *TileDef) GetVizOk() (string, bool) {
+	if t == nil || t.Viz == nil {
+		return "", false
 	}
-	return *s.Start, true
+	return *t.Viz, true
 }
 
-// HasStart returns a boolean if a field has been set.
-func (s *Series) HasStart() bool {
-	if s != nil && s.Start != nil {
+// HasViz returns a boolean if a field has been set.
+func (t *TileDef) HasViz() bool {
+	if t != nil && t.Viz != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStart allocates a new s.Start and returns the pointer to it.
-func (s *Series) SetStart(v float64) {
-	s.Start = &v
+// SetViz allocates a new t.Viz and returns the pointer to it.
+func (t *TileDef) SetViz(v string) {
+	t.Viz = &v
 }
 
-// GetUnits returns the Units field if non-nil, zero value otherwise.
-func (s *Series) GetUnits() UnitPair {
-	if s == nil || s.Units == nil {
-		return UnitPair{}
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (t *TileDefEvent) GetQuery() string {
+	if t == nil || t.Query == nil {
+		return ""
 	}
-	return *s.Units
+	return *t.Query
 }
 
-// GetUnitsOk returns a tuple with the Units field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Series) GetUnitsOk() (UnitPair, bool) {
-	if s == nil || s.Units == nil {
-		return UnitPair{}, false
+func (t *TileDefEvent) GetQueryOk() (string, bool) {
+	if t == nil || t.Query == nil {
+		return "", false
 	}
-	return *s.Units, true
+	return *t.Query, true
 }
 
-// HasUnits returns a boolean if a field has been set.
-func (s *Series) HasUnits() bool {
-	if s != nil && s.Units != nil {
+// HasQuery returns a boolean if a field has been set.
+func (t *TileDefEvent) HasQuery() bool {
+	if t != nil && t.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUnits allocates a new s.Units and returns the pointer to it.
-func (s *Series) SetUnits(v UnitPair) {
-	s.Units = &v
+// SetQuery allocates a new t.Query and returns the pointer to it.
+func (t *TileDefEvent) SetQuery(v string) {
+	t.Query = &v
 }
 
-// GetAccount returns the Account field if non-nil, zero value otherwise.
-func (s *ServiceHookSlackRequest) GetAccount() string {
-	if s == nil || s.Account == nil {
+// GetLabel returns the Label field if non-nil, zero value otherwise.
+func (t *TileDefMarker) GetLabel() string {
+	if t == nil || t.Label == nil {
 		return ""
 	}
-	return *s.Account
+	return *t.Label
 }
 
-// GetAccountOk returns a tuple with the Account field if it's non-nil, zero value otherwise
+// GetLabelOk returns a tuple with the Label field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ServiceHookSlackRequest) GetAccountOk() (string, bool) {
-	if s == nil || s.Account == nil {
+func (t *TileDefMarker) GetLabelOk() (string, bool) {
+	if t == nil || t.Label == nil {
 		return "", false
 	}
-	return *s.Account, true
+	return *t.Label, true
 }
 
-// HasAccount returns a boolean if a field has been set.
-func (s *ServiceHookSlackRequest) HasAccount() bool {
-	if s != nil && s.Account != nil {
+// HasLabel returns a boolean if a field has been set.
+func (t *TileDefMarker) HasLabel() bool {
+	if t != nil && t.Label != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccount allocates a new s.Account and returns the pointer to it.
-func (s *ServiceHookSlackRequest) SetAccount(v string) {
-	s.Account = &v
+// SetLabel allocates a new t.Label and returns the pointer to it.
+func (t *TileDefMarker) SetLabel(v string) {
+	t.Label = &v
 }
 
-// GetUrl returns the Url field if non-nil, zero value otherwise.
-func (s *ServiceHookSlackRequest) GetUrl() string {
-	if s == nil || s.Url == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (t *TileDefMarker) GetType() string {
+	if t == nil || t.Type == nil {
 		return ""
 	}
-	return *s.Url
+	return *t.Type
 }
 
-// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ServiceHookSlackRequest) GetUrlOk() (string, bool) {
-	if s == nil || s.Url == nil {
+func (t *TileDefMarker) GetTypeOk() (string, bool) {
+	if t == nil || t.Type == nil {
 		return "", false
 	}
-	return *s.Url, true
+	return *t.Type, true
 }
 
-// HasUrl returns a boolean if a field has been set.
-func (s *ServiceHookSlackRequest) HasUrl() bool {
-	if s != nil && s.Url != nil {
+// HasType returns a boolean if a field has been set.
+func (t *TileDefMarker) HasType() bool {
+	if t != nil && t.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUrl allocates a new s.Url and returns the pointer to it.
-func (s *ServiceHookSlackRequest) SetUrl(v string) {
-	s.Url = &v
+// SetType allocates a new t.Type and returns the pointer to it.
+func (t *TileDefMarker) SetType(v string) {
+	t.Type = &v
 }
 
-// GetServiceKey returns the ServiceKey field if non-nil, zero value otherwise.
-func (s *servicePD) GetServiceKey() string {
-	if s == nil || s.ServiceKey == nil {
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (t *TileDefMarker) GetValue() string {
+	if t == nil || t.Value == nil {
 		return ""
 	}
-	return *s.ServiceKey
+	return *t.Value
 }
 
-// GetServiceKeyOk returns a tuple with the ServiceKey field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *servicePD) GetServiceKeyOk() (string, bool) {
-	if s == nil || s.ServiceKey == nil {
+func (t *TileDefMarker) GetValueOk() (string, bool) {
+	if t == nil || t.Value == nil {
 		return "", false
 	}
-	return *s.ServiceKey, true
+	return *t.Value, true
 }
 
-// HasServiceKey returns a boolean if a field has been set.
-func (s *servicePD) HasServiceKey() bool {
-	if s != nil && s.ServiceKey != nil {
+// HasValue returns a boolean if a field has been set.
+func (t *TileDefMarker) HasValue() bool {
+	if t != nil && t.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetServiceKey allocates a new s.ServiceKey and returns the pointer to it.
-func (s *servicePD) SetServiceKey(v string) {
-	s.ServiceKey = &v
+// SetValue allocates a new t.Value and returns the pointer to it.
+func (t *TileDefMarker) SetValue(v string) {
+	t.Value = &v
 }
 
-// GetServiceName returns the ServiceName field if non-nil, zero value otherwise.
-func (s *servicePD) GetServiceName() string {
-	if s == nil || s.ServiceName == nil {
+// GetAggregator returns the Aggregator field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetAggregator() string {
+	if t == nil || t.Aggregator == nil {
 		return ""
 	}
-	return *s.ServiceName
+	return *t.Aggregator
 }
 
-// GetServiceNameOk returns a tuple with the ServiceName field if it's non-nil, zero value otherwise
+// GetAggregatorOk returns a tuple with the Aggregator field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *servicePD) GetServiceNameOk() (string, bool) {
-	if s == nil || s.ServiceName == nil {
+func (t *TileDefRequest) GetAggregatorOk() (string, bool) {
+	if t == nil || t.Aggregator == nil {
 		return "", false
 	}
-	return *s.ServiceName, true
+	return *t.Aggregator, true
 }
 
-// HasServiceName returns a boolean if a field has been set.
-func (s *servicePD) HasServiceName() bool {
-	if s != nil && s.ServiceName != nil {
+// HasAggregator returns a boolean if a field has been set.
+func (t *TileDefRequest) HasAggregator() bool {
+	if t != nil && t.Aggregator != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetServiceName allocates a new s.ServiceName and returns the pointer to it.
-func (s *servicePD) SetServiceName(v string) {
-	s.ServiceName = &v
+// SetAggregator allocates a new t.Aggregator and returns the pointer to it.
+func (t *TileDefRequest) SetAggregator(v string) {
+	t.Aggregator = &v
 }
 
-// GetServiceKey returns the ServiceKey field if non-nil, zero value otherwise.
-func (s *ServicePDRequest) GetServiceKey() string {
-	if s == nil || s.ServiceKey == nil {
+// GetChangeType returns the ChangeType field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetChangeType() string {
+	if t == nil || t.ChangeType == nil {
 		return ""
 	}
-	return *s.ServiceKey
+	return *t.ChangeType
 }
 
-// GetServiceKeyOk returns a tuple with the ServiceKey field if it's non-nil, zero value otherwise
+// GetChangeTypeOk returns a tuple with the ChangeType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ServicePDRequest) GetServiceKeyOk() (string, bool) {
-	if s == nil || s.ServiceKey == nil {
+func (t *TileDefRequest) GetChangeTypeOk() (string, bool) {
+	if t == nil || t.ChangeType == nil {
 		return "", false
 	}
-	return *s.ServiceKey, true
+	return *t.ChangeType, true
 }
 
-// HasServiceKey returns a boolean if a field has been set.
-func (s *ServicePDRequest) HasServiceKey() bool {
-	if s != nil && s.ServiceKey != nil {
+// HasChangeType returns a boolean if a field has been set.
+func (t *TileDefRequest) HasChangeType() bool {
+	if t != nil && t.ChangeType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetServiceKey allocates a new s.ServiceKey and returns the pointer to it.
-func (s *ServicePDRequest) SetServiceKey(v string) {
-	s.ServiceKey = &v
+// SetChangeType allocates a new t.ChangeType and returns the pointer to it.
+func (t *TileDefRequest) SetChangeType(v string) {
+	t.ChangeType = &v
 }
 
-// GetServiceName returns the ServiceName field if non-nil, zero value otherwise.
-func (s *ServicePDRequest) GetServiceName() string {
-	if s == nil || s.ServiceName == nil {
+// GetCompareTo returns the CompareTo field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetCompareTo() string {
+	if t == nil || t.CompareTo == nil {
 		return ""
 	}
-	return *s.ServiceName
+	return *t.CompareTo
 }
 
-// GetServiceNameOk returns a tuple with the ServiceName field if it's non-nil, zero value otherwise
+// GetCompareToOk returns a tuple with the CompareTo field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *ServicePDRequest) GetServiceNameOk() (string, bool) {
-	if s == nil || s.ServiceName == nil {
+func (t *TileDefRequest) GetCompareToOk() (string, bool) {
+	if t == nil || t.CompareTo == nil {
 		return "", false
 	}
-	return *s.ServiceName, true
+	return *t.CompareTo, true
 }
 
-// HasServiceName returns a boolean if a field has been set.
-func (s *ServicePDRequest) HasServiceName() bool {
-	if s != nil && s.ServiceName != nil {
+// HasCompareTo returns a boolean if a field has been set.
+func (t *TileDefRequest) HasCompareTo() bool {
+	if t != nil && t.CompareTo != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetServiceName allocates a new s.ServiceName and returns the pointer to it.
-func (s *ServicePDRequest) SetServiceName(v string) {
-	s.ServiceName = &v
+// SetCompareTo allocates a new t.CompareTo and returns the pointer to it.
+func (t *TileDefRequest) SetCompareTo(v string) {
+	t.CompareTo = &v
 }
 
-// GetFillMax returns the FillMax field if non-nil, zero value otherwise.
-func (s *Style) GetFillMax() json.Number {
-	if s == nil || s.FillMax == nil {
+// GetExtraCol returns the ExtraCol field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetExtraCol() string {
+	if t == nil || t.ExtraCol == nil {
 		return ""
 	}
-	return *s.FillMax
+	return *t.ExtraCol
 }
 
-// GetFillMaxOk returns a tuple with the FillMax field if it's non-nil, zero value otherwise
+// GetExtraColOk returns a tuple with the ExtraCol field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Style) GetFillMaxOk() (json.Number, bool) {
-	if s == nil || s.FillMax == nil {
+func (t *TileDefRequest) GetExtraColOk() (string, bool) {
+	if t == nil || t.ExtraCol == nil {
 		return "", false
 	}
-	return *s.FillMax, true
+	return *t.ExtraCol, true
 }
 
-// HasFillMax returns a boolean if a field has been set.
-func (s *Style) HasFillMax() bool {
-	if s != nil && s.FillMax != nil {
+// HasExtraCol returns a boolean if a field has been set.
+func (t *TileDefRequest) HasExtraCol() bool {
+	if t != nil && t.ExtraCol != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetFillMax allocates a new s.FillMax and returns the pointer to it.
-func (s *Style) SetFillMax(v json.Number) {
-	s.FillMax = &v
+// SetExtraCol allocates a new t.ExtraCol and returns the pointer to it.
+func (t *TileDefRequest) SetExtraCol(v string) {
+	t.ExtraCol = &v
 }
 
-// GetFillMin returns the FillMin field if non-nil, zero value otherwise.
-func (s *Style) GetFillMin() json.Number {
-	if s == nil || s.FillMin == nil {
-		return ""
+// GetIncreaseGood returns the IncreaseGood field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetIncreaseGood() bool {
+	if t == nil || t.IncreaseGood == nil {
+		return false
 	}
-	return *s.FillMin
+	return *t.IncreaseGood
 }
 
-// GetFillMinOk returns a tuple with the FillMin field if it's non-nil, zero value otherwise
+// GetIncreaseGoodOk returns a tuple with the IncreaseGood field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Style) GetFillMinOk() (json.Number, bool) {
-	if s == nil || s.FillMin == nil {
-		return "", false
+func (t *TileDefRequest) GetIncreaseGoodOk() (bool, bool) {
+	if t == nil || t.IncreaseGood == nil {
+		return false, false
 	}
-	return *s.FillMin, true
+	return *t.IncreaseGood, true
 }
 
-// HasFillMin returns a boolean if a field has been set.
-func (s *Style) HasFillMin() bool {
-	if s != nil && s.FillMin != nil {
+// HasIncreaseGood returns a boolean if a field has been set.
+func (t *TileDefRequest) HasIncreaseGood() bool {
+	if t != nil && t.IncreaseGood != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetFillMin allocates a new s.FillMin and returns the pointer to it.
-func (s *Style) SetFillMin(v json.Number) {
-	s.FillMin = &v
+// SetIncreaseGood allocates a new t.IncreaseGood and returns the pointer to it.
+func (t *TileDefRequest) SetIncreaseGood(v bool) {
+	t.IncreaseGood = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (s *Style) GetPalette() string {
-	if s == nil || s.Palette == nil {
-		return ""
+// GetLimit returns the Limit field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetLimit() int {
+	if t == nil || t.Limit == nil {
+		return 0
 	}
-	return *s.Palette
+	return *t.Limit
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetLimitOk returns a tuple with the Limit field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Style) GetPaletteOk() (string, bool) {
-	if s == nil || s.Palette == nil {
-		return "", false
+func (t *TileDefRequest) GetLimitOk() (int, bool) {
+	if t == nil || t.Limit == nil {
+		return 0, false
 	}
-	return *s.Palette, true
+	return *t.Limit, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (s *Style) HasPalette() bool {
-	if s != nil && s.Palette != nil {
+// HasLimit returns a boolean if a field has been set.
+func (t *TileDefRequest) HasLimit() bool {
+	if t != nil && t.Limit != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new s.Palette and returns the pointer to it.
-func (s *Style) SetPalette(v string) {
-	s.Palette = &v
+// SetLimit allocates a new t.Limit and returns the pointer to it.
+func (t *TileDefRequest) SetLimit(v int) {
+	t.Limit = &v
 }
 
-// GetPaletteFlip returns the PaletteFlip field if non-nil, zero value otherwise.
-func (s *Style) GetPaletteFlip() bool {
-	if s == nil || s.PaletteFlip == nil {
-		return false
+// GetMetric returns the Metric field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetMetric() string {
+	if t == nil || t.Metric == nil {
+		return ""
 	}
-	return *s.PaletteFlip
+	return *t.Metric
 }
 
-// GetPaletteFlipOk returns a tuple with the PaletteFlip field if it's non-nil, zero value otherwise
+// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (s *Style) GetPaletteFlipOk() (bool, bool) {
-	if s == nil || s.PaletteFlip == nil {
-		return false, false
+func (t *TileDefRequest) GetMetricOk() (string, bool) {
+	if t == nil || t.Metric == nil {
+		return "", false
 	}
-	return *s.PaletteFlip, true
+	return *t.Metric, true
 }
 
-// HasPaletteFlip returns a boolean if a field has been set.
-func (s *Style) HasPaletteFlip() bool {
-	if s != nil && s.PaletteFlip != nil {
+// HasMetric returns a boolean if a field has been set.
+func (t *TileDefRequest) HasMetric() bool {
+	if t != nil && t.Metric != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPaletteFlip allocates a new s.PaletteFlip and returns the pointer to it.
-func (s *Style) SetPaletteFlip(v bool) {
-	s.PaletteFlip = &v
+// SetMetric allocates a new t.Metric and returns the pointer to it.
+func (t *TileDefRequest) SetMetric(v string) {
+	t.Metric = &v
 }
 
-// GetDefault returns the Default field if non-nil, zero value otherwise.
-func (t *TemplateVariable) GetDefault() string {
-	if t == nil || t.Default == nil {
+// GetOrderBy returns the OrderBy field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetOrderBy() string {
+	if t == nil || t.OrderBy == nil {
 		return ""
 	}
-	return *t.Default
+	return *t.OrderBy
 }
 
-// GetDefaultOk returns a tuple with the Default field if it's non-nil, zero value otherwise
+// GetOrderByOk returns a tuple with the OrderBy field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TemplateVariable) GetDefaultOk() (string, bool) {
-	if t == nil || t.Default == nil {
+func (t *TileDefRequest) GetOrderByOk() (string, bool) {
+	if t == nil || t.OrderBy == nil {
 		return "", false
 	}
-	return *t.Default, true
+	return *t.OrderBy, true
 }
 
-// HasDefault returns a boolean if a field has been set.
-func (t *TemplateVariable) HasDefault() bool {
-	if t != nil && t.Default != nil {
+// HasOrderBy returns a boolean if a field has been set.
+func (t *TileDefRequest) HasOrderBy() bool {
+	if t != nil && t.OrderBy != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDefault allocates a new t.Default and returns the pointer to it.
-func (t *TemplateVariable) SetDefault(v string) {
-	t.Default = &v
+// SetOrderBy allocates a new t.OrderBy and returns the pointer to it.
+func (t *TileDefRequest) SetOrderBy(v string) {
+	t.OrderBy = &v
 }
 
-// GetName returns the Name field if non-nil, zero value otherwise.
-func (t *TemplateVariable) GetName() string {
-	if t == nil || t.Name == nil {
+// GetOrderDir returns the OrderDir field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetOrderDir() string {
+	if t == nil || t.OrderDir == nil {
 		return ""
 	}
-	return *t.Name
+	return *t.OrderDir
 }
 
-// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
+// GetOrderDirOk returns a tuple with the OrderDir field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TemplateVariable) GetNameOk() (string, bool) {
-	if t == nil || t.Name == nil {
+func (t *TileDefRequest) GetOrderDirOk() (string, bool) {
+	if t == nil || t.OrderDir == nil {
 		return "", false
 	}
-	return *t.Name, true
+	return *t.OrderDir, true
 }
 
-// HasName returns a boolean if a field has been set.
-func (t *TemplateVariable) HasName() bool {
-	if t != nil && t.Name != nil {
+// HasOrderDir returns a boolean if a field has been set.
+func (t *TileDefRequest) HasOrderDir() bool {
+	if t != nil && t.OrderDir != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new t.Name and returns the pointer to it.
-func (t *TemplateVariable) SetName(v string) {
-	t.Name = &v
+// SetOrderDir allocates a new t.OrderDir and returns the pointer to it.
+func (t *TileDefRequest) SetOrderDir(v string) {
+	t.OrderDir = &v
 }
 
-// GetPrefix returns the Prefix field if non-nil, zero value otherwise.
-func (t *TemplateVariable) GetPrefix() string {
-	if t == nil || t.Prefix == nil {
+// GetQuery returns the Query field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetQuery() string {
+	if t == nil || t.Query == nil {
 		return ""
 	}
-	return *t.Prefix
+	return *t.Query
 }
 
-// GetPrefixOk returns a tuple with the Prefix field if it's non-nil, zero value otherwise
+// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TemplateVariable) GetPrefixOk() (string, bool) {
-	if t == nil || t.Prefix == nil {
+func (t *TileDefRequest) GetQueryOk() (string, bool) {
+	if t == nil || t.Query == nil {
 		return "", false
 	}
-	return *t.Prefix, true
+	return *t.Query, true
 }
 
-// HasPrefix returns a boolean if a field has been set.
-func (t *TemplateVariable) HasPrefix() bool {
-	if t != nil && t.Prefix != nil {
+// HasQuery returns a boolean if a field has been set.
+func (t *TileDefRequest) HasQuery() bool {
+	if t != nil && t.Query != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPrefix allocates a new t.Prefix and returns the pointer to it.
-func (t *TemplateVariable) SetPrefix(v string) {
-	t.Prefix = &v
+// SetQuery allocates a new t.Query and returns the pointer to it.
+func (t *TileDefRequest) SetQuery(v string) {
+	t.Query = &v
 }
 
-// GetCritical returns the Critical field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetCritical() json.Number {
-	if t == nil || t.Critical == nil {
+// GetQueryType returns the QueryType field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetQueryType() string {
+	if t == nil || t.QueryType == nil {
 		return ""
 	}
-	return *t.Critical
+	return *t.QueryType
 }
 
-// GetCriticalOk returns a tuple with the Critical field if it's non-nil, zero value otherwise
+// GetQueryTypeOk returns a tuple with the QueryType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetCriticalOk() (json.Number, bool) {
-	if t == nil || t.Critical == nil {
+func (t *TileDefRequest) GetQueryTypeOk() (string, bool) {
+	if t == nil || t.QueryType == nil {
 		return "", false
 	}
-	return *t.Critical, true
+	return *t.QueryType, true
 }
 
-// HasCritical returns a boolean if a field has been set.
-func (t *ThresholdCount) HasCritical() bool {
-	if t != nil && t.Critical != nil {
+// HasQueryType returns a boolean if a field has been set.
+func (t *TileDefRequest) HasQueryType() bool {
+	if t != nil && t.QueryType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCritical allocates a new t.Critical and returns the pointer to it.
-func (t *ThresholdCount) SetCritical(v json.Number) {
-	t.Critical = &v
+// SetQueryType allocates a new t.QueryType and returns the pointer to it.
+func (t *TileDefRequest) SetQueryType(v string) {
+	t.QueryType = &v
 }
 
-// GetCriticalRecovery returns the CriticalRecovery field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetCriticalRecovery() json.Number {
-	if t == nil || t.CriticalRecovery == nil {
-		return ""
+// GetStyle returns the Style field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetStyle() TileDefRequestStyle {
+	if t == nil || t.Style == nil {
+		return TileDefRequestStyle{}
 	}
-	return *t.CriticalRecovery
+	return *t.Style
 }
 
-// GetCriticalRecoveryOk returns a tuple with the CriticalRecovery field if it's non-nil, zero value otherwise
+// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetCriticalRecoveryOk() (json.Number, bool) {
-	if t == nil || t.CriticalRecovery == nil {
-		return "", false
+func (t *TileDefRequest) GetStyleOk() (TileDefRequestStyle, bool) {
+	if t == nil || t.Style == nil {
+		return TileDefRequestStyle{}, false
 	}
-	return *t.CriticalRecovery, true
+	return *t.Style, true
 }
 
-// HasCriticalRecovery returns a boolean if a field has been set.
-func (t *ThresholdCount) HasCriticalRecovery() bool {
-	if t != nil && t.CriticalRecovery != nil {
+// HasStyle returns a boolean if a field has been set.
+func (t *TileDefRequest) HasStyle() bool {
+	if t != nil && t.Style != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCriticalRecovery allocates a new t.CriticalRecovery and returns the pointer to it.
-func (t *ThresholdCount) SetCriticalRecovery(v json.Number) {
-	t.CriticalRecovery = &v
+// SetStyle allocates a new t.Style and returns the pointer to it.
+func (t *TileDefRequest) SetStyle(v TileDefRequestStyle) {
+	t.Style = &v
 }
 
-// GetOk returns the Ok field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetOk() json.Number {
-	if t == nil || t.Ok == nil {
+// GetTextFilter returns the TextFilter field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetTextFilter() string {
+	if t == nil || t.TextFilter == nil {
 		return ""
 	}
-	return *t.Ok
+	return *t.TextFilter
 }
 
-// GetOkOk returns a tuple with the Ok field if it's non-nil, zero value otherwise
+// GetTextFilterOk returns a tuple with the TextFilter field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetOkOk() (json.Number, bool) {
-	if t == nil || t.Ok == nil {
+func (t *TileDefRequest) GetTextFilterOk() (string, bool) {
+	if t == nil || t.TextFilter == nil {
 		return "", false
 	}
-	return *t.Ok, true
+	return *t.TextFilter, true
 }
 
-// HasOk returns a boolean if a field has been set.
-func (t *ThresholdCount) HasOk() bool {
-	if t != nil && t.Ok != nil {
+// HasTextFilter returns a boolean if a field has been set.
+func (t *TileDefRequest) HasTextFilter() bool {
+	if t != nil && t.TextFilter != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOk allocates a new t.Ok and returns the pointer to it.
-func (t *ThresholdCount) SetOk(v json.Number) {
-	t.Ok = &v
+// SetTextFilter allocates a new t.TextFilter and returns the pointer to it.
+func (t *TileDefRequest) SetTextFilter(v string) {
+	t.TextFilter = &v
 }
 
-// GetUnknown returns the Unknown field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetUnknown() json.Number {
-	if t == nil || t.Unknown == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (t *TileDefRequest) GetType() string {
+	if t == nil || t.Type == nil {
 		return ""
 	}
-	return *t.Unknown
+	return *t.Type
 }
 
-// GetUnknownOk returns a tuple with the Unknown field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetUnknownOk() (json.Number, bool) {
-	if t == nil || t.Unknown == nil {
+func (t *TileDefRequest) GetTypeOk() (string, bool) {
+	if t == nil || t.Type == nil {
 		return "", false
 	}
-	return *t.Unknown, true
+	return *t.Type, true
 }
 
-// HasUnknown returns a boolean if a field has been set.
-func (t *ThresholdCount) HasUnknown() bool {
-	if t != nil && t.Unknown != nil {
+// HasType returns a boolean if a field has been set.
+func (t *TileDefRequest) HasType() bool {
+	if t != nil && t.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetUnknown allocates a new t.Unknown and returns the pointer to it.
-func (t *ThresholdCount) SetUnknown(v json.Number) {
-	t.Unknown = &v
+// SetType allocates a new t.Type and returns the pointer to it.
+func (t *TileDefRequest) SetType(v string) {
+	t.Type = &v
 }
 
-// GetWarning returns the Warning field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetWarning() json.Number {
-	if t == nil || t.Warning == nil {
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (t *TileDefRequestStyle) GetPalette() string {
+	if t == nil || t.Palette == nil {
 		return ""
 	}
-	return *t.Warning
+	return *t.Palette
 }
 
-// GetWarningOk returns a tuple with the Warning field if it's non-nil, zero value otherwise
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetWarningOk() (json.Number, bool) {
-	if t == nil || t.Warning == nil {
+func (t *TileDefRequestStyle) GetPaletteOk() (string, bool) {
+	if t == nil || t.Palette == nil {
 		return "", false
 	}
-	return *t.Warning, true
+	return *t.Palette, true
 }
 
-// HasWarning returns a boolean if a field has been set.
-func (t *ThresholdCount) HasWarning() bool {
-	if t != nil && t.Warning != nil {
+// HasPalette returns a boolean if a field has been set.
+func (t *TileDefRequestStyle) HasPalette() bool {
+	if t != nil && t.Palette != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetWarning allocates a new t.Warning and returns the pointer to it.
-func (t *ThresholdCount) SetWarning(v json.Number) {
-	t.Warning = &v
+// SetPalette allocates a new t.Palette and returns the pointer to it.
+func (t *TileDefRequestStyle) SetPalette(v string) {
+	t.Palette = &v
 }
 
-// GetWarningRecovery returns the WarningRecovery field if non-nil, zero value otherwise.
-func (t *ThresholdCount) GetWarningRecovery() json.Number {
-	if t == nil || t.WarningRecovery == nil {
+// GetType returns the Type field if non-nil, zero value otherwise.
+func (t *TileDefRequestStyle) GetType() string {
+	if t == nil || t.Type == nil {
 		return ""
 	}
-	return *t.WarningRecovery
+	return *t.Type
 }
 
-// GetWarningRecoveryOk returns a tuple with the WarningRecovery field if it's non-nil, zero value otherwise
+// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdCount) GetWarningRecoveryOk() (json.Number, bool) {
-	if t == nil || t.WarningRecovery == nil {
+func (t *TileDefRequestStyle) GetTypeOk() (string, bool) {
+	if t == nil || t.Type == nil {
 		return "", false
 	}
-	return *t.WarningRecovery, true
+	return *t.Type, true
 }
 
-// HasWarningRecovery returns a boolean if a field has been set.
-func (t *ThresholdCount) HasWarningRecovery() bool {
-	if t != nil && t.WarningRecovery != nil {
+// HasType returns a boolean if a field has been set.
+func (t *TileDefRequestStyle) HasType() bool {
+	if t != nil && t.Type != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetWarningRecovery allocates a new t.WarningRecovery and returns the pointer to it.
-func (t *ThresholdCount) SetWarningRecovery(v json.Number) {
-	t.WarningRecovery = &v
+// SetType allocates a new t.Type and returns the pointer to it.
+func (t *TileDefRequestStyle) SetType(v string) {
+	t.Type = &v
 }
 
-// GetRecoveryWindow returns the RecoveryWindow field if non-nil, zero value otherwise.
-func (t *ThresholdWindows) GetRecoveryWindow() string {
-	if t == nil || t.RecoveryWindow == nil {
+// GetWidth returns the Width field if non-nil, zero value otherwise.
+func (t *TileDefRequestStyle) GetWidth() string {
+	if t == nil || t.Width == nil {
 		return ""
 	}
-	return *t.RecoveryWindow
+	return *t.Width
 }
 
-// GetRecoveryWindowOk returns a tuple with the RecoveryWindow field if it's non-nil, zero value otherwise
+// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdWindows) GetRecoveryWindowOk() (string, bool) {
-	if t == nil || t.RecoveryWindow == nil {
+func (t *TileDefRequestStyle) GetWidthOk() (string, bool) {
+	if t == nil || t.Width == nil {
 		return "", false
 	}
-	return *t.RecoveryWindow, true
+	return *t.Width, true
 }
 
-// HasRecoveryWindow returns a boolean if a field has been set.
-func (t *ThresholdWindows) HasRecoveryWindow() bool {
-	if t != nil && t.RecoveryWindow != nil {
+// HasWidth returns a boolean if a field has been set.
+func (t *TileDefRequestStyle) HasWidth() bool {
+	if t != nil && t.Width != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRecoveryWindow allocates a new t.RecoveryWindow and returns the pointer to it.
-func (t *ThresholdWindows) SetRecoveryWindow(v string) {
-	t.RecoveryWindow = &v
+// SetWidth allocates a new t.Width and returns the pointer to it.
+func (t *TileDefRequestStyle) SetWidth(v string) {
+	t.Width = &v
 }
 
-// GetTriggerWindow returns the TriggerWindow field if non-nil, zero value otherwise.
-func (t *ThresholdWindows) GetTriggerWindow() string {
-	if t == nil || t.TriggerWindow == nil {
+// GetFillMax returns the FillMax field if non-nil, zero value otherwise.
+func (t *TileDefStyle) GetFillMax() string {
+	if t == nil || t.FillMax == nil {
 		return ""
 	}
-	return *t.TriggerWindow
+	return *t.FillMax
 }
 
-// GetTriggerWindowOk returns a tuple with the TriggerWindow field if it's non-nil, zero value otherwise
+// GetFillMaxOk returns a tuple with the FillMax field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *ThresholdWindows) GetTriggerWindowOk() (string, bool) {
-	if t == nil || t.TriggerWindow == nil {
+func (t *TileDefStyle) GetFillMaxOk() (string, bool) {
+	if t == nil || t.FillMax == nil {
 		return "", false
 	}
-	return *t.TriggerWindow, true
+	return *t.FillMax, true
 }
 
-// HasTriggerWindow returns a boolean if a field has been set.
-func (t *ThresholdWindows) HasTriggerWindow() bool {
-	if t != nil && t.TriggerWindow != nil {
+// HasFillMax returns a boolean if a field has been set.
+func (t *TileDefStyle) HasFillMax() bool {
+	if t != nil && t.FillMax != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTriggerWindow allocates a new t.TriggerWindow and returns the pointer to it.
-func (t *ThresholdWindows) SetTriggerWindow(v string) {
-	t.TriggerWindow = &v
+// SetFillMax allocates a new t.FillMax and returns the pointer to it.
+func (t *TileDefStyle) SetFillMax(v string) {
+	t.FillMax = &v
 }
 
-// GetAutoscale returns the Autoscale field if non-nil, zero value otherwise.
-func (t *TileDef) GetAutoscale() bool {
-	if t == nil || t.Autoscale == nil {
-		return false
+// GetFillMin returns the FillMin field if non-nil, zero value otherwise.
+func (t *TileDefStyle) GetFillMin() string {
+	if t == nil || t.FillMin == nil {
+		return ""
 	}
-	return *t.Autoscale
+	return *t.FillMin
 }
 
-// GetAutoscaleOk returns a tuple with the Autoscale field if it's non-nil, zero value otherwise
+// GetFillMinOk returns a tuple with the FillMin field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetAutoscaleOk() (bool, bool) {
-	if t == nil || t.Autoscale == nil {
-		return false, false
+func (t *TileDefStyle) GetFillMinOk() (string, bool) {
+	if t == nil || t.FillMin == nil {
+		return "", false
 	}
-	return *t.Autoscale, true
+	return *t.FillMin, true
 }
 
-// HasAutoscale returns a boolean if a field has been set.
-func (t *TileDef) HasAutoscale() bool {
-	if t != nil && t.Autoscale != nil {
+// HasFillMin returns a boolean if a field has been set.
+func (t *TileDefStyle) HasFillMin() bool {
+	if t != nil && t.FillMin != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAutoscale allocates a new t.Autoscale and returns the pointer to it.
-func (t *TileDef) SetAutoscale(v bool) {
-	t.Autoscale = &v
+// SetFillMin allocates a new t.FillMin and returns the pointer to it.
+func (t *TileDefStyle) SetFillMin(v string) {
+	t.FillMin = &v
 }
 
-// GetCustomUnit returns the CustomUnit field if non-nil, zero value otherwise.
-func (t *TileDef) GetCustomUnit() string {
-	if t == nil || t.CustomUnit == nil {
+// GetPalette returns the Palette field if non-nil, zero value otherwise.
+func (t *TileDefStyle) GetPalette() string {
+	if t == nil || t.Palette == nil {
 		return ""
 	}
-	return *t.CustomUnit
+	return *t.Palette
 }
 
-// GetCustomUnitOk returns a tuple with the CustomUnit field if it's non-nil, zero value otherwise
+// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetCustomUnitOk() (string, bool) {
-	if t == nil || t.CustomUnit == nil {
+func (t *TileDefStyle) GetPaletteOk() (string, bool) {
+	if t == nil || t.Palette == nil {
 		return "", false
 	}
-	return *t.CustomUnit, true
+	return *t.Palette, true
 }
 
-// HasCustomUnit returns a boolean if a field has been set.
-func (t *TileDef) HasCustomUnit() bool {
-	if t != nil && t.CustomUnit != nil {
+// HasPalette returns a boolean if a field has been set.
+func (t *TileDefStyle) HasPalette() bool {
+	if t != nil && t.Palette != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCustomUnit allocates a new t.CustomUnit and returns the pointer to it.
-func (t *TileDef) SetCustomUnit(v string) {
-	t.CustomUnit = &v
+// SetPalette allocates a new t.Palette and returns the pointer to it.
+func (t *TileDefStyle) SetPalette(v string) {
+	t.Palette = &v
 }
 
-// GetNodeType returns the NodeType field if non-nil, zero value otherwise.
-func (t *TileDef) GetNodeType() string {
-	if t == nil || t.NodeType == nil {
+// GetPaletteFlip returns the PaletteFlip field if non-nil, zero value otherwise.
+func (t *TileDefStyle) GetPaletteFlip() string {
+	if t == nil || t.PaletteFlip == nil {
 		return ""
 	}
-	return *t.NodeType
+	return *t.PaletteFlip
 }
 
-// GetNodeTypeOk returns a tuple with the NodeType field if it's non-nil, zero value otherwise
+// GetPaletteFlipOk returns a tuple with the PaletteFlip field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetNodeTypeOk() (string, bool) {
-	if t == nil || t.NodeType == nil {
+func (t *TileDefStyle) GetPaletteFlipOk() (string, bool) {
+	if t == nil || t.PaletteFlip == nil {
 		return "", false
 	}
-	return *t.NodeType, true
+	return *t.PaletteFlip, true
 }
 
-// HasNodeType returns a boolean if a field has been set.
-func (t *TileDef) HasNodeType() bool {
-	if t != nil && t.NodeType != nil {
+// HasPaletteFlip returns a boolean if a field has been set.
+func (t *TileDefStyle) HasPaletteFlip() bool {
+	if t != nil && t.PaletteFlip != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNodeType allocates a new t.NodeType and returns the pointer to it.
-func (t *TileDef) SetNodeType(v string) {
-	t.NodeType = &v
+// SetPaletteFlip allocates a new t.PaletteFlip and returns the pointer to it.
+func (t *TileDefStyle) SetPaletteFlip(v string) {
+	t.PaletteFlip = &v
 }
 
-// GetNoGroupHosts returns the NoGroupHosts field if non-nil, zero value otherwise.
-func (t *TileDef) GetNoGroupHosts() bool {
-	if t == nil || t.NoGroupHosts == nil {
-		return false
+// GetLiveSpan returns the LiveSpan field if non-nil, zero value otherwise.
+func (t *Time) GetLiveSpan() string {
+	if t == nil || t.LiveSpan == nil {
+		return ""
 	}
-	return *t.NoGroupHosts
+	return *t.LiveSpan
 }
 
-// GetNoGroupHostsOk returns a tuple with the NoGroupHosts field if it's non-nil, zero value otherwise
+// GetLiveSpanOk returns a tuple with the LiveSpan field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetNoGroupHostsOk() (bool, bool) {
-	if t == nil || t.NoGroupHosts == nil {
-		return false, false
+func (t *Time) GetLiveSpanOk() (string, bool) {
+	if t == nil || t.LiveSpan == nil {
+		return "", false
 	}
-	return *t.NoGroupHosts, true
+	return *t.LiveSpan, true
 }
 
-// HasNoGroupHosts returns a boolean if a field has been set.
-func (t *TileDef) HasNoGroupHosts() bool {
-	if t != nil && t.NoGroupHosts != nil {
+// HasLiveSpan returns a boolean if a field has been set.
+func (t *Time) HasLiveSpan() bool {
+	if t != nil && t.LiveSpan != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNoGroupHosts allocates a new t.NoGroupHosts and returns the pointer to it.
-func (t *TileDef) SetNoGroupHosts(v bool) {
-	t.NoGroupHosts = &v
+// SetLiveSpan allocates a new t.LiveSpan and returns the pointer to it.
+func (t *Time) SetLiveSpan(v string) {
+	t.LiveSpan = &v
 }
 
-// GetNoMetricHosts returns the NoMetricHosts field if non-nil, zero value otherwise.
-func (t *TileDef) GetNoMetricHosts() bool {
-	if t == nil || t.NoMetricHosts == nil {
-		return false
+// GetFromTs returns the FromTs field if non-nil, zero value otherwise.
+func (t *TriggeringValue) GetFromTs() int {
+	if t == nil || t.FromTs == nil {
+		return 0
 	}
-	return *t.NoMetricHosts
+	return *t.FromTs
 }
 
-// GetNoMetricHostsOk returns a tuple with the NoMetricHosts field if it's non-nil, zero value otherwise
+// GetFromTsOk returns a tuple with the FromTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetNoMetricHostsOk() (bool, bool) {
-	if t == nil || t.NoMetricHosts == nil {
-		return false, false
+func (t *TriggeringValue) GetFromTsOk() (int, bool) {
+	if t == nil || t.FromTs == nil {
+		return 0, false
 	}
-	return *t.NoMetricHosts, true
+	return *t.FromTs, true
 }
 
-// HasNoMetricHosts returns a boolean if a field has been set.
-func (t *TileDef) HasNoMetricHosts() bool {
-	if t != nil && t.NoMetricHosts != nil {
+// HasFromTs returns a boolean if a field has been set.
+func (t *TriggeringValue) HasFromTs() bool {
+	if t != nil && t.FromTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetNoMetricHosts allocates a new t.NoMetricHosts and returns the pointer to it.
-func (t *TileDef) SetNoMetricHosts(v bool) {
-	t.NoMetricHosts = &v
+// SetFromTs allocates a new t.FromTs and returns the pointer to it.
+func (t *TriggeringValue) SetFromTs(v int) {
+	t.FromTs = &v
 }
 
-// GetPrecision returns the Precision field if non-nil, zero value otherwise.
-func (t *TileDef) GetPrecision() PrecisionT {
-	if t == nil || t.Precision == nil {
-		return ""
+// GetToTs returns the ToTs field if non-nil, zero value otherwise.
+func (t *TriggeringValue) GetToTs() int {
+	if t == nil || t.ToTs == nil {
+		return 0
 	}
-	return *t.Precision
+	return *t.ToTs
 }
 
-// GetPrecisionOk returns a tuple with the Precision field if it's non-nil, zero value otherwise
+// GetToTsOk returns a tuple with the ToTs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetPrecisionOk() (PrecisionT, bool) {
-	if t == nil || t.Precision == nil {
-		return "", false
+func (t *TriggeringValue) GetToTsOk() (int, bool) {
+	if t == nil || t.ToTs == nil {
+		return 0, false
 	}
-	return *t.Precision, true
+	return *t.ToTs, true
 }
 
-// HasPrecision returns a boolean if a field has been set.
-func (t *TileDef) HasPrecision() bool {
-	if t != nil && t.Precision != nil {
+// HasToTs returns a boolean if a field has been set.
+func (t *TriggeringValue) HasToTs() bool {
+	if t != nil && t.ToTs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPrecision allocates a new t.Precision and returns the pointer to it.
-func (t *TileDef) SetPrecision(v PrecisionT) {
-	t.Precision = &v
+// SetToTs allocates a new t.ToTs and returns the pointer to it.
+func (t *TriggeringValue) SetToTs(v int) {
+	t.ToTs = &v
 }
 
-// GetStyle returns the Style field if non-nil, zero value otherwise.
-func (t *TileDef) GetStyle() TileDefStyle {
-	if t == nil || t.Style == nil {
-		return TileDefStyle{}
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (t *TriggeringValue) GetValue() int {
+	if t == nil || t.Value == nil {
+		return 0
 	}
-	return *t.Style
+	return *t.Value
 }
 
-// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetStyleOk() (TileDefStyle, bool) {
-	if t == nil || t.Style == nil {
-		return TileDefStyle{}, false
+func (t *TriggeringValue) GetValueOk() (int, bool) {
+	if t == nil || t.Value == nil {
+		return 0, false
 	}
-	return *t.Style, true
+	return *t.Value, true
 }
 
-// HasStyle returns a boolean if a field has been set.
-func (t *TileDef) HasStyle() bool {
-	if t != nil && t.Style != nil {
+// HasValue returns a boolean if a field has been set.
+func (t *TriggeringValue) HasValue() bool {
+	if t != nil && t.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStyle allocates a new t.Style and returns the pointer to it.
-func (t *TileDef) SetStyle(v TileDefStyle) {
-	t.Style = &v
+// SetValue allocates a new t.Value and returns the pointer to it.
+func (t *TriggeringValue) SetValue(v int) {
+	t.Value = &v
 }
 
-// GetTextAlign returns the TextAlign field if non-nil, zero value otherwise.
-func (t *TileDef) GetTextAlign() string {
-	if t == nil || t.TextAlign == nil {
-		return ""
+// GetMetadata returns the Metadata field if non-nil, zero value otherwise.
+func (u *UsageAttribution) GetMetadata() UsageAttributionMetadata {
+	if u == nil || u.Metadata == nil {
+		return UsageAttributionMetadata{}
 	}
-	return *t.TextAlign
+	return *u.Metadata
 }
 
-// GetTextAlignOk returns a tuple with the TextAlign field if it's non-nil, zero value otherwise
+// GetMetadataOk returns a tuple with the Metadata field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetTextAlignOk() (string, bool) {
-	if t == nil || t.TextAlign == nil {
-		return "", false
+func (u *UsageAttribution) GetMetadataOk() (UsageAttributionMetadata, bool) {
+	if u == nil || u.Metadata == nil {
+		return UsageAttributionMetadata{}, false
 	}
-	return *t.TextAlign, true
+	return *u.Metadata, true
 }
 
-// HasTextAlign returns a boolean if a field has been set.
-func (t *TileDef) HasTextAlign() bool {
-	if t != nil && t.TextAlign != nil {
+// HasMetadata returns a boolean if a field has been set.
+func (u *UsageAttribution) HasMetadata() bool {
+	if u != nil && u.Metadata != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTextAlign allocates a new t.TextAlign and returns the pointer to it.
-func (t *TileDef) SetTextAlign(v string) {
-	t.TextAlign = &v
+// SetMetadata allocates a new u.Metadata and returns the pointer to it.
+func (u *UsageAttribution) SetMetadata(v UsageAttributionMetadata) {
+	u.Metadata = &v
 }
-
-// GetViz returns the Viz field if non-nil, zero value otherwise.
-func (t *TileDef) GetViz() string {
-	if t == nil || t.Viz == nil {
+
+// GetAggType returns the AggType field if non-nil, zero value otherwise.
+func (u *UsageAttributionAggregate) GetAggType() string {
+	if u == nil || u.AggType == nil {
 		return ""
 	}
-	return *t.Viz
+	return *u.AggType
 }
 
-// GetVizOk returns a tuple with the Viz field if it's non-nil, zero value otherwise
+// GetAggTypeOk returns a tuple with the AggType field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDef) GetVizOk() (string, bool) {
-	if t == nil || t.Viz == nil {
+func (u *UsageAttributionAggregate) GetAggTypeOk() (string, bool) {
+	if u == nil || u.AggType == nil {
 		return "", false
 	}
-	return *t.Viz, true
+	return *u.AggType, true
 }
 
-// HasViz returns a boolean if a field has been set.
-func (t *TileDef) HasViz() bool {
-	if t != nil && t.Viz != nil {
+// HasAggType returns a boolean if a field has been set.
+func (u *UsageAttributionAggregate) HasAggType() bool {
+	if u != nil && u.AggType != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetViz allocates a new t.Viz and returns the pointer to it.
-func (t *TileDef) SetViz(v string) {
-	t.Viz = &v
+// SetAggType allocates a new u.AggType and returns the pointer to it.
+func (u *UsageAttributionAggregate) SetAggType(v string) {
+	u.AggType = &v
 }
 
-// GetQuery returns the Query field if non-nil, zero value otherwise.
-func (t *TileDefEvent) GetQuery() string {
-	if t == nil || t.Query == nil {
+// GetField returns the Field field if non-nil, zero value otherwise.
+func (u *UsageAttributionAggregate) GetField() string {
+	if u == nil || u.Field == nil {
 		return ""
 	}
-	return *t.Query
+	return *u.Field
 }
 
-// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// GetFieldOk returns a tuple with the Field field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefEvent) GetQueryOk() (string, bool) {
-	if t == nil || t.Query == nil {
+func (u *UsageAttributionAggregate) GetFieldOk() (string, bool) {
+	if u == nil || u.Field == nil {
 		return "", false
 	}
-	return *t.Query, true
+	return *u.Field, true
 }
 
-// HasQuery returns a boolean if a field has been set.
-func (t *TileDefEvent) HasQuery() bool {
-	if t != nil && t.Query != nil {
+// HasField returns a boolean if a field has been set.
+func (u *UsageAttributionAggregate) HasField() bool {
+	if u != nil && u.Field != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQuery allocates a new t.Query and returns the pointer to it.
-func (t *TileDefEvent) SetQuery(v string) {
-	t.Query = &v
+// SetField allocates a new u.Field and returns the pointer to it.
+func (u *UsageAttributionAggregate) SetField(v string) {
+	u.Field = &v
 }
 
-// GetLabel returns the Label field if non-nil, zero value otherwise.
-func (t *TileDefMarker) GetLabel() string {
-	if t == nil || t.Label == nil {
-		return ""
+// GetValue returns the Value field if non-nil, zero value otherwise.
+func (u *UsageAttributionAggregate) GetValue() float64 {
+	if u == nil || u.Value == nil {
+		return 0
 	}
-	return *t.Label
+	return *u.Value
 }
 
-// GetLabelOk returns a tuple with the Label field if it's non-nil, zero value otherwise
+// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefMarker) GetLabelOk() (string, bool) {
-	if t == nil || t.Label == nil {
-		return "", false
+func (u *UsageAttributionAggregate) GetValueOk() (float64, bool) {
+	if u == nil || u.Value == nil {
+		return 0, false
 	}
-	return *t.Label, true
+	return *u.Value, true
 }
 
-// HasLabel returns a boolean if a field has been set.
-func (t *TileDefMarker) HasLabel() bool {
-	if t != nil && t.Label != nil {
+// HasValue returns a boolean if a field has been set.
+func (u *UsageAttributionAggregate) HasValue() bool {
+	if u != nil && u.Value != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLabel allocates a new t.Label and returns the pointer to it.
-func (t *TileDefMarker) SetLabel(v string) {
-	t.Label = &v
+// SetValue allocates a new u.Value and returns the pointer to it.
+func (u *UsageAttributionAggregate) SetValue(v float64) {
+	u.Value = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (t *TileDefMarker) GetType() string {
-	if t == nil || t.Type == nil {
+// GetMonth returns the Month field if non-nil, zero value otherwise.
+func (u *UsageAttributionBody) GetMonth() string {
+	if u == nil || u.Month == nil {
 		return ""
 	}
-	return *t.Type
+	return *u.Month
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetMonthOk returns a tuple with the Month field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefMarker) GetTypeOk() (string, bool) {
-	if t == nil || t.Type == nil {
+func (u *UsageAttributionBody) GetMonthOk() (string, bool) {
+	if u == nil || u.Month == nil {
 		return "", false
 	}
-	return *t.Type, true
+	return *u.Month, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (t *TileDefMarker) HasType() bool {
-	if t != nil && t.Type != nil {
+// HasMonth returns a boolean if a field has been set.
+func (u *UsageAttributionBody) HasMonth() bool {
+	if u != nil && u.Month != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new t.Type and returns the pointer to it.
-func (t *TileDefMarker) SetType(v string) {
-	t.Type = &v
+// SetMonth allocates a new u.Month and returns the pointer to it.
+func (u *UsageAttributionBody) SetMonth(v string) {
+	u.Month = &v
 }
 
-// GetValue returns the Value field if non-nil, zero value otherwise.
-func (t *TileDefMarker) GetValue() string {
-	if t == nil || t.Value == nil {
+// GetOrgName returns the OrgName field if non-nil, zero value otherwise.
+func (u *UsageAttributionBody) GetOrgName() string {
+	if u == nil || u.OrgName == nil {
 		return ""
 	}
-	return *t.Value
+	return *u.OrgName
 }
 
-// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
+// GetOrgNameOk returns a tuple with the OrgName field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefMarker) GetValueOk() (string, bool) {
-	if t == nil || t.Value == nil {
+func (u *UsageAttributionBody) GetOrgNameOk() (string, bool) {
+	if u == nil || u.OrgName == nil {
 		return "", false
 	}
-	return *t.Value, true
+	return *u.OrgName, true
 }
 
-// HasValue returns a boolean if a field has been set.
-func (t *TileDefMarker) HasValue() bool {
-	if t != nil && t.Value != nil {
+// HasOrgName returns a boolean if a field has been set.
+func (u *UsageAttributionBody) HasOrgName() bool {
+	if u != nil && u.OrgName != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetValue allocates a new t.Value and returns the pointer to it.
-func (t *TileDefMarker) SetValue(v string) {
-	t.Value = &v
+// SetOrgName allocates a new u.OrgName and returns the pointer to it.
+func (u *UsageAttributionBody) SetOrgName(v string) {
+	u.OrgName = &v
 }
 
-// GetAggregator returns the Aggregator field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetAggregator() string {
-	if t == nil || t.Aggregator == nil {
+// GetPublicId returns the PublicId field if non-nil, zero value otherwise.
+func (u *UsageAttributionBody) GetPublicId() string {
+	if u == nil || u.PublicId == nil {
 		return ""
 	}
-	return *t.Aggregator
+	return *u.PublicId
 }
 
-// GetAggregatorOk returns a tuple with the Aggregator field if it's non-nil, zero value otherwise
+// GetPublicIdOk returns a tuple with the PublicId field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetAggregatorOk() (string, bool) {
-	if t == nil || t.Aggregator == nil {
+func (u *UsageAttributionBody) GetPublicIdOk() (string, bool) {
+	if u == nil || u.PublicId == nil {
 		return "", false
 	}
-	return *t.Aggregator, true
+	return *u.PublicId, true
 }
 
-// HasAggregator returns a boolean if a field has been set.
-func (t *TileDefRequest) HasAggregator() bool {
-	if t != nil && t.Aggregator != nil {
+// HasPublicId returns a boolean if a field has been set.
+func (u *UsageAttributionBody) HasPublicId() bool {
+	if u != nil && u.PublicId != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAggregator allocates a new t.Aggregator and returns the pointer to it.
-func (t *TileDefRequest) SetAggregator(v string) {
-	t.Aggregator = &v
+// SetPublicId allocates a new u.PublicId and returns the pointer to it.
+func (u *UsageAttributionBody) SetPublicId(v string) {
+	u.PublicId = &v
 }
 
-// GetChangeType returns the ChangeType field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetChangeType() string {
-	if t == nil || t.ChangeType == nil {
+// GetTagConfigSource returns the TagConfigSource field if non-nil, zero value otherwise.
+func (u *UsageAttributionBody) GetTagConfigSource() string {
+	if u == nil || u.TagConfigSource == nil {
 		return ""
 	}
-	return *t.ChangeType
+	return *u.TagConfigSource
 }
 
-// GetChangeTypeOk returns a tuple with the ChangeType field if it's non-nil, zero value otherwise
+// GetTagConfigSourceOk returns a tuple with the TagConfigSource field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetChangeTypeOk() (string, bool) {
-	if t == nil || t.ChangeType == nil {
+func (u *UsageAttributionBody) GetTagConfigSourceOk() (string, bool) {
+	if u == nil || u.TagConfigSource == nil {
 		return "", false
 	}
-	return *t.ChangeType, true
+	return *u.TagConfigSource, true
 }
 
-// HasChangeType returns a boolean if a field has been set.
-func (t *TileDefRequest) HasChangeType() bool {
-	if t != nil && t.ChangeType != nil {
+// HasTagConfigSource returns a boolean if a field has been set.
+func (u *UsageAttributionBody) HasTagConfigSource() bool {
+	if u != nil && u.TagConfigSource != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetChangeType allocates a new t.ChangeType and returns the pointer to it.
-func (t *TileDefRequest) SetChangeType(v string) {
-	t.ChangeType = &v
+// SetTagConfigSource allocates a new u.TagConfigSource and returns the pointer to it.
+func (u *UsageAttributionBody) SetTagConfigSource(v string) {
+	u.TagConfigSource = &v
 }
 
-// GetCompareTo returns the CompareTo field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetCompareTo() string {
-	if t == nil || t.CompareTo == nil {
+// GetUpdatedAt returns the UpdatedAt field if non-nil, zero value otherwise.
+func (u *UsageAttributionBody) GetUpdatedAt() string {
+	if u == nil || u.UpdatedAt == nil {
 		return ""
 	}
-	return *t.CompareTo
+	return *u.UpdatedAt
 }
 
-// GetCompareToOk returns a tuple with the CompareTo field if it's non-nil, zero value otherwise
+// GetUpdatedAtOk returns a tuple with the UpdatedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetCompareToOk() (string, bool) {
-	if t == nil || t.CompareTo == nil {
+func (u *UsageAttributionBody) GetUpdatedAtOk() (string, bool) {
+	if u == nil || u.UpdatedAt == nil {
 		return "", false
 	}
-	return *t.CompareTo, true
+	return *u.UpdatedAt, true
 }
 
-// HasCompareTo returns a boolean if a field has been set.
-func (t *TileDefRequest) HasCompareTo() bool {
-	if t != nil && t.CompareTo != nil {
+// HasUpdatedAt returns a boolean if a field has been set.
+func (u *UsageAttributionBody) HasUpdatedAt() bool {
+	if u != nil && u.UpdatedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetCompareTo allocates a new t.CompareTo and returns the pointer to it.
-func (t *TileDefRequest) SetCompareTo(v string) {
-	t.CompareTo = &v
+// SetUpdatedAt allocates a new u.UpdatedAt and returns the pointer to it.
+func (u *UsageAttributionBody) SetUpdatedAt(v string) {
+	u.UpdatedAt = &v
 }
 
-// GetExtraCol returns the ExtraCol field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetExtraCol() string {
-	if t == nil || t.ExtraCol == nil {
-		return ""
+// GetPagination returns the Pagination field if non-nil, zero value otherwise.
+func (u *UsageAttributionMetadata) GetPagination() UsageAttributionPagination {
+	if u == nil || u.Pagination == nil {
+		return UsageAttributionPagination{}
 	}
-	return *t.ExtraCol
+	return *u.Pagination
 }
 
-// GetExtraColOk returns a tuple with the ExtraCol field if it's non-nil, zero value otherwise
+// GetPaginationOk returns a tuple with the Pagination field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetExtraColOk() (string, bool) {
-	if t == nil || t.ExtraCol == nil {
-		return "", false
+func (u *UsageAttributionMetadata) GetPaginationOk() (UsageAttributionPagination, bool) {
+	if u == nil || u.Pagination == nil {
+		return UsageAttributionPagination{}, false
 	}
-	return *t.ExtraCol, true
+	return *u.Pagination, true
 }
 
-// HasExtraCol returns a boolean if a field has been set.
-func (t *TileDefRequest) HasExtraCol() bool {
-	if t != nil && t.ExtraCol != nil {
+// HasPagination returns a boolean if a field has been set.
+func (u *UsageAttributionMetadata) HasPagination() bool {
+	if u != nil && u.Pagination != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetExtraCol allocates a new t.ExtraCol and returns the pointer to it.
-func (t *TileDefRequest) SetExtraCol(v string) {
-	t.ExtraCol = &v
+// SetPagination allocates a new u.Pagination and returns the pointer to it.
+func (u *UsageAttributionMetadata) SetPagination(v UsageAttributionPagination) {
+	u.Pagination = &v
 }
 
-// GetIncreaseGood returns the IncreaseGood field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetIncreaseGood() bool {
-	if t == nil || t.IncreaseGood == nil {
-		return false
+// GetLimit returns the Limit field if non-nil, zero value otherwise.
+func (u *UsageAttributionPagination) GetLimit() int {
+	if u == nil || u.Limit == nil {
+		return 0
 	}
-	return *t.IncreaseGood
+	return *u.Limit
 }
 
-// GetIncreaseGoodOk returns a tuple with the IncreaseGood field if it's non-nil, zero value otherwise
+// GetLimitOk returns a tuple with the Limit field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetIncreaseGoodOk() (bool, bool) {
-	if t == nil || t.IncreaseGood == nil {
-		return false, false
+func (u *UsageAttributionPagination) GetLimitOk() (int, bool) {
+	if u == nil || u.Limit == nil {
+		return 0, false
 	}
-	return *t.IncreaseGood, true
+	return *u.Limit, true
 }
 
-// HasIncreaseGood returns a boolean if a field has been set.
-func (t *TileDefRequest) HasIncreaseGood() bool {
-	if t != nil && t.IncreaseGood != nil {
+// HasLimit returns a boolean if a field has been set.
+func (u *UsageAttributionPagination) HasLimit() bool {
+	if u != nil && u.Limit != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIncreaseGood allocates a new t.IncreaseGood and returns the pointer to it.
-func (t *TileDefRequest) SetIncreaseGood(v bool) {
-	t.IncreaseGood = &v
+// SetLimit allocates a new u.Limit and returns the pointer to it.
+func (u *UsageAttributionPagination) SetLimit(v int) {
+	u.Limit = &v
 }
 
-// GetLimit returns the Limit field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetLimit() int {
-	if t == nil || t.Limit == nil {
+// GetOffset returns the Offset field if non-nil, zero value otherwise.
+func (u *UsageAttributionPagination) GetOffset() int {
+	if u == nil || u.Offset == nil {
 		return 0
 	}
-	return *t.Limit
+	return *u.Offset
 }
 
-// GetLimitOk returns a tuple with the Limit field if it's non-nil, zero value otherwise
+// GetOffsetOk returns a tuple with the Offset field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetLimitOk() (int, bool) {
-	if t == nil || t.Limit == nil {
+func (u *UsageAttributionPagination) GetOffsetOk() (int, bool) {
+	if u == nil || u.Offset == nil {
 		return 0, false
 	}
-	return *t.Limit, true
+	return *u.Offset, true
 }
 
-// HasLimit returns a boolean if a field has been set.
-func (t *TileDefRequest) HasLimit() bool {
-	if t != nil && t.Limit != nil {
+// HasOffset returns a boolean if a field has been set.
+func (u *UsageAttributionPagination) HasOffset() bool {
+	if u != nil && u.Offset != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLimit allocates a new t.Limit and returns the pointer to it.
-func (t *TileDefRequest) SetLimit(v int) {
-	t.Limit = &v
+// SetOffset allocates a new u.Offset and returns the pointer to it.
+func (u *UsageAttributionPagination) SetOffset(v int) {
+	u.Offset = &v
 }
 
-// GetMetric returns the Metric field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetMetric() string {
-	if t == nil || t.Metric == nil {
-		return ""
+// GetTotalNumberOfRecords returns the TotalNumberOfRecords field if non-nil, zero value otherwise.
+func (u *UsageAttributionPagination) GetTotalNumberOfRecords() int {
+	if u == nil || u.TotalNumberOfRecords == nil {
+		return 0
 	}
-	return *t.Metric
+	return *u.TotalNumberOfRecords
 }
 
-// GetMetricOk returns a tuple with the Metric field if it's non-nil, zero value otherwise
+// GetTotalNumberOfRecordsOk returns a tuple with the TotalNumberOfRecords field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetMetricOk() (string, bool) {
-	if t == nil || t.Metric == nil {
-		return "", false
+func (u *UsageAttributionPagination) GetTotalNumberOfRecordsOk() (int, bool) {
+	if u == nil || u.TotalNumberOfRecords == nil {
+		return 0, false
 	}
-	return *t.Metric, true
+	return *u.TotalNumberOfRecords, true
 }
 
-// HasMetric returns a boolean if a field has been set.
-func (t *TileDefRequest) HasMetric() bool {
-	if t != nil && t.Metric != nil {
+// HasTotalNumberOfRecords returns a boolean if a field has been set.
+func (u *UsageAttributionPagination) HasTotalNumberOfRecords() bool {
+	if u != nil && u.TotalNumberOfRecords != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetMetric allocates a new t.Metric and returns the pointer to it.
-func (t *TileDefRequest) SetMetric(v string) {
-	t.Metric = &v
+// SetTotalNumberOfRecords allocates a new u.TotalNumberOfRecords and returns the pointer to it.
+func (u *UsageAttributionPagination) SetTotalNumberOfRecords(v int) {
+	u.TotalNumberOfRecords = &v
 }
 
-// GetOrderBy returns the OrderBy field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetOrderBy() string {
-	if t == nil || t.OrderBy == nil {
+// GetAccessRole returns the AccessRole field if non-nil, zero value otherwise.
+func (u *User) GetAccessRole() string {
+	if u == nil || u.AccessRole == nil {
 		return ""
 	}
-	return *t.OrderBy
+	return *u.AccessRole
 }
 
-// GetOrderByOk returns a tuple with the OrderBy field if it's non-nil, zero value otherwise
+// GetAccessRoleOk returns a tuple with the AccessRole field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetOrderByOk() (string, bool) {
-	if t == nil || t.OrderBy == nil {
+func (u *User) GetAccessRoleOk() (string, bool) {
+	if u == nil || u.AccessRole == nil {
 		return "", false
 	}
-	return *t.OrderBy, true
+	return *u.AccessRole, true
 }
 
-// HasOrderBy returns a boolean if a field has been set.
-func (t *TileDefRequest) HasOrderBy() bool {
-	if t != nil && t.OrderBy != nil {
+// HasAccessRole returns a boolean if a field has been set.
+func (u *User) HasAccessRole() bool {
+	if u != nil && u.AccessRole != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOrderBy allocates a new t.OrderBy and returns the pointer to it.
-func (t *TileDefRequest) SetOrderBy(v string) {
-	t.OrderBy = &v
+// SetAccessRole allocates a new u.AccessRole and returns the pointer to it.
+func (u *User) SetAccessRole(v string) {
+	u.AccessRole = &v
 }
 
-// GetOrderDir returns the OrderDir field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetOrderDir() string {
-	if t == nil || t.OrderDir == nil {
-		return ""
+// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
+func (u *User) GetDisabled() bool {
+	if u == nil || u.Disabled == nil {
+		return false
 	}
-	return *t.OrderDir
+	return *u.Disabled
 }
 
-// GetOrderDirOk returns a tuple with the OrderDir field if it's non-nil, zero value otherwise
+// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetOrderDirOk() (string, bool) {
-	if t == nil || t.OrderDir == nil {
-		return "", false
+func (u *User) GetDisabledOk() (bool, bool) {
+	if u == nil || u.Disabled == nil {
+		return false, false
 	}
-	return *t.OrderDir, true
+	return *u.Disabled, true
 }
 
-// HasOrderDir returns a boolean if a field has been set.
-func (t *TileDefRequest) HasOrderDir() bool {
-	if t != nil && t.OrderDir != nil {
+// HasDisabled returns a boolean if a field has been set.
+func (u *User) HasDisabled() bool {
+	if u != nil && u.Disabled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetOrderDir allocates a new t.OrderDir and returns the pointer to it.
-func (t *TileDefRequest) SetOrderDir(v string) {
-	t.OrderDir = &v
+// SetDisabled allocates a new u.Disabled and returns the pointer to it.
+func (u *User) SetDisabled(v bool) {
+	u.Disabled = &v
 }
 
-// GetQuery returns the Query field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetQuery() string {
-	if t == nil || t.Query == nil {
+// GetEmail returns the Email field if non-nil, zero value otherwise.
+func (u *User) GetEmail() string {
+	if u == nil || u.Email == nil {
 		return ""
 	}
-	return *t.Query
+	return *u.Email
 }
 
-// GetQueryOk returns a tuple with the Query field if it's non-nil, zero value otherwise
+// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetQueryOk() (string, bool) {
-	if t == nil || t.Query == nil {
+func (u *User) GetEmailOk() (string, bool) {
+	if u == nil || u.Email == nil {
 		return "", false
 	}
-	return *t.Query, true
+	return *u.Email, true
 }
 
-// HasQuery returns a boolean if a field has been set.
-func (t *TileDefRequest) HasQuery() bool {
-	if t != nil && t.Query != nil {
+// HasEmail returns a boolean if a field has been set.
+func (u *User) HasEmail() bool {
+	if u != nil && u.Email != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQuery allocates a new t.Query and returns the pointer to it.
-func (t *TileDefRequest) SetQuery(v string) {
-	t.Query = &v
+// SetEmail allocates a new u.Email and returns the pointer to it.
+func (u *User) SetEmail(v string) {
+	u.Email = &v
 }
 
-// GetQueryType returns the QueryType field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetQueryType() string {
-	if t == nil || t.QueryType == nil {
+// GetHandle returns the Handle field if non-nil, zero value otherwise.
+func (u *User) GetHandle() string {
+	if u == nil || u.Handle == nil {
 		return ""
 	}
-	return *t.QueryType
+	return *u.Handle
 }
 
-// GetQueryTypeOk returns a tuple with the QueryType field if it's non-nil, zero value otherwise
+// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetQueryTypeOk() (string, bool) {
-	if t == nil || t.QueryType == nil {
+func (u *User) GetHandleOk() (string, bool) {
+	if u == nil || u.Handle == nil {
 		return "", false
 	}
-	return *t.QueryType, true
+	return *u.Handle, true
 }
 
-// HasQueryType returns a boolean if a field has been set.
-func (t *TileDefRequest) HasQueryType() bool {
-	if t != nil && t.QueryType != nil {
+// HasHandle returns a boolean if a field has been set.
+func (u *User) HasHandle() bool {
+	if u != nil && u.Handle != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetQueryType allocates a new t.QueryType and returns the pointer to it.
-func (t *TileDefRequest) SetQueryType(v string) {
-	t.QueryType = &v
+// SetHandle allocates a new u.Handle and returns the pointer to it.
+func (u *User) SetHandle(v string) {
+	u.Handle = &v
 }
 
-// GetStyle returns the Style field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetStyle() TileDefRequestStyle {
-	if t == nil || t.Style == nil {
-		return TileDefRequestStyle{}
+// GetIsAdmin returns the IsAdmin field if non-nil, zero value otherwise.
+func (u *User) GetIsAdmin() bool {
+	if u == nil || u.IsAdmin == nil {
+		return false
 	}
-	return *t.Style
+	return *u.IsAdmin
 }
 
-// GetStyleOk returns a tuple with the Style field if it's non-nil, zero value otherwise
+// GetIsAdminOk returns a tuple with the IsAdmin field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetStyleOk() (TileDefRequestStyle, bool) {
-	if t == nil || t.Style == nil {
-		return TileDefRequestStyle{}, false
+func (u *User) GetIsAdminOk() (bool, bool) {
+	if u == nil || u.IsAdmin == nil {
+		return false, false
 	}
-	return *t.Style, true
+	return *u.IsAdmin, true
 }
 
-// HasStyle returns a boolean if a field has been set.
-func (t *TileDefRequest) HasStyle() bool {
-	if t != nil && t.Style != nil {
+// HasIsAdmin returns a boolean if a field has been set.
+func (u *User) HasIsAdmin() bool {
+	if u != nil && u.IsAdmin != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetStyle allocates a new t.Style and returns the pointer to it.
-func (t *TileDefRequest) SetStyle(v TileDefRequestStyle) {
-	t.Style = &v
+// SetIsAdmin allocates a new u.IsAdmin and returns the pointer to it.
+func (u *User) SetIsAdmin(v bool) {
+	u.IsAdmin = &v
 }
 
-// GetTextFilter returns the TextFilter field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetTextFilter() string {
-	if t == nil || t.TextFilter == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (u *User) GetName() string {
+	if u == nil || u.Name == nil {
 		return ""
 	}
-	return *t.TextFilter
+	return *u.Name
 }
 
-// GetTextFilterOk returns a tuple with the TextFilter field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetTextFilterOk() (string, bool) {
-	if t == nil || t.TextFilter == nil {
+func (u *User) GetNameOk() (string, bool) {
+	if u == nil || u.Name == nil {
 		return "", false
 	}
-	return *t.TextFilter, true
+	return *u.Name, true
 }
 
-// HasTextFilter returns a boolean if a field has been set.
-func (t *TileDefRequest) HasTextFilter() bool {
-	if t != nil && t.TextFilter != nil {
+// HasName returns a boolean if a field has been set.
+func (u *User) HasName() bool {
+	if u != nil && u.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetTextFilter allocates a new t.TextFilter and returns the pointer to it.
-func (t *TileDefRequest) SetTextFilter(v string) {
-	t.TextFilter = &v
+// SetName allocates a new u.Name and returns the pointer to it.
+func (u *User) SetName(v string) {
+	u.Name = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (t *TileDefRequest) GetType() string {
-	if t == nil || t.Type == nil {
+// GetRole returns the Role field if non-nil, zero value otherwise.
+func (u *User) GetRole() string {
+	if u == nil || u.Role == nil {
 		return ""
 	}
-	return *t.Type
+	return *u.Role
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetRoleOk returns a tuple with the Role field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequest) GetTypeOk() (string, bool) {
-	if t == nil || t.Type == nil {
+func (u *User) GetRoleOk() (string, bool) {
+	if u == nil || u.Role == nil {
 		return "", false
 	}
-	return *t.Type, true
+	return *u.Role, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (t *TileDefRequest) HasType() bool {
-	if t != nil && t.Type != nil {
+// HasRole returns a boolean if a field has been set.
+func (u *User) HasRole() bool {
+	if u != nil && u.Role != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new t.Type and returns the pointer to it.
-func (t *TileDefRequest) SetType(v string) {
-	t.Type = &v
+// SetRole allocates a new u.Role and returns the pointer to it.
+func (u *User) SetRole(v string) {
+	u.Role = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (t *TileDefRequestStyle) GetPalette() string {
-	if t == nil || t.Palette == nil {
-		return ""
+// GetVerified returns the Verified field if non-nil, zero value otherwise.
+func (u *User) GetVerified() bool {
+	if u == nil || u.Verified == nil {
+		return false
 	}
-	return *t.Palette
+	return *u.Verified
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetVerifiedOk returns a tuple with the Verified field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequestStyle) GetPaletteOk() (string, bool) {
-	if t == nil || t.Palette == nil {
-		return "", false
+func (u *User) GetVerifiedOk() (bool, bool) {
+	if u == nil || u.Verified == nil {
+		return false, false
 	}
-	return *t.Palette, true
+	return *u.Verified, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (t *TileDefRequestStyle) HasPalette() bool {
-	if t != nil && t.Palette != nil {
+// HasVerified returns a boolean if a field has been set.
+func (u *User) HasVerified() bool {
+	if u != nil && u.Verified != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new t.Palette and returns the pointer to it.
-func (t *TileDefRequestStyle) SetPalette(v string) {
-	t.Palette = &v
+// SetVerified allocates a new u.Verified and returns the pointer to it.
+func (u *User) SetVerified(v bool) {
+	u.Verified = &v
 }
 
-// GetType returns the Type field if non-nil, zero value otherwise.
-func (t *TileDefRequestStyle) GetType() string {
-	if t == nil || t.Type == nil {
+// GetCreatedAt returns the CreatedAt field if non-nil, zero value otherwise.
+func (u *UserV2) GetCreatedAt() string {
+	if u == nil || u.CreatedAt == nil {
 		return ""
 	}
-	return *t.Type
+	return *u.CreatedAt
 }
 
-// GetTypeOk returns a tuple with the Type field if it's non-nil, zero value otherwise
+// GetCreatedAtOk returns a tuple with the CreatedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequestStyle) GetTypeOk() (string, bool) {
-	if t == nil || t.Type == nil {
+func (u *UserV2) GetCreatedAtOk() (string, bool) {
+	if u == nil || u.CreatedAt == nil {
 		return "", false
 	}
-	return *t.Type, true
+	return *u.CreatedAt, true
 }
 
-// HasType returns a boolean if a field has been set.
-func (t *TileDefRequestStyle) HasType() bool {
-	if t != nil && t.Type != nil {
+// HasCreatedAt returns a boolean if a field has been set.
+func (u *UserV2) HasCreatedAt() bool {
+	if u != nil && u.CreatedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetType allocates a new t.Type and returns the pointer to it.
-func (t *TileDefRequestStyle) SetType(v string) {
-	t.Type = &v
+// SetCreatedAt allocates a new u.CreatedAt and returns the pointer to it.
+func (u *UserV2) SetCreatedAt(v string) {
+	u.CreatedAt = &v
 }
 
-// GetWidth returns the Width field if non-nil, zero value otherwise.
-func (t *TileDefRequestStyle) GetWidth() string {
-	if t == nil || t.Width == nil {
-		return ""
+// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
+func (u *UserV2) GetDisabled() bool {
+	if u == nil || u.Disabled == nil {
+		return false
 	}
-	return *t.Width
+	return *u.Disabled
 }
 
-// GetWidthOk returns a tuple with the Width field if it's non-nil, zero value otherwise
+// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefRequestStyle) GetWidthOk() (string, bool) {
-	if t == nil || t.Width == nil {
-		return "", false
+func (u *UserV2) GetDisabledOk() (bool, bool) {
+	if u == nil || u.Disabled == nil {
+		return false, false
 	}
-	return *t.Width, true
+	return *u.Disabled, true
 }
 
-// HasWidth returns a boolean if a field has been set.
-func (t *TileDefRequestStyle) HasWidth() bool {
-	if t != nil && t.Width != nil {
+// HasDisabled returns a boolean if a field has been set.
+func (u *UserV2) HasDisabled() bool {
+	if u != nil && u.Disabled != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetWidth allocates a new t.Width and returns the pointer to it.
-func (t *TileDefRequestStyle) SetWidth(v string) {
-	t.Width = &v
+// SetDisabled allocates a new u.Disabled and returns the pointer to it.
+func (u *UserV2) SetDisabled(v bool) {
+	u.Disabled = &v
 }
 
-// GetFillMax returns the FillMax field if non-nil, zero value otherwise.
-func (t *TileDefStyle) GetFillMax() string {
-	if t == nil || t.FillMax == nil {
+// GetEmail returns the Email field if non-nil, zero value otherwise.
+func (u *UserV2) GetEmail() string {
+	if u == nil || u.Email == nil {
 		return ""
 	}
-	return *t.FillMax
+	return *u.Email
 }
 
-// GetFillMaxOk returns a tuple with the FillMax field if it's non-nil, zero value otherwise
+// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefStyle) GetFillMaxOk() (string, bool) {
-	if t == nil || t.FillMax == nil {
+func (u *UserV2) GetEmailOk() (string, bool) {
+	if u == nil || u.Email == nil {
 		return "", false
 	}
-	return *t.FillMax, true
+	return *u.Email, true
 }
 
-// HasFillMax returns a boolean if a field has been set.
-func (t *TileDefStyle) HasFillMax() bool {
-	if t != nil && t.FillMax != nil {
+// HasEmail returns a boolean if a field has been set.
+func (u *UserV2) HasEmail() bool {
+	if u != nil && u.Email != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetFillMax allocates a new t.FillMax and returns the pointer to it.
-func (t *TileDefStyle) SetFillMax(v string) {
-	t.FillMax = &v
+// SetEmail allocates a new u.Email and returns the pointer to it.
+func (u *UserV2) SetEmail(v string) {
+	u.Email = &v
 }
 
-// GetFillMin returns the FillMin field if non-nil, zero value otherwise.
-func (t *TileDefStyle) GetFillMin() string {
-	if t == nil || t.FillMin == nil {
+// GetHandle returns the Handle field if non-nil, zero value otherwise.
+func (u *UserV2) GetHandle() string {
+	if u == nil || u.Handle == nil {
 		return ""
 	}
-	return *t.FillMin
+	return *u.Handle
 }
 
-// GetFillMinOk returns a tuple with the FillMin field if it's non-nil, zero value otherwise
+// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefStyle) GetFillMinOk() (string, bool) {
-	if t == nil || t.FillMin == nil {
+func (u *UserV2) GetHandleOk() (string, bool) {
+	if u == nil || u.Handle == nil {
 		return "", false
 	}
-	return *t.FillMin, true
+	return *u.Handle, true
 }
 
-// HasFillMin returns a boolean if a field has been set.
-func (t *TileDefStyle) HasFillMin() bool {
-	if t != nil && t.FillMin != nil {
+// HasHandle returns a boolean if a field has been set.
+func (u *UserV2) HasHandle() bool {
+	if u != nil && u.Handle != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetFillMin allocates a new t.FillMin and returns the pointer to it.
-func (t *TileDefStyle) SetFillMin(v string) {
-	t.FillMin = &v
+// SetHandle allocates a new u.Handle and returns the pointer to it.
+func (u *UserV2) SetHandle(v string) {
+	u.Handle = &v
 }
 
-// GetPalette returns the Palette field if non-nil, zero value otherwise.
-func (t *TileDefStyle) GetPalette() string {
-	if t == nil || t.Palette == nil {
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (u *UserV2) GetId() string {
+	if u == nil || u.Id == nil {
 		return ""
 	}
-	return *t.Palette
+	return *u.Id
 }
 
-// GetPaletteOk returns a tuple with the Palette field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefStyle) GetPaletteOk() (string, bool) {
-	if t == nil || t.Palette == nil {
+func (u *UserV2) GetIdOk() (string, bool) {
+	if u == nil || u.Id == nil {
 		return "", false
 	}
-	return *t.Palette, true
+	return *u.Id, true
 }
 
-// HasPalette returns a boolean if a field has been set.
-func (t *TileDefStyle) HasPalette() bool {
-	if t != nil && t.Palette != nil {
+// HasId returns a boolean if a field has been set.
+func (u *UserV2) HasId() bool {
+	if u != nil && u.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPalette allocates a new t.Palette and returns the pointer to it.
-func (t *TileDefStyle) SetPalette(v string) {
-	t.Palette = &v
+// SetId allocates a new u.Id and returns the pointer to it.
+func (u *UserV2) SetId(v string) {
+	u.Id = &v
 }
 
-// GetPaletteFlip returns the PaletteFlip field if non-nil, zero value otherwise.
-func (t *TileDefStyle) GetPaletteFlip() string {
-	if t == nil || t.PaletteFlip == nil {
+// GetModifiedAt returns the ModifiedAt field if non-nil, zero value otherwise.
+func (u *UserV2) GetModifiedAt() string {
+	if u == nil || u.ModifiedAt == nil {
 		return ""
 	}
-	return *t.PaletteFlip
+	return *u.ModifiedAt
 }
 
-// GetPaletteFlipOk returns a tuple with the PaletteFlip field if it's non-nil, zero value otherwise
+// GetModifiedAtOk returns a tuple with the ModifiedAt field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TileDefStyle) GetPaletteFlipOk() (string, bool) {
-	if t == nil || t.PaletteFlip == nil {
+func (u *UserV2) GetModifiedAtOk() (string, bool) {
+	if u == nil || u.ModifiedAt == nil {
 		return "", false
 	}
-	return *t.PaletteFlip, true
+	return *u.ModifiedAt, true
 }
 
-// HasPaletteFlip returns a boolean if a field has been set.
-func (t *TileDefStyle) HasPaletteFlip() bool {
-	if t != nil && t.PaletteFlip != nil {
+// HasModifiedAt returns a boolean if a field has been set.
+func (u *UserV2) HasModifiedAt() bool {
+	if u != nil && u.ModifiedAt != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetPaletteFlip allocates a new t.PaletteFlip and returns the pointer to it.
-func (t *TileDefStyle) SetPaletteFlip(v string) {
-	t.PaletteFlip = &v
+// SetModifiedAt allocates a new u.ModifiedAt and returns the pointer to it.
+func (u *UserV2) SetModifiedAt(v string) {
+	u.ModifiedAt = &v
 }
 
-// GetLiveSpan returns the LiveSpan field if non-nil, zero value otherwise.
-func (t *Time) GetLiveSpan() string {
-	if t == nil || t.LiveSpan == nil {
+// GetName returns the Name field if non-nil, zero value otherwise.
+func (u *UserV2) GetName() string {
+	if u == nil || u.Name == nil {
 		return ""
 	}
-	return *t.LiveSpan
+	return *u.Name
 }
 
-// GetLiveSpanOk returns a tuple with the LiveSpan field if it's non-nil, zero value otherwise
+// GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *Time) GetLiveSpanOk() (string, bool) {
-	if t == nil || t.LiveSpan == nil {
+func (u *UserV2) GetNameOk() (string, bool) {
+	if u == nil || u.Name == nil {
 		return "", false
 	}
-	return *t.LiveSpan, true
+	return *u.Name, true
 }
 
-// HasLiveSpan returns a boolean if a field has been set.
-func (t *Time) HasLiveSpan() bool {
-	if t != nil && t.LiveSpan != nil {
+// HasName returns a boolean if a field has been set.
+func (u *UserV2) HasName() bool {
+	if u != nil && u.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetLiveSpan allocates a new t.LiveSpan and returns the pointer to it.
-func (t *Time) SetLiveSpan(v string) {
-	t.LiveSpan = &v
+// SetName allocates a new u.Name and returns the pointer to it.
+func (u *UserV2) SetName(v string) {
+	u.Name = &v
 }
 
-// GetFromTs returns the FromTs field if non-nil, zero value otherwise.
-func (t *TriggeringValue) GetFromTs() int {
-	if t == nil || t.FromTs == nil {
-		return 0
+// GetStatus returns the Status field if non-nil, zero value otherwise.
+func (u *UserV2) GetStatus() string {
+	if u == nil || u.Status == nil {
+		return ""
 	}
-	return *t.FromTs
+	return *u.Status
 }
 
-// GetFromTsOk returns a tuple with the FromTs field if it's non-nil, zero value otherwise
+// GetStatusOk returns a tuple with the Status field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TriggeringValue) GetFromTsOk() (int, bool) {
-	if t == nil || t.FromTs == nil {
-		return 0, false
+func (u *UserV2) GetStatusOk() (string, bool) {
+	if u == nil || u.Status == nil {
+		return "", false
 	}
-	return *t.FromTs, true
+	return *u.Status, true
 }
 
-// HasFromTs returns a boolean if a field has been set.
-func (t *TriggeringValue) HasFromTs() bool {
-	if t != nil && t.FromTs != nil {
+// HasStatus returns a boolean if a field has been set.
+func (u *UserV2) HasStatus() bool {
+	if u != nil && u.Status != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetFromTs allocates a new t.FromTs and returns the pointer to it.
-func (t *TriggeringValue) SetFromTs(v int) {
-	t.FromTs = &v
+// SetStatus allocates a new u.Status and returns the pointer to it.
+func (u *UserV2) SetStatus(v string) {
+	u.Status = &v
 }
 
-// GetToTs returns the ToTs field if non-nil, zero value otherwise.
-func (t *TriggeringValue) GetToTs() int {
-	if t == nil || t.ToTs == nil {
-		return 0
+// GetTitle returns the Title field if non-nil, zero value otherwise.
+func (u *UserV2) GetTitle() string {
+	if u == nil || u.Title == nil {
+		return ""
 	}
-	return *t.ToTs
+	return *u.Title
 }
 
-// GetToTsOk returns a tuple with the ToTs field if it's non-nil, zero value otherwise
+// GetTitleOk returns a tuple with the Title field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TriggeringValue) GetToTsOk() (int, bool) {
-	if t == nil || t.ToTs == nil {
-		return 0, false
+func (u *UserV2) GetTitleOk() (string, bool) {
+	if u == nil || u.Title == nil {
+		return "", false
 	}
-	return *t.ToTs, true
+	return *u.Title, true
 }
 
-// HasToTs returns a boolean if a field has been set.
-func (t *TriggeringValue) HasToTs() bool {
-	if t != nil && t.ToTs != nil {
+// HasTitle returns a boolean if a field has been set.
+func (u *UserV2) HasTitle() bool {
+	if u != nil && u.Title != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetToTs allocates a new t.ToTs and returns the pointer to it.
-func (t *TriggeringValue) SetToTs(v int) {
-	t.ToTs = &v
+// SetTitle allocates a new u.Title and returns the pointer to it.
+func (u *UserV2) SetTitle(v string) {
+	u.Title = &v
 }
 
-// GetValue returns the Value field if non-nil, zero value otherwise.
-func (t *TriggeringValue) GetValue() int {
-	if t == nil || t.Value == nil {
-		return 0
+// GetVerified returns the Verified field if non-nil, zero value otherwise.
+func (u *UserV2) GetVerified() bool {
+	if u == nil || u.Verified == nil {
+		return false
 	}
-	return *t.Value
+	return *u.Verified
 }
 
-// GetValueOk returns a tuple with the Value field if it's non-nil, zero value otherwise
+// GetVerifiedOk returns a tuple with the Verified field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (t *TriggeringValue) GetValueOk() (int, bool) {
-	if t == nil || t.Value == nil {
-		return 0, false
+func (u *UserV2) GetVerifiedOk() (bool, bool) {
+	if u == nil || u.Verified == nil {
+		return false, false
 	}
-	return *t.Value, true
+	return *u.Verified, true
 }
 
-// HasValue returns a boolean if a field has been set.
-func (t *TriggeringValue) HasValue() bool {
-	if t != nil && t.Value != nil {
+// HasVerified returns a boolean if a field has been set.
+func (u *UserV2) HasVerified() bool {
+	if u != nil && u.Verified != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetValue allocates a new t.Value and returns the pointer to it.
-func (t *TriggeringValue) SetValue(v int) {
-	t.Value = &v
+// SetVerified allocates a new u.Verified and returns the pointer to it.
+func (u *UserV2) SetVerified(v bool) {
+	u.Verified = &v
 }
 
-// GetAccessRole returns the AccessRole field if non-nil, zero value otherwise.
-func (u *User) GetAccessRole() string {
-	if u == nil || u.AccessRole == nil {
-		return ""
+// GetAttributes returns the Attributes field if non-nil, zero value otherwise.
+func (u *userV2Data) GetAttributes() UserV2 {
+	if u == nil || u.Attributes == nil {
+		return UserV2{}
 	}
-	return *u.AccessRole
+	return *u.Attributes
 }
 
-// GetAccessRoleOk returns a tuple with the AccessRole field if it's non-nil, zero value otherwise
+// GetAttributesOk returns a tuple with the Attributes field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetAccessRoleOk() (string, bool) {
-	if u == nil || u.AccessRole == nil {
-		return "", false
+func (u *userV2Data) GetAttributesOk() (UserV2, bool) {
+	if u == nil || u.Attributes == nil {
+		return UserV2{}, false
 	}
-	return *u.AccessRole, true
+	return *u.Attributes, true
 }
 
-// HasAccessRole returns a boolean if a field has been set.
-func (u *User) HasAccessRole() bool {
-	if u != nil && u.AccessRole != nil {
+// HasAttributes returns a boolean if a field has been set.
+func (u *userV2Data) HasAttributes() bool {
+	if u != nil && u.Attributes != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetAccessRole allocates a new u.AccessRole and returns the pointer to it.
-func (u *User) SetAccessRole(v string) {
-	u.AccessRole = &v
+// SetAttributes allocates a new u.Attributes and returns the pointer to it.
+func (u *userV2Data) SetAttributes(v UserV2) {
+	u.Attributes = &v
 }
 
-// GetDisabled returns the Disabled field if non-nil, zero value otherwise.
-func (u *User) GetDisabled() bool {
-	if u == nil || u.Disabled == nil {
-		return false
+// GetId returns the Id field if non-nil, zero value otherwise.
+func (u *userV2Data) GetId() string {
+	if u == nil || u.Id == nil {
+		return ""
 	}
-	return *u.Disabled
+	return *u.Id
 }
 
-// GetDisabledOk returns a tuple with the Disabled field if it's non-nil, zero value otherwise
+// GetIdOk returns a tuple with the Id field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetDisabledOk() (bool, bool) {
-	if u == nil || u.Disabled == nil {
-		return false, false
+func (u *userV2Data) GetIdOk() (string, bool) {
+	if u == nil || u.Id == nil {
+		return "", false
 	}
-	return *u.Disabled, true
+	return *u.Id, true
 }
 
-// HasDisabled returns a boolean if a field has been set.
-func (u *User) HasDisabled() bool {
-	if u != nil && u.Disabled != nil {
+// HasId returns a boolean if a field has been set.
+func (u *userV2Data) HasId() bool {
+	if u != nil && u.Id != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetDisabled allocates a new u.Disabled and returns the pointer to it.
-func (u *User) SetDisabled(v bool) {
-	u.Disabled = &v
+// SetId allocates a new u.Id and returns the pointer to it.
+func (u *userV2Data) SetId(v string) {
+	u.Id = &v
 }
 
-// GetEmail returns the Email field if non-nil, zero value otherwise.
-func (u *User) GetEmail() string {
-	if u == nil || u.Email == nil {
-		return ""
+// GetRelationships returns the Relationships field if non-nil, zero value otherwise.
+func (u *userV2Data) GetRelationships() userRelationships {
+	if u == nil || u.Relationships == nil {
+		return userRelationships{}
 	}
-	return *u.Email
+	return *u.Relationships
 }
 
-// GetEmailOk returns a tuple with the Email field if it's non-nil, zero value otherwise
+// GetRelationshipsOk returns a tuple with the Relationships field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetEmailOk() (string, bool) {
-	if u == nil || u.Email == nil {
-		return "", false
+func (u *userV2Data) GetRelationshipsOk() (userRelationships, bool) {
+	if u == nil || u.Relationships == nil {
+		return userRelationships{}, false
 	}
-	return *u.Email, true
+	return *u.Relationships, true
 }
 
-// HasEmail returns a boolean if a field has been set.
-func (u *User) HasEmail() bool {
-	if u != nil && u.Email != nil {
+// HasRelationships returns a boolean if a field has been set.
+func (u *userV2Data) HasRelationships() bool {
+	if u != nil && u.Relationships != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetEmail allocates a new u.Email and returns the pointer to it.
-func (u *User) SetEmail(v string) {
-	u.Email = &v
+// SetRelationships allocates a new u.Relationships and returns the pointer to it.
+func (u *userV2Data) SetRelationships(v userRelationships) {
+	u.Relationships = &v
 }
 
-// GetHandle returns the Handle field if non-nil, zero value otherwise.
-func (u *User) GetHandle() string {
-	if u == nil || u.Handle == nil {
+// GetCustomHeaders returns the CustomHeaders field if non-nil, zero value otherwise.
+func (w *Webhook) GetCustomHeaders() string {
+	if w == nil || w.CustomHeaders == nil {
 		return ""
 	}
-	return *u.Handle
+	return *w.CustomHeaders
 }
 
-// GetHandleOk returns a tuple with the Handle field if it's non-nil, zero value otherwise
+// GetCustomHeadersOk returns a tuple with the CustomHeaders field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetHandleOk() (string, bool) {
-	if u == nil || u.Handle == nil {
+func (w *Webhook) GetCustomHeadersOk() (string, bool) {
+	if w == nil || w.CustomHeaders == nil {
 		return "", false
 	}
-	return *u.Handle, true
+	return *w.CustomHeaders, true
 }
 
-// HasHandle returns a boolean if a field has been set.
-func (u *User) HasHandle() bool {
-	if u != nil && u.Handle != nil {
+// HasCustomHeaders returns a boolean if a field has been set.
+func (w *Webhook) HasCustomHeaders() bool {
+	if w != nil && w.CustomHeaders != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetHandle allocates a new u.Handle and returns the pointer to it.
-func (u *User) SetHandle(v string) {
-	u.Handle = &v
+// SetCustomHeaders allocates a new w.CustomHeaders and returns the pointer to it.
+func (w *Webhook) SetCustomHeaders(v string) {
+	w.CustomHeaders = &v
 }
 
-// GetIsAdmin returns the IsAdmin field if non-nil, zero value otherwise.
-func (u *User) GetIsAdmin() bool {
-	if u == nil || u.IsAdmin == nil {
-		return false
+// GetEncodeAs returns the EncodeAs field if non-nil, zero value otherwise.
+func (w *Webhook) GetEncodeAs() string {
+	if w == nil || w.EncodeAs == nil {
+		return ""
 	}
-	return *u.IsAdmin
+	return *w.EncodeAs
 }
 
-// GetIsAdminOk returns a tuple with the IsAdmin field if it's non-nil, zero value otherwise
+// GetEncodeAsOk returns a tuple with the EncodeAs field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetIsAdminOk() (bool, bool) {
-	if u == nil || u.IsAdmin == nil {
-		return false, false
+func (w *Webhook) GetEncodeAsOk() (string, bool) {
+	if w == nil || w.EncodeAs == nil {
+		return "", false
 	}
-	return *u.IsAdmin, true
+	return *w.EncodeAs, true
 }
 
-// HasIsAdmin returns a boolean if a field has been set.
-func (u *User) HasIsAdmin() bool {
-	if u != nil && u.IsAdmin != nil {
+// HasEncodeAs returns a boolean if a field has been set.
+func (w *Webhook) HasEncodeAs() bool {
+	if w != nil && w.EncodeAs != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetIsAdmin allocates a new u.IsAdmin and returns the pointer to it.
-func (u *User) SetIsAdmin(v bool) {
-	u.IsAdmin = &v
+// SetEncodeAs allocates a new w.EncodeAs and returns the pointer to it.
+func (w *Webhook) SetEncodeAs(v string) {
+	w.EncodeAs = &v
 }
 
 // GetName returns the Name field if non-nil, zero value otherwise.
-func (u *User) GetName() string {
-	if u == nil || u.Name == nil {
+func (w *Webhook) GetName() string {
+	if w == nil || w.Name == nil {
 		return ""
 	}
-	return *u.Name
+	return *w.Name
 }
 
 // GetNameOk returns a tuple with the Name field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetNameOk() (string, bool) {
-	if u == nil || u.Name == nil {
+func (w *Webhook) GetNameOk() (string, bool) {
+	if w == nil || w.Name == nil {
 		return "", false
 	}
-	return *u.Name, true
+	return *w.Name, true
 }
 
 // HasName returns a boolean if a field has been set.
-func (u *User) HasName() bool {
-	if u != nil && u.Name != nil {
+func (w *Webhook) HasName() bool {
+	if w != nil && w.Name != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetName allocates a new u.Name and returns the pointer to it.
-func (u *User) SetName(v string) {
-	u.Name = &v
+// SetName allocates a new w.Name and returns the pointer to it.
+func (w *Webhook) SetName(v string) {
+	w.Name = &v
 }
 
-// GetRole returns the Role field if non-nil, zero value otherwise.
-func (u *User) GetRole() string {
-	if u == nil || u.Role == nil {
+// GetPayload returns the Payload field if non-nil, zero value otherwise.
+func (w *Webhook) GetPayload() string {
+	if w == nil || w.Payload == nil {
 		return ""
 	}
-	return *u.Role
+	return *w.Payload
 }
 
-// GetRoleOk returns a tuple with the Role field if it's non-nil, zero value otherwise
+// GetPayloadOk returns a tuple with the Payload field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetRoleOk() (string, bool) {
-	if u == nil || u.Role == nil {
+func (w *Webhook) GetPayloadOk() (string, bool) {
+	if w == nil || w.Payload == nil {
 		return "", false
 	}
-	return *u.Role, true
+	return *w.Payload, true
 }
 
-// HasRole returns a boolean if a field has been set.
-func (u *User) HasRole() bool {
-	if u != nil && u.Role != nil {
+// HasPayload returns a boolean if a field has been set.
+func (w *Webhook) HasPayload() bool {
+	if w != nil && w.Payload != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetRole allocates a new u.Role and returns the pointer to it.
-func (u *User) SetRole(v string) {
-	u.Role = &v
+// SetPayload allocates a new w.Payload and returns the pointer to it.
+func (w *Webhook) SetPayload(v string) {
+	w.Payload = &v
 }
 
-// GetVerified returns the Verified field if non-nil, zero value otherwise.
-func (u *User) GetVerified() bool {
-	if u == nil || u.Verified == nil {
-		return false
+// GetUrl returns the Url field if non-nil, zero value otherwise.
+func (w *Webhook) GetUrl() string {
+	if w == nil || w.Url == nil {
+		return ""
 	}
-	return *u.Verified
+	return *w.Url
 }
 
-// GetVerifiedOk returns a tuple with the Verified field if it's non-nil, zero value otherwise
+// GetUrlOk returns a tuple with the Url field if it's non-nil, zero value otherwise
 // and a boolean to check if the value has been set.
-func (u *User) GetVerifiedOk() (bool, bool) {
-	if u == nil || u.Verified == nil {
-		return false, false
+func (w *Webhook) GetUrlOk() (string, bool) {
+	if w == nil || w.Url == nil {
+		return "", false
 	}
-	return *u.Verified, true
+	return *w.Url, true
 }
 
-// HasVerified returns a boolean if a field has been set.
-func (u *User) HasVerified() bool {
-	if u != nil && u.Verified != nil {
+// HasUrl returns a boolean if a field has been set.
+func (w *Webhook) HasUrl() bool {
+	if w != nil && w.Url != nil {
 		return true
 	}
 
 	return false
 }
 
-// SetVerified allocates a new u.Verified and returns the pointer to it.
-func (u *User) SetVerified(v bool) {
-	u.Verified = &v
+// SetUrl allocates a new w.Url and returns the pointer to it.
+func (w *Webhook) SetUrl(v string) {
+	w.Url = &v
 }
 
 // GetAlertID returns the AlertID field if non-nil, zero value otherwise.
@@ -0,0 +1,106 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadogtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// FakeIntake talks to a running fake intake, the server Datadog provides to
+// test submissions without sending them to Datadog. Submissions made with its
+// Client can be read back with Payloads.
+type FakeIntake struct {
+	// URL is the base URL of the fake intake, e.g. http://localhost:8080.
+	URL string
+
+	// Client is a client which submits to the fake intake.
+	Client *datadog.Client
+}
+
+// NewFakeIntake returns a FakeIntake for the fake intake served at baseUrl.
+func NewFakeIntake(baseUrl string) *FakeIntake {
+	client := datadog.NewClient(APIKey, AppKey)
+	client.SetBaseUrl(baseUrl)
+	return &FakeIntake{URL: baseUrl, Client: client}
+}
+
+// fakeIntakePayload is a payload as listed by the fake intake. Data is the
+// body of the submission, compressed with Encoding.
+type fakeIntakePayload struct {
+	Data     []byte `json:"data"`
+	Encoding string `json:"encoding"`
+}
+
+// Payloads returns the decompressed bodies of the submissions the fake intake
+// received on an endpoint, e.g. "/api/v1/series".
+func (f *FakeIntake) Payloads(endpoint string) ([][]byte, error) {
+	v := url.Values{}
+	v.Add("endpoint", endpoint)
+	resp, err := f.Client.HttpClient.Get(f.URL + "/fakeintake/payloads?" + v.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fake intake error %s: %s", resp.Status, body)
+	}
+
+	var out struct {
+		Payloads []fakeIntakePayload `json:"payloads"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+
+	payloads := make([][]byte, 0, len(out.Payloads))
+	for _, p := range out.Payloads {
+		data, err := decompress(p.Data, p.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, data)
+	}
+	return payloads, nil
+}
+
+// decompress returns data decompressed with the given content encoding.
+func decompress(data []byte, encoding string) ([]byte, error) {
+	var r io.ReadCloser
+	var err error
+	switch encoding {
+	case "", "identity":
+		return data, nil
+	case "gzip":
+		r, err = gzip.NewReader(bytes.NewReader(data))
+	case "deflate":
+		r, err = zlib.NewReader(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("unsupported payload encoding %q", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
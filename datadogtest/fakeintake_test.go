@@ -0,0 +1,67 @@
+package datadogtest_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zorkian/go-datadog-api"
+	"github.com/zorkian/go-datadog-api/datadogtest"
+)
+
+// newFakeIntakeServer emulates the fake intake: it records submissions and
+// lists them deflated, like the real one does for agent payloads.
+func newFakeIntakeServer() *httptest.Server {
+	var mu sync.Mutex
+	received := map[string][][]byte{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path != "/fakeintake/payloads" {
+			body, _ := ioutil.ReadAll(r.Body)
+			received[r.URL.Path] = append(received[r.URL.Path], body)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		type payload struct {
+			Data     []byte `json:"data"`
+			Encoding string `json:"encoding"`
+		}
+		var payloads []payload
+		for _, data := range received[r.URL.Query().Get("endpoint")] {
+			var buf bytes.Buffer
+			zw := zlib.NewWriter(&buf)
+			zw.Write(data)
+			zw.Close()
+			payloads = append(payloads, payload{Data: buf.Bytes(), Encoding: "deflate"})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"payloads": payloads})
+	}))
+}
+
+func TestFakeIntake(t *testing.T) {
+	ts := newFakeIntakeServer()
+	defer ts.Close()
+
+	intake := datadogtest.NewFakeIntake(ts.URL)
+	err := intake.Client.PostMetrics([]datadog.Metric{{Metric: datadog.String("app.requests")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payloads, err := intake.Payloads("/api/v1/series")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, payloads, 1) {
+		assert.JSONEq(t, `{"series": [{"metric": "app.requests"}]}`, string(payloads[0]))
+	}
+}
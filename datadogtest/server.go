@@ -0,0 +1,143 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+// Package datadogtest provides an in-process Datadog API server to test code
+// built on top of the datadog client.
+package datadogtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+const (
+	// APIKey is the API key used by the client of a Server.
+	APIKey = "datadogtest-api-key"
+	// AppKey is the application key used by the client of a Server.
+	AppKey = "datadogtest-app-key"
+)
+
+// Handler serves the requests made with Method to Path, e.g. "/api/v1/monitor".
+type Handler struct {
+	Method  string
+	Path    string
+	Handler http.HandlerFunc
+}
+
+// JSON returns a Handler which responds to requests with the given status
+// code and JSON body.
+func JSON(method, path string, code int, body string) Handler {
+	return Handler{
+		Method: method,
+		Path:   path,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			w.Write([]byte(body))
+		},
+	}
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is an in-process Datadog API. Requests without a matching handler
+// are answered with a 404.
+type Server struct {
+	*httptest.Server
+
+	// Client is a client which talks to the server. Its retries are
+	// disabled, so error responses are returned right away rather than after
+	// the retry timeout.
+	Client *datadog.Client
+
+	handlers []Handler
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// NewServer starts a Server which serves requests with the given handlers.
+// The caller should call Close when finished, to shut it down.
+func NewServer(handlers ...Handler) *Server {
+	s := &Server{handlers: handlers}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.Client = datadog.NewClient(APIKey, AppKey)
+	s.Client.SetBaseUrl(s.URL)
+	s.Client.DisableRetries = true
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header,
+		Body:   body,
+	})
+	s.mu.Unlock()
+
+	for _, h := range s.handlers {
+		if h.Method == r.Method && h.Path == r.URL.Path {
+			h.Handler(w, r)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	w.Write([]byte(`{"errors": ["Not found"]}`))
+}
+
+// Requests returns the requests received by the server so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// AssertAuth checks that every request received by the server carried the
+// API and application keys of the client.
+func (s *Server) AssertAuth(t testing.TB) {
+	t.Helper()
+	for _, r := range s.Requests() {
+		if got := r.Query.Get("api_key"); got != APIKey {
+			t.Errorf("%s %s: expect api_key %q. Got %q", r.Method, r.Path, APIKey, got)
+		}
+		if got := r.Query.Get("application_key"); got != AppKey {
+			t.Errorf("%s %s: expect application_key %q. Got %q", r.Method, r.Path, AppKey, got)
+		}
+	}
+}
+
+// AssertHeader checks that every request received by the server carried the
+// given header value.
+func (s *Server) AssertHeader(t testing.TB, name, value string) {
+	t.Helper()
+	for _, r := range s.Requests() {
+		if got := r.Header.Get(name); got != value {
+			t.Errorf("%s %s: expect header %s %q. Got %q", r.Method, r.Path, name, value, got)
+		}
+	}
+}
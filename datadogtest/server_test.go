@@ -0,0 +1,45 @@
+package datadogtest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zorkian/go-datadog-api/datadogtest"
+)
+
+func TestServer(t *testing.T) {
+	s := datadogtest.NewServer(
+		datadogtest.JSON("GET", "/api/v1/monitor/1", 200, `{"id": 1, "name": "test monitor"}`),
+	)
+	defer s.Close()
+
+	s.Client.Headers = http.Header{"X-Tenant-Id": []string{"tenant-1"}}
+
+	monitor, err := s.Client.GetMonitor(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "test monitor", monitor.GetName())
+
+	_, err = s.Client.GetMonitor(2)
+	assert.NotNil(t, err)
+
+	requests := s.Requests()
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, "/api/v1/monitor/2", requests[1].Path)
+	}
+	s.AssertAuth(t)
+	s.AssertHeader(t, "X-Tenant-Id", "tenant-1")
+}
+
+func TestServerClientDoesNotRetry(t *testing.T) {
+	s := datadogtest.NewServer(
+		datadogtest.JSON("GET", "/api/v1/monitor/1", 503, `{"errors": ["Service unavailable"]}`),
+	)
+	defer s.Close()
+
+	_, err := s.Client.GetMonitor(1)
+	assert.NotNil(t, err)
+	assert.Len(t, s.Requests(), 1)
+}
@@ -0,0 +1,97 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// sensitiveHeaders are the headers whose values are redacted from dumps.
+var sensitiveHeaders = []string{"Authorization", "Dd-Api-Key", "Dd-Application-Key"}
+
+// keyPaths are the paths of the key management API, whose responses hold the
+// secret values of the keys they create in a "key" attribute.
+var keyPaths = []string{"/v2/api_keys", "/v2/application_keys", "/v2/current_user/application_keys"}
+
+// keyAttribute matches the "key" attribute of a key management response.
+var keyAttribute = regexp.MustCompile(`("key"\s*:\s*)"[^"]*"`)
+
+// do sends a request with the HttpClient, dumping the request and its
+// response to DebugWriter if it is set. The request is recorded in Metrics.
+// It waits for the Limiter first, if there is one.
+func (client *Client) do(req *http.Request) (*http.Response, error) {
+	if client.Limiter != nil {
+		if err := client.Limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	start := time.Now()
+	if client.DebugWriter == nil {
+		resp, err := client.HttpClient.Do(req)
+		client.observeRequest(req, start, resp)
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		client.writeDump(">>> request", dump)
+	} else {
+		client.writeDump(">>> request", []byte(err.Error()))
+	}
+
+	resp, err := client.HttpClient.Do(req)
+	client.observeRequest(req, start, resp)
+	if err != nil {
+		client.writeDump("<<< error", []byte(err.Error()))
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpResponse(resp, true); err == nil {
+		if isKeyPath(req.URL.Path) {
+			// The secret of a created key is only registered for redaction
+			// once the response is decoded, after it is dumped.
+			dump = keyAttribute.ReplaceAll(dump, []byte(`${1}"redacted"`))
+		}
+		client.writeDump("<<< response", dump)
+	} else {
+		client.writeDump("<<< response", []byte(err.Error()))
+	}
+	return resp, nil
+}
+
+// isKeyPath reports whether path is one of the key management API.
+func isKeyPath(path string) bool {
+	for _, keyPath := range keyPaths {
+		if strings.Contains(path, keyPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeDump writes a dump to DebugWriter, without secrets.
+func (client *Client) writeDump(title string, dump []byte) {
+	lines := strings.Split(string(dump), "\n")
+	for i, line := range lines {
+		if line == "" || line == "\r" {
+			// Headers end at the first empty line.
+			break
+		}
+		for _, header := range sensitiveHeaders {
+			if strings.HasPrefix(strings.ToLower(line), strings.ToLower(header)+":") {
+				lines[i] = header + ": redacted"
+			}
+		}
+	}
+	fmt.Fprintf(client.DebugWriter, "%s\n%s\n", title, client.redact(strings.Join(lines, "\n")))
+}
@@ -0,0 +1,72 @@
+package datadog
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugWriter(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": 1, "name": "sample_app_key monitor"}`))
+	}))
+	defer ts.Close()
+
+	var debug bytes.Buffer
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.DebugWriter = &debug
+	c.Headers = http.Header{"Dd-Api-Key": []string{"header_api_key"}}
+
+	monitor, err := c.GetMonitor(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "sample_app_key monitor", monitor.GetName())
+
+	dump := debug.String()
+	assert.Equal(t, 2, strings.Count(dump, ">>> request"))
+	assert.Contains(t, dump, "GET /api/v1/monitor/1?")
+	assert.Contains(t, dump, "503 Service Unavailable")
+	assert.Contains(t, dump, `"name": "redacted monitor"`)
+	assert.Contains(t, dump, "Dd-Api-Key: redacted")
+	assert.NotContains(t, dump, "sample_api_key")
+	assert.NotContains(t, dump, "sample_app_key")
+	assert.NotContains(t, dump, "header_api_key")
+}
+
+func TestDebugWriterCreatedKey(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"id": "abc-123", "type": "api_keys", "attributes": {"name": "ci", "key": "` + secret + `"}}}`))
+	}))
+	defer ts.Close()
+
+	var debug bytes.Buffer
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.DebugWriter = &debug
+
+	key, err := c.CreateAPIKey("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, secret, key.GetKey())
+
+	dump := debug.String()
+	assert.Contains(t, dump, "<<< response")
+	assert.Contains(t, dump, `"key": "redacted"`)
+	assert.NotContains(t, dump, secret)
+}
@@ -31,6 +31,10 @@ type Downtime struct {
 	Recurrence *Recurrence `json:"recurrence,omitempty"`
 	Scope      []string    `json:"scope,omitempty"`
 	Start      *int        `json:"start,omitempty"`
+
+	// MonitorTags silences the monitors bearing all of these tags, instead
+	// of, or in addition to, the monitors in Scope.
+	MonitorTags []string `json:"monitor_tags,omitempty"`
 }
 
 // reqDowntimes retrieves a slice of all Downtimes.
@@ -40,8 +44,11 @@ type reqDowntimes struct {
 
 // CreateDowntime adds a new downtme to the system. This returns a pointer
 // to a Downtime so you can pass that to UpdateDowntime or CancelDowntime
-// later if needed.
+// later if needed. The downtime is checked with Validate first.
 func (client *Client) CreateDowntime(downtime *Downtime) (*Downtime, error) {
+	if err := downtime.Validate(); err != nil {
+		return nil, err
+	}
 	var out Downtime
 	if err := client.doJsonRequest("POST", "/v1/downtime", downtime, &out); err != nil {
 		return nil, err
@@ -79,3 +86,21 @@ func (client *Client) GetDowntimes() ([]Downtime, error) {
 	}
 	return out.Downtimes, nil
 }
+
+// reqCancelDowntimesByScope is the request and response of
+// /api/v1/downtime/cancel/by_scope.
+type reqCancelDowntimesByScope struct {
+	Scope        string `json:"scope,omitempty"`
+	CancelledIds []int  `json:"cancelled_ids,omitempty"`
+}
+
+// CancelDowntimesByScope cancels all the downtimes with the given scope, e.g.
+// "env:prod", and returns the identifiers of the cancelled downtimes.
+func (client *Client) CancelDowntimesByScope(scope string) ([]int, error) {
+	var out reqCancelDowntimesByScope
+	if err := client.doJsonRequest("POST", "/v1/downtime/cancel/by_scope",
+		reqCancelDowntimesByScope{Scope: scope}, &out); err != nil {
+		return nil, err
+	}
+	return out.CancelledIds, nil
+}
@@ -0,0 +1,61 @@
+package datadog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDowntimeWithMonitorTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/downtime", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"monitor_tags": ["service:web", "team:core"], "message": "deploying"}`, string(body))
+		w.Write([]byte(`{"id": 123, "monitor_tags": ["service:web", "team:core"], "message": "deploying"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	downtime, err := c.CreateDowntime(&Downtime{
+		MonitorTags: []string{"service:web", "team:core"},
+		Message:     String("deploying"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 123, downtime.GetId())
+	assert.Equal(t, []string{"service:web", "team:core"}, downtime.MonitorTags)
+	assert.Nil(t, downtime.Scope)
+
+	b, err := json.Marshal(downtime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, string(b), "scope")
+}
+
+func TestCancelDowntimesByScope(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v1/downtime/cancel/by_scope", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"scope": "env:prod"}`, string(body))
+		w.Write([]byte(`{"cancelled_ids": [123, 456]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	ids, err := c.CancelDowntimesByScope("env:prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []int{123, 456}, ids)
+}
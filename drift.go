@@ -0,0 +1,112 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldDiff is a field whose desired value differs from the actual one.
+// Field is the JSON path of the field, e.g. "options.thresholds.critical".
+type FieldDiff struct {
+	Field   string
+	Desired interface{}
+	Actual  interface{}
+}
+
+// serverMonitorFields are the fields of monitors managed by Datadog.
+var serverMonitorFields = []string{
+	"id", "creator", "overall_state", "overall_state_modified", "state",
+	"matching_downtimes", "created", "modified",
+}
+
+// serverDashboardFields are the fields of dashboards managed by Datadog.
+var serverDashboardFields = []string{"id", "created", "modified"}
+
+// DiffMonitor returns the fields of desired whose value differs in actual,
+// e.g. to detect drift between a monitor as configured and as deployed.
+// Fields managed by Datadog, like the state of the monitor, are ignored, as
+// are the fields desired doesn't set, whose actual value is a default picked
+// by Datadog.
+func DiffMonitor(desired, actual *Monitor) ([]FieldDiff, error) {
+	return diffJSON(desired, actual, serverMonitorFields)
+}
+
+// DiffDashboard is like DiffMonitor for dashboards.
+func DiffDashboard(desired, actual *Dashboard) ([]FieldDiff, error) {
+	return diffJSON(desired, actual, serverDashboardFields)
+}
+
+// diffJSON compares the JSON encodings of desired and actual, except for the
+// top level fields in ignored.
+func diffJSON(desired, actual interface{}, ignored []string) ([]FieldDiff, error) {
+	var d, a map[string]interface{}
+	if err := roundTripJSON(desired, &d); err != nil {
+		return nil, err
+	}
+	if err := roundTripJSON(actual, &a); err != nil {
+		return nil, err
+	}
+	for _, field := range ignored {
+		delete(d, field)
+	}
+
+	var diffs []FieldDiff
+	diffValues("", d, a, &diffs)
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs, nil
+}
+
+// roundTripJSON encodes v as JSON and decodes it into out.
+func roundTripJSON(v, out interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// diffValues adds the differences between the decoded JSON values desired
+// and actual to diffs. Null desired values are left out.
+func diffValues(path string, desired, actual interface{}, diffs *[]FieldDiff) {
+	switch d := desired.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for key, value := range d {
+			field := key
+			if path != "" {
+				field = path + "." + key
+			}
+			diffValues(field, value, a[key], diffs)
+		}
+		return
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(d) {
+			break
+		}
+		for i := range d {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), d[i], a[i], diffs)
+		}
+		return
+	default:
+		if reflect.DeepEqual(desired, actual) {
+			return
+		}
+	}
+	*diffs = append(*diffs, FieldDiff{Field: path, Desired: desired, Actual: actual})
+}
@@ -0,0 +1,78 @@
+package datadog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMonitor(t *testing.T) {
+	desired := &Monitor{
+		Name:  String("CPU is high"),
+		Type:  String("metric alert"),
+		Query: String("avg(last_5m):avg:system.cpu.user{*} > 90"),
+		Tags:  []string{"team:core"},
+		Options: &Options{
+			NotifyNoData: Bool(true),
+			Thresholds:   &ThresholdCount{Critical: jsonNumber("90")},
+		},
+	}
+
+	var actual Monitor
+	if err := json.Unmarshal([]byte(`{
+		"id": 12,
+		"name": "CPU is high",
+		"type": "metric alert",
+		"query": "avg(last_5m):avg:system.cpu.user{*} > 95",
+		"tags": ["team:core", "env:prod"],
+		"overall_state": "Alert",
+		"options": {
+			"notify_no_data": true,
+			"notify_audit": false,
+			"thresholds": {"critical": 95.0}
+		}
+	}`), &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	diffs, err := DiffMonitor(desired, &actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []FieldDiff{
+		{Field: "options.thresholds.critical", Desired: float64(90), Actual: float64(95)},
+		{Field: "query", Desired: "avg(last_5m):avg:system.cpu.user{*} > 90", Actual: "avg(last_5m):avg:system.cpu.user{*} > 95"},
+		{Field: "tags", Desired: []interface{}{"team:core"}, Actual: []interface{}{"team:core", "env:prod"}},
+	}, diffs)
+
+	actual.SetQuery(desired.GetQuery())
+	actual.Tags = desired.Tags
+	actual.Options.Thresholds.Critical = jsonNumber("90.0")
+	diffs, err = DiffMonitor(desired, &actual)
+	assert.Nil(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffDashboard(t *testing.T) {
+	desired := &Dashboard{
+		Title:  String("Web"),
+		Graphs: []Graph{{Title: String("Requests")}, {Title: String("Errors")}},
+	}
+	actual := &Dashboard{
+		Id:     Int(10),
+		Title:  String("Web"),
+		Graphs: []Graph{{Title: String("Requests")}, {Title: String("5xx")}},
+	}
+
+	diffs, err := DiffDashboard(desired, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []FieldDiff{{Field: "graphs[1].title", Desired: "Errors", Actual: "5xx"}}, diffs)
+}
+
+func jsonNumber(n string) *json.Number {
+	number := json.Number(n)
+	return &number
+}
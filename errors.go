@@ -0,0 +1,113 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxErrorBodySize is the number of bytes of a response body kept in a
+// ServiceUnavailableError.
+const maxErrorBodySize = 200
+
+// ErrEmptyResponse is returned when a response has an empty body but a
+// result was expected, if Client.RequireResponseBody is set.
+var ErrEmptyResponse = errors.New("API returned an empty response")
+
+// MultiError is returned by batch operations which carry on after some of
+// their operations failed. It holds the error of every failed operation.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// errorOrNil returns the MultiError if any error was collected, nil otherwise.
+func (e *MultiError) errorOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// RetriesExhaustedError is returned when a request still failed once the
+// retry time or count limit was reached. Err is the error of the last
+// attempt.
+type RetriesExhaustedError struct {
+	Err error
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return fmt.Sprintf("giving up after retries: %s", e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// ServiceUnavailableError is returned for 5xx responses whose body isn't
+// JSON, like the HTML pages served while Datadog is under maintenance.
+type ServiceUnavailableError struct {
+	StatusCode int
+	Status     string
+	// RetryAfter is the delay requested by the Retry-After header, zero if
+	// there was none.
+	RetryAfter time.Duration
+	// Body is the start of the body of the response.
+	Body string
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("API error %s, retry after %s: %s", e.Status, e.RetryAfter, e.Body)
+	}
+	return fmt.Sprintf("API error %s: %s", e.Status, e.Body)
+}
+
+// newServiceUnavailableError returns the error for a 5xx response with the
+// given non-JSON body.
+func newServiceUnavailableError(resp *http.Response, body []byte) *ServiceUnavailableError {
+	text := string(body)
+	if len(text) > maxErrorBodySize {
+		text = text[:maxErrorBodySize] + "..."
+	}
+	return &ServiceUnavailableError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header, time.Now()),
+		Body:       text,
+	}
+}
+
+// parseRetryAfter reads the Retry-After header, given either in seconds or as
+// a date. It returns zero if the header is missing or malformed.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds := headerInt(header, "Retry-After"); seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
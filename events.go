@@ -9,27 +9,63 @@
 package datadog
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cenkalti/backoff"
+)
+
+const (
+	// MaxEventTextLength is the number of characters of the text of events
+	// kept by Datadog.
+	MaxEventTextLength = 4000
+
+	// truncatedEventMarker ends the text of events truncated by PostEvent.
+	truncatedEventMarker = "…(truncated)"
+
+	// markdownEventStart and markdownEventEnd surround the text of events
+	// rendered as Markdown.
+	markdownEventStart = "%%% \n"
+	markdownEventEnd   = "\n %%%"
 )
 
 // Event is a single event. If this is being used to post an event, then not
 // all fields will be filled out.
 type Event struct {
-	Id          *int     `json:"id,omitempty"`
-	Title       *string  `json:"title,omitempty"`
-	Text        *string  `json:"text,omitempty"`
-	Time        *int     `json:"date_happened,omitempty"` // UNIX time.
-	Priority    *string  `json:"priority,omitempty"`
-	AlertType   *string  `json:"alert_type,omitempty"`
-	Host        *string  `json:"host,omitempty"`
-	Aggregation *string  `json:"aggregation_key,omitempty"`
-	SourceType  *string  `json:"source_type_name,omitempty"`
-	Tags        []string `json:"tags,omitempty"`
-	Url         *string  `json:"url,omitempty"`
-	Resource    *string  `json:"resource,omitempty"`
-	EventType   *string  `json:"event_type,omitempty"`
+	Id             *int     `json:"id,omitempty"`
+	Title          *string  `json:"title,omitempty"`
+	Text           *string  `json:"text,omitempty"`
+	Time           *int     `json:"date_happened,omitempty"` // UNIX time.
+	Priority       *string  `json:"priority,omitempty"`
+	AlertType      *string  `json:"alert_type,omitempty"`
+	Host           *string  `json:"host,omitempty"`
+	Aggregation    *string  `json:"aggregation_key,omitempty"`
+	SourceType     *string  `json:"source_type_name,omitempty"`
+	DeviceName     *string  `json:"device_name,omitempty"`
+	RelatedEventId *int     `json:"related_event_id,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Url            *string  `json:"url,omitempty"`
+	Resource       *string  `json:"resource,omitempty"`
+	EventType      *string  `json:"event_type,omitempty"`
+
+	// MonitorId and MonitorGroups are set on the events of monitor
+	// notifications.
+	MonitorId     *int     `json:"monitor_id,omitempty"`
+	MonitorGroups []string `json:"monitor_groups,omitempty"`
+}
+
+// SetMarkdownText sets the text of the event, marked so Datadog renders it
+// as Markdown.
+func (e *Event) SetMarkdownText(text string) {
+	e.SetText(markdownEventStart + text + markdownEventEnd)
 }
 
 // reqGetEvent is the container for receiving a single event.
@@ -42,15 +78,57 @@ type reqGetEvents struct {
 	Events []Event `json:"events,omitempty"`
 }
 
-// PostEvent takes as input an event and then posts it to the server.
+// PostEvent takes as input an event and then posts it to the server. The
+// event is checked with Validate first.
 func (client *Client) PostEvent(event *Event) (*Event, error) {
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
 	var out reqGetEvent
-	if err := client.doJsonRequest("POST", "/v1/events", event, &out); err != nil {
+	if err := client.doJsonRequest("POST", "/v1/events", client.eventToSend(event), &out); err != nil {
 		return nil, err
 	}
 	return out.Event, nil
 }
 
+// eventToSend returns the event as PostEvent sends it: with its text
+// truncated if TruncateEventText is set.
+func (client *Client) eventToSend(event *Event) *Event {
+	if !client.TruncateEventText || event.Text == nil {
+		return event
+	}
+	truncated := *event
+	truncated.Text = String(truncateEventText(*event.Text, MaxEventTextLength))
+	return &truncated
+}
+
+// truncateEventText returns text, if it is at most max characters long, or
+// its beginning cut at the last line or word boundary followed by a marker.
+// The delimiters of Markdown text are kept.
+func truncateEventText(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	start, end := "", ""
+	if strings.HasPrefix(text, markdownEventStart) && strings.HasSuffix(text, markdownEventEnd) {
+		start, end = markdownEventStart, markdownEventEnd
+		text = text[len(start) : len(text)-len(end)]
+	}
+
+	budget := max - utf8.RuneCountInString(start+truncatedEventMarker+end)
+	if budget < 0 {
+		budget = 0
+	}
+	cut := string([]rune(text)[:budget])
+	// Cutting at a boundary is only worth it if it keeps most of the text.
+	if i := strings.LastIndex(cut, "\n"); i > len(cut)/2 {
+		cut = cut[:i]
+	} else if i := strings.LastIndexAny(cut, " \t"); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return start + strings.TrimRightFunc(cut, unicode.IsSpace) + truncatedEventMarker + end
+}
+
 // GetEvent gets a single event given an identifier.
 func (client *Client) GetEvent(id int) (*Event, error) {
 	var out reqGetEvent
@@ -85,3 +163,188 @@ func (client *Client) GetEvents(start, end int,
 	}
 	return out.Events, nil
 }
+
+// eventsQueryLimit is the most events Datadog returns for a single query of
+// the event stream. A query returning that many events may be truncated.
+const eventsQueryLimit = 1000
+
+// QueryEventsParallel returns the events which happened between start and end
+// (seconds from Unix Epoch). The time window is split in shards sub-windows,
+// queried concurrently, at most BatchConcurrency at a time. A sub-window
+// returning as many events as Datadog returns for a query is split again
+// until all its events are returned, and an error is returned if one second
+// holds that many events. Events found in several sub-windows are returned
+// once, newest first. The first failing query abandons the others, as does
+// ctx being done.
+func (client *Client) QueryEventsParallel(ctx context.Context, start, end int64, shards int) ([]Event, error) {
+	if shards < 1 {
+		shards = 1
+	}
+	if window := end - start + 1; window < int64(shards) {
+		shards = int(window)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var once sync.Once
+	var firstErr error
+	results := make([][]Event, shards)
+	client.runBatch(shards, func(i int) error {
+		from := start + (end-start)*int64(i)/int64(shards)
+		to := start + (end-start)*int64(i+1)/int64(shards)
+		events, err := client.queryEventsWindow(ctx, from, to)
+		if err != nil {
+			once.Do(func() {
+				firstErr = err
+				cancel()
+			})
+			return err
+		}
+		results[i] = events
+		return nil
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var events []Event
+	seen := map[int]bool{}
+	for _, result := range results {
+		for _, event := range result {
+			if id, ok := event.GetIdOk(); ok {
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+			}
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetTime() > events[j].GetTime()
+	})
+	return events, nil
+}
+
+// queryEventsWindow returns the events which happened between from and to,
+// splitting the window in halves as long as a query may be truncated.
+func (client *Client) queryEventsWindow(ctx context.Context, from, to int64) ([]Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	v := url.Values{}
+	v.Add("start", strconv.FormatInt(from, 10))
+	v.Add("end", strconv.FormatInt(to, 10))
+	var out reqGetEvents
+	if err := client.doJsonRequestWithContext(ctx, "GET", "/v1/events?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Events) < eventsQueryLimit {
+		return out.Events, nil
+	}
+	if from >= to {
+		return nil, fmt.Errorf("too many events at %d, a query returns at most %d", from, eventsQueryLimit)
+	}
+
+	mid := from + (to-from)/2
+	events, err := client.queryEventsWindow(ctx, from, mid)
+	if err != nil {
+		return nil, err
+	}
+	later, err := client.queryEventsWindow(ctx, mid+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(events, later...), nil
+}
+
+// eventStreamLookback is how far back EventStream queries events again on
+// every poll, to catch the events which show up late, e.g. posted with a
+// date_happened in the past.
+const eventStreamLookback = 5 * time.Minute
+
+// EventStream polls the event stream every interval for the events with all
+// the given tags, and sends the new ones on the returned channel, oldest
+// first in every poll. Every poll queries the events of the last
+// eventStreamLookback again, so the events which show up late are sent too,
+// but not the events which happened before the stream started. Errors are
+// sent on the error channel, and polling continues after a backoff configured
+// like the retries of the client. Both channels must be read from, and are
+// closed once ctx is done.
+func (client *Client) EventStream(ctx context.Context, tags []string, interval time.Duration) (<-chan Event, <-chan error) {
+	events := make(chan Event)
+	errs := make(chan error)
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		bo := client.getBackOff(0)
+		started := time.Now().Unix()
+		polled := started
+		// seen holds the time of the events already sent, by identifier, as
+		// the next polls return them again.
+		seen := map[int]int64{}
+		wait := interval
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+
+			start := polled - int64(eventStreamLookback/time.Second)
+			if start < started {
+				start = started
+			}
+			end := time.Now().Unix()
+			v := url.Values{}
+			v.Add("start", strconv.FormatInt(start, 10))
+			v.Add("end", strconv.FormatInt(end, 10))
+			if len(tags) > 0 {
+				v.Add("tags", strings.Join(tags, ","))
+			}
+			var out reqGetEvents
+			if err := client.doJsonRequestWithContext(ctx, "GET", "/v1/events?"+v.Encode(), nil, &out); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+					return
+				}
+				if wait = bo.NextBackOff(); wait == backoff.Stop {
+					bo.Reset()
+					wait = interval
+				}
+				continue
+			}
+			bo.Reset()
+			wait = interval
+			polled = end
+
+			sort.SliceStable(out.Events, func(i, j int) bool {
+				return out.Events[i].GetTime() < out.Events[j].GetTime()
+			})
+			for _, event := range out.Events {
+				if _, ok := seen[event.GetId()]; ok {
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+				seen[event.GetId()] = int64(event.GetTime())
+			}
+			// Events older than the next lookback window aren't returned
+			// again.
+			for id, t := range seen {
+				if t < polled-int64(eventStreamLookback/time.Second) {
+					delete(seen, id)
+				}
+			}
+		}
+	}()
+	return events, errs
+}
@@ -0,0 +1,242 @@
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostEvent(t *testing.T) {
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/events", r.URL.Path)
+		body, _ = ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{"event": {"id": 1}, "status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	t.Run("Optional fields are omitted", func(t *testing.T) {
+		_, err := c.PostEvent(&Event{Title: String("Deploy"), Text: String("v1.2.3")})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"title": "Deploy", "text": "v1.2.3"}`, string(body))
+	})
+	t.Run("All fields are sent", func(t *testing.T) {
+		event := &Event{
+			Title:          String("Deploy"),
+			Aggregation:    String("deploy-web"),
+			SourceType:     String("jenkins"),
+			DeviceName:     String("eth0"),
+			RelatedEventId: Int(42),
+		}
+		event.SetMarkdownText("**v1.2.3**")
+
+		_, err := c.PostEvent(event)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+			"title": "Deploy",
+			"text": "%%% \n**v1.2.3**\n %%%",
+			"aggregation_key": "deploy-web",
+			"source_type_name": "jenkins",
+			"device_name": "eth0",
+			"related_event_id": 42
+		}`, string(body))
+	})
+	t.Run("Long text is truncated when enabled", func(t *testing.T) {
+		long := strings.Repeat("word ", MaxEventTextLength)
+		event := &Event{Title: String("Deploy"), Text: String(long)}
+
+		_, err := c.PostEvent(event)
+		assert.Nil(t, err)
+		assert.Contains(t, string(body), long)
+
+		c := c.Clone()
+		c.TruncateEventText = true
+		_, err = c.PostEvent(event)
+		assert.Nil(t, err)
+		var sent Event
+		assert.Nil(t, json.Unmarshal(body, &sent))
+		assert.True(t, utf8.RuneCountInString(sent.GetText()) <= MaxEventTextLength)
+		assert.True(t, strings.HasSuffix(sent.GetText(), "word…(truncated)"))
+		assert.Equal(t, long, event.GetText(), "expect the event to be left as is")
+	})
+}
+
+func TestTruncateEventText(t *testing.T) {
+	assert.Equal(t, "short", truncateEventText("short", 20))
+	assert.Equal(t, "first line…(truncated)", truncateEventText("first line\nsecond line is long", 25))
+	assert.Equal(t, "some words…(truncated)", truncateEventText("some words that go on and on", 25))
+	assert.Equal(t, "abcdefghijklm…(truncated)", truncateEventText("abcdefghijklmnopqrstuvwxyz", 25))
+	assert.Equal(t, "%%% \nsome…(truncated)\n %%%", truncateEventText("%%% \nsome words that go on\n %%%", 28))
+}
+
+func TestQueryEventsParallel(t *testing.T) {
+	var mu sync.Mutex
+	var windows [][2]int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		end, _ := strconv.Atoi(r.URL.Query().Get("end"))
+		mu.Lock()
+		windows = append(windows, [2]int{start, end})
+		mu.Unlock()
+
+		// One event per 100 seconds, so events on the boundaries of the
+		// windows are returned twice.
+		var events []string
+		for ts := start + (100-start%100)%100; ts <= end; ts += 100 {
+			events = append(events, fmt.Sprintf(`{"id": %d, "date_happened": %d}`, ts, ts))
+		}
+		fmt.Fprintf(w, `{"events": [%s]}`, strings.Join(events, ","))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	events, err := c.QueryEventsParallel(context.Background(), 1000, 2000, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, windows, 4)
+	if assert.Len(t, events, 11) {
+		assert.Equal(t, 2000, events[0].GetTime())
+		assert.Equal(t, 1000, events[10].GetTime())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.QueryEventsParallel(ctx, 1000, 2000, 4)
+	assert.NotNil(t, err)
+}
+
+func TestQueryEventsParallelSplitsFullWindows(t *testing.T) {
+	// One event per second, and at most eventsQueryLimit events per query,
+	// like Datadog does. The second 5000 holds too many events.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		end, _ := strconv.Atoi(r.URL.Query().Get("end"))
+		var events []string
+		for ts := end; ts >= start && len(events) < eventsQueryLimit; ts-- {
+			events = append(events, fmt.Sprintf(`{"id": %d, "date_happened": %d}`, ts, ts))
+			for i := 0; ts == 5000 && i < eventsQueryLimit; i++ {
+				events = append(events, fmt.Sprintf(`{"id": %d, "date_happened": %d}`, -i, ts))
+			}
+		}
+		if len(events) > eventsQueryLimit {
+			events = events[:eventsQueryLimit]
+		}
+		fmt.Fprintf(w, `{"events": [%s]}`, strings.Join(events, ","))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	events, err := c.QueryEventsParallel(context.Background(), 1000, 3999, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, events, 3000) {
+		assert.Equal(t, 3999, events[0].GetTime())
+		assert.Equal(t, 1000, events[2999].GetTime())
+	}
+
+	_, err = c.QueryEventsParallel(context.Background(), 4000, 5999, 2)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "too many events at 5000")
+	}
+}
+
+func TestQueryEventsParallelStopsOnError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "1000" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": ["Bad request"]}`))
+			return
+		}
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.Write([]byte(`{"events": []}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	begin := time.Now()
+	_, err := c.QueryEventsParallel(context.Background(), 1000, 2000, 4)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "Bad request")
+	}
+	assert.True(t, time.Since(begin) < 5*time.Second, "the other queries weren't abandoned")
+}
+
+func TestEventStream(t *testing.T) {
+	now := time.Now().Unix()
+	responses := []string{
+		fmt.Sprintf(`{"events": [{"id": 2, "date_happened": %d}, {"id": 1, "date_happened": %d}]}`, now+1, now),
+		fmt.Sprintf(`{"events": [{"id": 3, "date_happened": %d}, {"id": 2, "date_happened": %d}, {"id": 5, "date_happened": %d}]}`, now+2, now+1, now),
+		"error",
+		fmt.Sprintf(`{"events": [{"id": 3, "date_happened": %d}, {"id": 4, "date_happened": %d}]}`, now+2, now+2),
+	}
+	var mu sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "service:web,env:prod", r.URL.Query().Get("tags"))
+		mu.Lock()
+		defer mu.Unlock()
+		if len(responses) == 0 {
+			w.Write([]byte(`{"events": []}`))
+			return
+		}
+		response := responses[0]
+		responses = responses[1:]
+		if response == "error" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": ["Bad request"]}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events, errs := c.EventStream(ctx, []string{"service:web", "env:prod"}, 5*time.Millisecond)
+
+	var ids []int
+	var errors []error
+	for len(ids) < 5 && ctx.Err() == nil {
+		select {
+		case event := <-events:
+			ids = append(ids, event.GetId())
+		case err := <-errs:
+			errors = append(errors, err)
+		}
+	}
+	// Event 5 shows up late, after event 2 which happened after it.
+	assert.Equal(t, []int{1, 2, 5, 3, 4}, ids)
+	assert.Len(t, errors, 1)
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok, "expect the events channel to be closed")
+}
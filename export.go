@@ -0,0 +1,75 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ExportMonitor returns the definition of a monitor as indented JSON, e.g.
+// to keep it in a file. The definition is exported as returned by Datadog,
+// including the fields this library doesn't model, except for the fields
+// managed by Datadog, like the identifier and state of the monitor. The keys
+// are sorted, so exporting an unchanged monitor gives the same bytes. The
+// result can be imported back with CreateMonitorsFromReader.
+func (client *Client) ExportMonitor(id int) ([]byte, error) {
+	body, _, err := client.doRawRequest("GET", fmt.Sprintf("/v1/monitor/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return exportJSON(body, serverMonitorFields)
+}
+
+// ExportDashboard is like ExportMonitor for dashboards. The result can be
+// imported back with CreateDashboard.
+func (client *Client) ExportDashboard(id int) ([]byte, error) {
+	body, _, err := client.doRawRequest("GET", fmt.Sprintf("/v1/dash/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Dash json.RawMessage `json:"dash"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	if out.Dash == nil {
+		return nil, fmt.Errorf("no dashboard returned")
+	}
+	return exportJSON(out.Dash, serverDashboardFields)
+}
+
+// exportJSON encodes the JSON object in body as indented JSON with sorted
+// keys, without the top level fields in ignored.
+func exportJSON(body []byte, ignored []string) ([]byte, error) {
+	// Numbers are kept as is rather than going through float64, which
+	// would change large integers.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	for _, field := range ignored {
+		delete(fields, field)
+	}
+
+	// Maps are encoded with sorted keys. Queries are left readable rather
+	// than having characters like > escaped.
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(fields); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,94 @@
+package datadog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportMonitor(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/monitor/12345":
+			w.Write([]byte(`{
+				"id": 12345, "type": "metric alert", "query": "avg(last_5m):avg:system.cpu.user{*} > 90",
+				"name": "CPU", "message": "@slack-ops", "tags": ["team:ops"],
+				"creator": {"email": "jane@example.com"}, "overall_state": "OK",
+				"options": {"notify_no_data": false, "thresholds": {"critical": 90}, "new_option": 9007199254740993}
+			}`))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/monitor":
+			body, _ := ioutil.ReadAll(r.Body)
+			var in map[string]interface{}
+			assert.Nil(t, json.Unmarshal(body, &in))
+			assert.Equal(t, "CPU", in["name"])
+			assert.Nil(t, in["creator"])
+			w.Write([]byte(`{"id": 67890, "name": "CPU"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	b, err := c.ExportMonitor(12345)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{
+  "message": "@slack-ops",
+  "name": "CPU",
+  "options": {
+    "new_option": 9007199254740993,
+    "notify_no_data": false,
+    "thresholds": {
+      "critical": 90
+    }
+  },
+  "query": "avg(last_5m):avg:system.cpu.user{*} > 90",
+  "tags": [
+    "team:ops"
+  ],
+  "type": "metric alert"
+}
+`, string(b))
+
+	again, err := c.ExportMonitor(12345)
+	assert.Nil(t, err)
+	assert.Equal(t, b, again)
+
+	created, err := c.CreateMonitorsFromReader(bytes.NewReader(b))
+	assert.Nil(t, err)
+	if assert.Len(t, created, 1) {
+		assert.Equal(t, 67890, created[0].GetId())
+	}
+}
+
+func TestExportDashboard(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/dash/10880", r.URL.Path)
+		w.Write([]byte(`{"dash": {"id": 10880, "title": "Board", "description": "desc", "is_shared": true,
+			"template_variables": [{"name": "env", "prefix": "env"}], "created": "2019-01-01T00:00:00Z"}}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	b, err := c.ExportDashboard(10880)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"title": "Board",
+		"description": "desc",
+		"is_shared": true,
+		"template_variables": [{"name": "env", "prefix": "env"}]
+	}`, string(b))
+}
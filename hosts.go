@@ -1,5 +1,11 @@
 package datadog
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 type HostActionResp struct {
 	Action   string `json:"action"`
 	Hostname string `json:"hostname"`
@@ -31,3 +37,117 @@ func (client *Client) UnmuteHost(host string) (*HostActionResp, error) {
 	}
 	return &out, nil
 }
+
+// MuteHosts mutes all monitors for many hosts, e.g. for a maintenance. The
+// hosts are muted concurrently, at most BatchConcurrency at a time, and rate
+// limited requests are handled by OnRateLimited like any other request. The
+// hosts which couldn't be muted are returned, so they can be retried, along
+// with their errors as a *MultiError.
+func (client *Client) MuteHosts(hosts []string, action *HostActionMute) ([]string, error) {
+	return client.batchHosts(hosts, func(host string) error {
+		_, err := client.MuteHost(host, action)
+		return err
+	})
+}
+
+// UnmuteHosts is like MuteHosts for unmuting hosts.
+func (client *Client) UnmuteHosts(hosts []string) ([]string, error) {
+	return client.batchHosts(hosts, func(host string) error {
+		_, err := client.UnmuteHost(host)
+		return err
+	})
+}
+
+// batchHosts calls do for every host, at most BatchConcurrency at a time. It
+// returns the hosts do failed for, and their errors as a *MultiError.
+func (client *Client) batchHosts(hosts []string, do func(host string) error) ([]string, error) {
+	hostErrs := client.runBatch(len(hosts), func(i int) error {
+		return do(hosts[i])
+	})
+
+	var failed []string
+	errs := &MultiError{}
+	for i, err := range hostErrs {
+		if err != nil {
+			failed = append(failed, hosts[i])
+			errs.Errors = append(errs.Errors, fmt.Errorf("host %s: %s", hosts[i], err))
+		}
+	}
+	return failed, errs.errorOrNil()
+}
+
+// HostListRequest holds the optional filtering, sorting and paging
+// parameters of GetHosts.
+type HostListRequest struct {
+	Filter    *string `json:"filter,omitempty"`
+	SortField *string `json:"sort_field,omitempty"`
+	SortDir   *string `json:"sort_dir,omitempty"`
+	Start     *int    `json:"start,omitempty"`
+	Count     *int    `json:"count,omitempty"`
+}
+
+// HostList is a page of hosts. TotalMatching is the number of hosts matching
+// the filter over all pages.
+type HostList struct {
+	Hosts         []Host `json:"host_list,omitempty"`
+	TotalMatching *int   `json:"total_matching,omitempty"`
+	TotalReturned *int   `json:"total_returned,omitempty"`
+}
+
+// Host is a host reporting to Datadog.
+type Host struct {
+	Id               *int                `json:"id,omitempty"`
+	Name             *string             `json:"name,omitempty"`
+	HostName         *string             `json:"host_name,omitempty"`
+	Up               *bool               `json:"up,omitempty"`
+	IsMuted          *bool               `json:"is_muted,omitempty"`
+	MuteTimeout      *int                `json:"mute_timeout,omitempty"`
+	LastReportedTime *int                `json:"last_reported_time,omitempty"`
+	Metrics          *HostMetrics        `json:"metrics,omitempty"`
+	TagsBySource     map[string][]string `json:"tags_by_source,omitempty"`
+	Apps             []string            `json:"apps,omitempty"`
+	Aliases          []string            `json:"aliases,omitempty"`
+	Sources          []string            `json:"sources,omitempty"`
+}
+
+// HostMetrics are the latest values of the core metrics of a host.
+type HostMetrics struct {
+	Load   *float64 `json:"load,omitempty"`
+	Iowait *float64 `json:"iowait,omitempty"`
+	Cpu    *float64 `json:"cpu,omitempty"`
+}
+
+// GetHosts returns the hosts matching the request, which may be nil to get
+// the first page of all hosts. Use Start and Count to page through the hosts
+// until TotalMatching of them have been returned.
+func (client *Client) GetHosts(req *HostListRequest) (*HostList, error) {
+	v := url.Values{}
+	if req != nil {
+		if req.Filter != nil {
+			v.Add("filter", req.GetFilter())
+		}
+		if req.SortField != nil {
+			v.Add("sort_field", req.GetSortField())
+		}
+		if req.SortDir != nil {
+			v.Add("sort_dir", req.GetSortDir())
+		}
+		if req.Start != nil {
+			v.Add("start", strconv.Itoa(req.GetStart()))
+		}
+		if req.Count != nil {
+			v.Add("count", strconv.Itoa(req.GetCount()))
+		}
+	}
+
+	uri := "/v1/hosts"
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	var out HostList
+	if err := client.doJsonRequest("GET", uri, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
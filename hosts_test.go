@@ -0,0 +1,76 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHosts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/hosts", r.URL.Path)
+		assert.Equal(t, "env:prod", r.URL.Query().Get("filter"))
+		assert.Equal(t, "100", r.URL.Query().Get("start"))
+		assert.Equal(t, "", r.URL.Query().Get("sort_dir"))
+		w.Write([]byte(`{
+			"host_list": [{
+				"name": "web-1",
+				"up": true,
+				"last_reported_time": 1546300800,
+				"metrics": {"load": 0.5, "iowait": 0.1, "cpu": 12.5},
+				"tags_by_source": {"Datadog": ["env:prod"]},
+				"apps": ["agent", "nginx"]
+			}],
+			"total_matching": 101,
+			"total_returned": 1
+		}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	hosts, err := c.GetHosts(&HostListRequest{Filter: String("env:prod"), Start: Int(100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 101, hosts.GetTotalMatching())
+	assert.Equal(t, 1, hosts.GetTotalReturned())
+	if assert.Len(t, hosts.Hosts, 1) {
+		host := hosts.Hosts[0]
+		assert.Equal(t, "web-1", host.GetName())
+		assert.True(t, host.GetUp())
+		assert.Equal(t, 12.5, host.Metrics.GetCpu())
+		assert.Equal(t, []string{"env:prod"}, host.TagsBySource["Datadog"])
+	}
+}
+
+func TestMuteHosts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/host/web-2/mute", "/api/v1/host/web-2/unmute":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": ["Host web-2 is already muted"]}`))
+		default:
+			w.Write([]byte(`{"action": "Muted", "hostname": "web"}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.BatchConcurrency = 2
+
+	failed, err := c.MuteHosts([]string{"web-1", "web-2", "web-3"}, &HostActionMute{Message: String("maintenance")})
+	assert.Equal(t, []string{"web-2"}, failed)
+	if assert.IsType(t, &MultiError{}, err) {
+		assert.Len(t, err.(*MultiError).Errors, 1)
+		assert.Contains(t, err.Error(), "host web-2")
+	}
+
+	failed, err = c.UnmuteHosts([]string{"web-1", "web-3"})
+	assert.Nil(t, err)
+	assert.Empty(t, failed)
+}
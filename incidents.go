@@ -0,0 +1,162 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// incidentsPageSize is the number of incidents requested per page when
+// listing incidents.
+const incidentsPageSize = 100
+
+// Incident is an incident tracked in Datadog. Its Timeline is kept as JSON,
+// as its shape isn't documented, so it survives being sent back unchanged.
+type Incident struct {
+	Id                  *string                  `json:"-"`
+	Title               *string                  `json:"title,omitempty"`
+	CustomerImpacted    *bool                    `json:"customer_impacted,omitempty"`
+	CustomerImpactScope *string                  `json:"customer_impact_scope,omitempty"`
+	Fields              map[string]IncidentField `json:"fields,omitempty"`
+	Created             *string                  `json:"created,omitempty"`
+	Modified            *string                  `json:"modified,omitempty"`
+	Resolved            *string                  `json:"resolved,omitempty"`
+	Timeline            json.RawMessage          `json:"timeline,omitempty"`
+}
+
+// IncidentField is a field of an incident, like its severity or state. Its
+// value is kept as JSON, as its shape depends on the type of the field, e.g.
+// a string for "dropdown" fields and an array of strings for "multiselect"
+// ones, so fields of any type survive being sent back unchanged.
+type IncidentField struct {
+	Type  *string         `json:"type,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// NewIncidentField returns a field of the given type whose value is the JSON
+// encoding of value.
+func NewIncidentField(fieldType string, value interface{}) (IncidentField, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return IncidentField{}, err
+	}
+	return IncidentField{Type: String(fieldType), Value: b}, nil
+}
+
+// incidentData is the resource envelope used by the incidents API.
+type incidentData struct {
+	Id         *string   `json:"id,omitempty"`
+	Type       string    `json:"type"`
+	Attributes *Incident `json:"attributes,omitempty"`
+}
+
+// reqIncident is the container for sending and receiving a single incident.
+type reqIncident struct {
+	Data *incidentData `json:"data"`
+}
+
+// reqIncidents is the container for receiving a page of incidents.
+type reqIncidents struct {
+	Data []incidentData `json:"data"`
+}
+
+func newIncidentData(incident *Incident) *incidentData {
+	return &incidentData{Id: incident.Id, Type: "incidents", Attributes: incidentToSend(incident)}
+}
+
+// incidentToSend returns the incident to send to the API, without the fields
+// managed by Datadog. The incident itself is left untouched.
+func incidentToSend(incident *Incident) *Incident {
+	writable := *incident
+	writable.Created = nil
+	writable.Modified = nil
+	writable.Resolved = nil
+	return &writable
+}
+
+func (d *incidentData) incident() *Incident {
+	if d.Attributes == nil {
+		d.Attributes = &Incident{}
+	}
+	d.Attributes.Id = d.Id
+	return d.Attributes
+}
+
+// CreateIncident adds a new incident to the system. This returns a pointer
+// to an Incident so you can pass that to UpdateIncident later if needed.
+func (client *Client) CreateIncident(incident *Incident) (*Incident, error) {
+	var out reqIncident
+	if err := client.doJsonRequest("POST", "/v2/incidents", reqIncident{Data: newIncidentData(incident)}, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no incident returned")
+	}
+	return out.Data.incident(), nil
+}
+
+// UpdateIncident takes an incident that was previously retrieved through
+// some method and sends it back to the server.
+func (client *Client) UpdateIncident(incident *Incident) error {
+	return client.doJsonRequest("PATCH", fmt.Sprintf("/v2/incidents/%s", incident.GetId()),
+		reqIncident{Data: newIncidentData(incident)}, nil)
+}
+
+// GetIncident retrieves an incident by identifier.
+func (client *Client) GetIncident(id string) (*Incident, error) {
+	var out reqIncident
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v2/incidents/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no incident returned")
+	}
+	return out.Data.incident(), nil
+}
+
+// DeleteIncident removes an incident from the system.
+func (client *Client) DeleteIncident(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/incidents/%s", id),
+		nil, nil)
+}
+
+// ListIncidents returns a slice of all incidents. The incidents are
+// requested page by page.
+func (client *Client) ListIncidents() ([]Incident, error) {
+	var incidents []Incident
+	seen := map[string]bool{}
+	for offset := 0; ; offset += incidentsPageSize {
+		v := url.Values{}
+		v.Add("page[size]", strconv.Itoa(incidentsPageSize))
+		v.Add("page[offset]", strconv.Itoa(offset))
+
+		var out reqIncidents
+		if err := client.doJsonRequest("GET", "/v2/incidents?"+v.Encode(), nil, &out); err != nil {
+			return nil, err
+		}
+		added := 0
+		for i := range out.Data {
+			incident := out.Data[i].incident()
+			if !seen[incident.GetId()] {
+				seen[incident.GetId()] = true
+				incidents = append(incidents, *incident)
+				added++
+			}
+		}
+
+		// A page without new incidents means the paging parameters were
+		// ignored and every incident was returned at once.
+		if added == 0 || len(out.Data) < incidentsPageSize {
+			return incidents, nil
+		}
+	}
+}
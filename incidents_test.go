@@ -0,0 +1,132 @@
+package datadog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncidents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			assert.Equal(t, "/api/v2/incidents", r.URL.Path)
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data": {"type": "incidents", "attributes": {
+				"title": "Checkout is down",
+				"customer_impacted": true,
+				"customer_impact_scope": "Checkout fails for all users",
+				"fields": {"severity": {"type": "dropdown", "value": "SEV-1"}}
+			}}}`, string(body))
+			w.Write([]byte(`{"data": {"id": "inc-1", "type": "incidents", "attributes": {
+				"title": "Checkout is down",
+				"created": "2020-01-01T00:00:00Z",
+				"modified": "2020-01-01T00:05:00Z",
+				"resolved": "2020-01-01T01:00:00Z",
+				"timeline": {"cells": [{"cell_type": "markdown", "content": {"markdown": "Rolled back"}}]},
+				"fields": {
+					"severity": {"type": "dropdown", "value": "SEV-1"},
+					"teams": {"type": "autocomplete", "value": ["payments", "web"]},
+					"custom": {"type": "metrictag", "value": {"nested": true}}
+				}
+			}}}`))
+		case "PATCH":
+			assert.Equal(t, "/api/v2/incidents/inc-1", r.URL.Path)
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data": {"id": "inc-1", "type": "incidents", "attributes": {
+				"title": "Checkout is down",
+				"fields": {
+					"severity": {"type": "dropdown", "value": "SEV-2"},
+					"teams": {"type": "autocomplete", "value": ["payments", "web"]},
+					"custom": {"type": "metrictag", "value": {"nested": true}}
+				},
+				"timeline": {"cells": [{"cell_type": "markdown", "content": {"markdown": "Rolled back"}}]}
+			}}}`, string(body))
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	severity, err := NewIncidentField("dropdown", "SEV-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	incident, err := c.CreateIncident(&Incident{
+		Title:               String("Checkout is down"),
+		CustomerImpacted:    Bool(true),
+		CustomerImpactScope: String("Checkout fails for all users"),
+		Fields:              map[string]IncidentField{"severity": severity},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "inc-1", incident.GetId())
+	assert.JSONEq(t, `["payments", "web"]`, string(incident.Fields["teams"].Value))
+	assert.JSONEq(t, `{"cells": [{"cell_type": "markdown", "content": {"markdown": "Rolled back"}}]}`, string(incident.Timeline))
+
+	incident.Fields["severity"], _ = NewIncidentField("dropdown", "SEV-2")
+	assert.Nil(t, c.UpdateIncident(incident))
+	assert.Equal(t, "2020-01-01T00:00:00Z", incident.GetCreated())
+	assert.Equal(t, "2020-01-01T01:00:00Z", incident.GetResolved())
+}
+
+func TestListIncidents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/incidents", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("page[size]"))
+		switch r.URL.Query().Get("page[offset]") {
+		case "0":
+			incidents := make([]string, 0, 100)
+			for i := 1; i <= 100; i++ {
+				incidents = append(incidents, fmt.Sprintf(`{"id": "inc-%d", "type": "incidents"}`, i))
+			}
+			w.Write([]byte(`{"data": [` + strings.Join(incidents, ",") + `]}`))
+		case "100":
+			w.Write([]byte(`{"data": [{"id": "inc-101", "type": "incidents", "attributes": {"title": "Last"}}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	incidents, err := c.ListIncidents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, incidents, 101) {
+		assert.Equal(t, "inc-100", incidents[99].GetId())
+		assert.Equal(t, "Last", incidents[100].GetTitle())
+	}
+}
+
+func TestListIncidentsIgnoredPaging(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		incidents := make([]string, 0, 100)
+		for i := 1; i <= 100; i++ {
+			incidents = append(incidents, fmt.Sprintf(`{"id": "inc-%d", "type": "incidents"}`, i))
+		}
+		w.Write([]byte(`{"data": [` + strings.Join(incidents, ",") + `]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	incidents, err := c.ListIncidents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, incidents, 100)
+	assert.Equal(t, 2, requests)
+}
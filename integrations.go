@@ -8,6 +8,11 @@
 
 package datadog
 
+import (
+	"fmt"
+	"net/url"
+)
+
 /*
 	PagerDuty Integration
 */
@@ -239,3 +244,52 @@ func (client *Client) UpdateIntegrationGCP(cir *IntegrationGCPUpdateRequest) err
 func (client *Client) DeleteIntegrationGCP(cir *IntegrationGCPDeleteRequest) error {
 	return client.doJsonRequest("DELETE", "/v1/integration/gcp", cir, nil)
 }
+
+/*
+	Webhooks Integration
+*/
+
+// Webhook defines a webhook of the Datadog-Webhooks integration. Payload and
+// CustomHeaders are JSON documents given as strings, they are sent as is.
+// EncodeAs is either "json" or "form".
+type Webhook struct {
+	Name          *string `json:"name,omitempty"`
+	Url           *string `json:"url,omitempty"`
+	Payload       *string `json:"payload,omitempty"`
+	CustomHeaders *string `json:"custom_headers,omitempty"`
+	EncodeAs      *string `json:"encode_as,omitempty"`
+}
+
+// webhookURI returns the API path of a webhook.
+func webhookURI(name string) string {
+	return fmt.Sprintf("/v1/integration/webhooks/configuration/webhooks/%s", url.PathEscape(name))
+}
+
+// CreateIntegrationWebhook creates a new webhook.
+func (client *Client) CreateIntegrationWebhook(webhook *Webhook) (*Webhook, error) {
+	var out Webhook
+	if err := client.doJsonRequest("POST", "/v1/integration/webhooks/configuration/webhooks", webhook, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateIntegrationWebhook updates the webhook with the name of the given
+// webhook.
+func (client *Client) UpdateIntegrationWebhook(webhook *Webhook) error {
+	return client.doJsonRequest("PUT", webhookURI(webhook.GetName()), webhook, nil)
+}
+
+// GetIntegrationWebhook gets a webhook by name.
+func (client *Client) GetIntegrationWebhook(name string) (*Webhook, error) {
+	var out Webhook
+	if err := client.doJsonRequest("GET", webhookURI(name), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteIntegrationWebhook removes a webhook from the system.
+func (client *Client) DeleteIntegrationWebhook(name string) error {
+	return client.doJsonRequest("DELETE", webhookURI(name), nil, nil)
+}
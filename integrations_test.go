@@ -0,0 +1,46 @@
+package datadog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationWebhook(t *testing.T) {
+	const webhook = `{
+		"name": "alerts-router",
+		"url": "https://alerts.example.com/hook",
+		"payload": "{\"title\": \"$EVENT_TITLE\", \"tags\": \"$TAGS\"}",
+		"custom_headers": "{\"X-Token\": \"abc\"}",
+		"encode_as": "json"
+	}`
+
+	var received []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/integration/webhooks/configuration/webhooks/alerts-router", r.URL.Path)
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(webhook))
+		case "PUT":
+			received, _ = ioutil.ReadAll(r.Body)
+			w.Write([]byte(webhook))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	hook, err := c.GetIntegrationWebhook("alerts-router")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"title": "$EVENT_TITLE", "tags": "$TAGS"}`, hook.GetPayload())
+	assert.Equal(t, `{"X-Token": "abc"}`, hook.GetCustomHeaders())
+
+	assert.Nil(t, c.UpdateIntegrationWebhook(hook))
+	assert.JSONEq(t, webhook, string(received))
+}
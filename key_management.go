@@ -0,0 +1,226 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"fmt"
+)
+
+// APIKey is an API key of the organization. Key, the secret value of the
+// key, is only returned on creation.
+type APIKey struct {
+	Id         *string `json:"-"`
+	Name       *string `json:"name,omitempty"`
+	Key        *string `json:"key,omitempty"`
+	Last4      *string `json:"last4,omitempty"`
+	CreatedAt  *string `json:"created_at,omitempty"`
+	ModifiedAt *string `json:"modified_at,omitempty"`
+}
+
+// String describes the key without its secret value, so it can be logged.
+func (k APIKey) String() string {
+	return fmt.Sprintf("API key %s (%s, ...%s)", k.GetId(), k.GetName(), k.GetLast4())
+}
+
+// ApplicationKey is an application key of the organization. Key, the secret
+// value of the key, is only returned on creation.
+type ApplicationKey struct {
+	Id        *string  `json:"-"`
+	Name      *string  `json:"name,omitempty"`
+	Key       *string  `json:"key,omitempty"`
+	Last4     *string  `json:"last4,omitempty"`
+	CreatedAt *string  `json:"created_at,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+// String describes the key without its secret value, so it can be logged.
+func (k ApplicationKey) String() string {
+	return fmt.Sprintf("application key %s (%s, ...%s)", k.GetId(), k.GetName(), k.GetLast4())
+}
+
+// apiKeyData is the resource envelope used by the API keys API.
+type apiKeyData struct {
+	Id         *string `json:"id,omitempty"`
+	Type       string  `json:"type"`
+	Attributes *APIKey `json:"attributes,omitempty"`
+}
+
+// reqAPIKey is the container for sending and receiving a single API key.
+type reqAPIKey struct {
+	Data *apiKeyData `json:"data"`
+}
+
+// reqAPIKeys is the container for receiving many API keys.
+type reqAPIKeys struct {
+	Data []apiKeyData `json:"data"`
+}
+
+func (d *apiKeyData) apiKey() *APIKey {
+	if d.Attributes == nil {
+		d.Attributes = &APIKey{}
+	}
+	d.Attributes.Id = d.Id
+	return d.Attributes
+}
+
+// applicationKeyData is the resource envelope used by the application keys
+// API.
+type applicationKeyData struct {
+	Id         *string         `json:"id,omitempty"`
+	Type       string          `json:"type"`
+	Attributes *ApplicationKey `json:"attributes,omitempty"`
+}
+
+// reqApplicationKey is the container for sending and receiving a single
+// application key.
+type reqApplicationKey struct {
+	Data *applicationKeyData `json:"data"`
+}
+
+// reqApplicationKeys is the container for receiving many application keys.
+type reqApplicationKeys struct {
+	Data []applicationKeyData `json:"data"`
+}
+
+func (d *applicationKeyData) applicationKey() *ApplicationKey {
+	if d.Attributes == nil {
+		d.Attributes = &ApplicationKey{}
+	}
+	d.Attributes.Id = d.Id
+	return d.Attributes
+}
+
+// CreateAPIKey creates a new API key. The returned key holds the secret
+// value of the key, which can't be retrieved later, and which is redacted
+// from the errors and debug dumps of the client.
+func (client *Client) CreateAPIKey(name string) (*APIKey, error) {
+	var out reqAPIKey
+	in := reqAPIKey{Data: &apiKeyData{Type: "api_keys", Attributes: &APIKey{Name: &name}}}
+	if err := client.doJsonRequest("POST", "/v2/api_keys", in, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no API key returned")
+	}
+	key := out.Data.apiKey()
+	client.addCreatedKey(key.GetKey())
+	return key, nil
+}
+
+// GetAPIKeys returns a slice of all API keys, without their secret values.
+func (client *Client) GetAPIKeys() ([]APIKey, error) {
+	var out reqAPIKeys
+	if err := client.doJsonRequest("GET", "/v2/api_keys", nil, &out); err != nil {
+		return nil, err
+	}
+	keys := make([]APIKey, 0, len(out.Data))
+	for i := range out.Data {
+		keys = append(keys, *out.Data[i].apiKey())
+	}
+	return keys, nil
+}
+
+// DeleteAPIKey removes an API key from the system.
+func (client *Client) DeleteAPIKey(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/api_keys/%s", id), nil, nil)
+}
+
+// CreateApplicationKey creates a new application key, owned by the user of
+// the application key of the client. The returned key holds the secret value
+// of the key, which can't be retrieved later, and which is redacted from the
+// errors and debug dumps of the client.
+func (client *Client) CreateApplicationKey(name string) (*ApplicationKey, error) {
+	return client.createApplicationKey(context.Background(), name, nil)
+}
+
+// createApplicationKey creates an application key limited to scopes, or
+// unscoped if there are none.
+func (client *Client) createApplicationKey(ctx context.Context, name string, scopes []string) (*ApplicationKey, error) {
+	var out reqApplicationKey
+	in := reqApplicationKey{Data: &applicationKeyData{Type: "application_keys", Attributes: &ApplicationKey{Name: &name, Scopes: scopes}}}
+	if err := client.doJsonRequestWithContext(ctx, "POST", "/v2/current_user/application_keys", in, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no application key returned")
+	}
+	key := out.Data.applicationKey()
+	client.addCreatedKey(key.GetKey())
+	return key, nil
+}
+
+// GetApplicationKeys returns a slice of all application keys, without their
+// secret values.
+func (client *Client) GetApplicationKeys() ([]ApplicationKey, error) {
+	var out reqApplicationKeys
+	if err := client.doJsonRequest("GET", "/v2/application_keys", nil, &out); err != nil {
+		return nil, err
+	}
+	keys := make([]ApplicationKey, 0, len(out.Data))
+	for i := range out.Data {
+		keys = append(keys, *out.Data[i].applicationKey())
+	}
+	return keys, nil
+}
+
+// DeleteApplicationKey removes an application key from the system.
+func (client *Client) DeleteApplicationKey(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/application_keys/%s", id), nil, nil)
+}
+
+// WithTemporaryAppKey creates an application key, calls fn with a clone of
+// the client using that key, and deletes the key once fn returns or panics,
+// e.g. for short-lived automation. The key is deleted even if ctx is done by
+// then, and failing to delete it is reported along with the error of fn, as a
+// *MultiError. The secret value of the key is redacted from the errors and
+// debug dumps of both clients, and forgotten by the client once the key is
+// deleted. The key isn't scoped, use WithTemporaryScopedAppKey to limit what
+// fn can do with it.
+func (client *Client) WithTemporaryAppKey(ctx context.Context, fn func(c *Client) error) error {
+	return client.withTemporaryAppKey(ctx, nil, fn)
+}
+
+// WithTemporaryScopedAppKey is like WithTemporaryAppKey, but the application
+// key is limited to scopes, of which there must be at least one.
+func (client *Client) WithTemporaryScopedAppKey(ctx context.Context, scopes []string, fn func(c *Client) error) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("a scoped application key needs at least one scope")
+	}
+	return client.withTemporaryAppKey(ctx, scopes, fn)
+}
+
+func (client *Client) withTemporaryAppKey(ctx context.Context, scopes []string, fn func(c *Client) error) (err error) {
+	suffix, err := newIdempotencyKey()
+	if err != nil {
+		return err
+	}
+	key, err := client.createApplicationKey(ctx, "temporary-"+suffix, scopes)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deleteErr := client.DeleteApplicationKey(key.GetId())
+		if deleteErr == nil {
+			client.removeCreatedKey(key.GetKey())
+			return
+		}
+		deleteErr = fmt.Errorf("deleting temporary %s: %s", key, deleteErr)
+		if err == nil {
+			err = deleteErr
+		} else {
+			err = &MultiError{Errors: []error{err, deleteErr}}
+		}
+	}()
+
+	c := client.Clone()
+	apiKey, _ := client.keys()
+	c.SetKeys(apiKey, key.GetKey())
+	return fn(c)
+}
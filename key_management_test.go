@@ -0,0 +1,186 @@
+package datadog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/api_keys", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"data": {"type": "api_keys", "attributes": {"name": "ci"}}}`, string(body))
+		w.Write([]byte(`{"data": {
+			"id": "abc-123",
+			"type": "api_keys",
+			"attributes": {"name": "ci", "key": "0123456789abcdef0123456789abcdef", "last4": "cdef"}
+		}}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	key, err := c.CreateAPIKey("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "abc-123", key.GetId())
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", key.GetKey())
+	assert.NotContains(t, fmt.Sprint(key), "0123456789abcdef")
+	assert.NotContains(t, fmt.Sprint(*key), "0123456789abcdef")
+}
+
+func TestGetApplicationKeys(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/application_keys", r.URL.Path)
+		w.Write([]byte(`{"data": [{"id": "def-456", "type": "application_keys", "attributes": {"name": "terraform", "last4": "9876"}}]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	keys, err := c.GetApplicationKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, keys, 1) {
+		assert.Equal(t, "def-456", keys[0].GetId())
+		assert.False(t, keys[0].HasKey())
+	}
+}
+
+func TestCreatedKeysAreRedacted(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprintf(w, `{"data": {"id": "abc-123", "type": "api_keys", "attributes": {"key": %q}}}`, secret)
+			return
+		}
+		// An error echoing the secrets the client knows about.
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"errors": ["bad keys %s, %s and %s"]}`, secret, "sample_api_key", "sample_app_key")
+	}))
+	defer ts.Close()
+
+	var debug bytes.Buffer
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.DebugWriter = &debug
+
+	_, err := c.CreateAPIKey("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, err := range []error{
+		c.DeleteAPIKey("abc-123"),
+		c.Clone().DeleteAPIKey("abc-123"),
+	} {
+		if assert.NotNil(t, err) {
+			assert.NotContains(t, err.Error(), secret)
+			assert.NotContains(t, err.Error(), "sample_api_key")
+			assert.NotContains(t, err.Error(), "sample_app_key")
+		}
+	}
+
+	_, _, err = c.GetRaw("GET", "/v2/api_keys", nil)
+	if assert.NotNil(t, err) {
+		assert.NotContains(t, err.Error(), secret)
+	}
+
+	_, err = c.CreateApplicationKey("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 5, strings.Count(debug.String(), "<<< response"))
+	assert.NotContains(t, debug.String(), secret)
+}
+
+func TestWithTemporaryAppKey(t *testing.T) {
+	const secret = "fedcba9876543210fedcba9876543210"
+	var deleted []string
+	var scopes string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/current_user/application_keys":
+			assert.Equal(t, "sample_app_key", r.URL.Query().Get("application_key"))
+			var in struct {
+				Data struct {
+					Attributes map[string]json.RawMessage `json:"attributes"`
+				} `json:"data"`
+			}
+			json.NewDecoder(r.Body).Decode(&in)
+			scopes = string(in.Data.Attributes["scopes"])
+			fmt.Fprintf(w, `{"data": {"id": "key-1", "type": "application_keys", "attributes": {"key": %q, "last4": "3210"}}}`, secret)
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			assert.Equal(t, secret, r.URL.Query().Get("application_key"))
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"errors": ["bad key %s"]}`, secret)
+		}
+	}))
+	defer ts.Close()
+
+	var debug bytes.Buffer
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.DebugWriter = &debug
+
+	err := c.WithTemporaryAppKey(context.Background(), func(tmp *Client) error {
+		apiKey, appKey := tmp.keys()
+		assert.Equal(t, "sample_api_key", apiKey)
+		assert.Equal(t, secret, appKey)
+		return tmp.doJsonRequest("GET", "/v1/something", nil, nil)
+	})
+	if assert.NotNil(t, err) {
+		assert.NotContains(t, err.Error(), secret)
+	}
+	assert.Equal(t, []string{"/api/v2/application_keys/key-1"}, deleted)
+	assert.Contains(t, debug.String(), "<<< response")
+	assert.NotContains(t, debug.String(), secret)
+	assert.NotContains(t, c.secrets(), secret)
+	assert.Empty(t, scopes)
+
+	t.Run("The key is deleted on panic", func(t *testing.T) {
+		deleted = nil
+		assert.Panics(t, func() {
+			c.WithTemporaryAppKey(context.Background(), func(tmp *Client) error {
+				panic("boom")
+			})
+		})
+		assert.Equal(t, []string{"/api/v2/application_keys/key-1"}, deleted)
+	})
+
+	t.Run("Scoped keys", func(t *testing.T) {
+		deleted = nil
+		err := c.WithTemporaryScopedAppKey(context.Background(), []string{"monitors_read"}, func(tmp *Client) error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `["monitors_read"]`, scopes)
+		assert.Equal(t, []string{"/api/v2/application_keys/key-1"}, deleted)
+	})
+
+	t.Run("Scoped keys need a scope", func(t *testing.T) {
+		deleted = nil
+		err := c.WithTemporaryScopedAppKey(context.Background(), nil, func(tmp *Client) error {
+			t.Fatal("fn called without scopes")
+			return nil
+		})
+		assert.NotNil(t, err)
+		assert.Empty(t, deleted)
+	})
+}
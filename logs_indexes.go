@@ -0,0 +1,112 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// LogsIndex is an index storing the logs matching its filter.
+type LogsIndex struct {
+	Name             *string              `json:"name,omitempty"`
+	Filter           *FilterConfiguration `json:"filter,omitempty"`
+	NumRetentionDays *int                 `json:"num_retention_days,omitempty"`
+	DailyLimit       *int                 `json:"daily_limit,omitempty"`
+	IsRateLimited    *bool                `json:"is_rate_limited,omitempty"`
+	ExclusionFilters []ExclusionFilter    `json:"exclusion_filters,omitempty"`
+}
+
+// ExclusionFilter excludes a sample of the logs matching its filter from an
+// index.
+type ExclusionFilter struct {
+	Name      *string               `json:"name,omitempty"`
+	IsEnabled *bool                 `json:"is_enabled,omitempty"`
+	Filter    *ExclusionFilterQuery `json:"filter,omitempty"`
+}
+
+// ExclusionFilterQuery selects the logs excluded by an ExclusionFilter.
+// SampleRate is the fraction of these logs which is excluded, from 0 to 1.
+type ExclusionFilterQuery struct {
+	Query      *string  `json:"query,omitempty"`
+	SampleRate *float64 `json:"sample_rate,omitempty"`
+}
+
+// LogsIndexOrder is the order in which indexes are matched against logs.
+type LogsIndexOrder struct {
+	IndexNames []string `json:"index_names"`
+}
+
+// reqLogsIndexes is the container for receiving all indexes.
+type reqLogsIndexes struct {
+	Indexes []LogsIndex `json:"indexes"`
+}
+
+// logsIndexURI returns the API path of an index.
+func logsIndexURI(name string) string {
+	return fmt.Sprintf("/v1/logs/config/indexes/%s", url.PathEscape(name))
+}
+
+// GetLogsIndexes returns a slice of all indexes.
+func (client *Client) GetLogsIndexes() ([]LogsIndex, error) {
+	var out reqLogsIndexes
+	if err := client.doJsonRequest("GET", "/v1/logs/config/indexes", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Indexes, nil
+}
+
+// GetLogsIndex retrieves an index by name.
+func (client *Client) GetLogsIndex(name string) (*LogsIndex, error) {
+	var out LogsIndex
+	if err := client.doJsonRequest("GET", logsIndexURI(name), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLogsIndex replaces the settings of the named index. The name of an
+// index can't be changed, so the Name of the given index is ignored, like
+// IsRateLimited, which is managed by Datadog.
+func (client *Client) UpdateLogsIndex(name string, index *LogsIndex) (*LogsIndex, error) {
+	var out LogsIndex
+	if err := client.doJsonRequest("PUT", logsIndexURI(name), logsIndexToSend(index), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// logsIndexToSend returns the index to send to the API on update, without
+// its name and the fields managed by Datadog. The index itself is left
+// untouched.
+func logsIndexToSend(index *LogsIndex) *LogsIndex {
+	writable := *index
+	writable.Name = nil
+	writable.IsRateLimited = nil
+	return &writable
+}
+
+// GetLogsIndexOrder returns the order of the indexes.
+func (client *Client) GetLogsIndexOrder() (*LogsIndexOrder, error) {
+	var out LogsIndexOrder
+	if err := client.doJsonRequest("GET", "/v1/logs/config/index-order", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLogsIndexOrder changes the order of the indexes. The order must list
+// all the indexes.
+func (client *Client) UpdateLogsIndexOrder(order *LogsIndexOrder) (*LogsIndexOrder, error) {
+	var out LogsIndexOrder
+	if err := client.doJsonRequest("PUT", "/v1/logs/config/index-order", order, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
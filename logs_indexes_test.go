@@ -0,0 +1,61 @@
+package datadog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsIndex(t *testing.T) {
+	const index = `{
+		"name": "main",
+		"filter": {"query": "*"},
+		"num_retention_days": 15,
+		"daily_limit": 1000000,
+		"is_rate_limited": false,
+		"exclusion_filters": [
+			{"name": "debug", "is_enabled": true, "filter": {"query": "status:debug", "sample_rate": 0.9}},
+			{"name": "health checks", "is_enabled": false, "filter": {"query": "@http.url:/health", "sample_rate": 1}}
+		]
+	}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/logs/config/indexes/main", r.URL.Path)
+		if r.Method == "PUT" {
+			body, _ := ioutil.ReadAll(r.Body)
+			var expected map[string]interface{}
+			assert.Nil(t, json.Unmarshal([]byte(index), &expected))
+			delete(expected, "name")
+			delete(expected, "is_rate_limited")
+			want, _ := json.Marshal(expected)
+			assert.JSONEq(t, string(want), string(body))
+		}
+		w.Write([]byte(index))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	idx, err := c.GetLogsIndex("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 15, idx.GetNumRetentionDays())
+	if assert.Len(t, idx.ExclusionFilters, 2) {
+		assert.Equal(t, 0.9, idx.ExclusionFilters[0].Filter.GetSampleRate())
+		assert.False(t, idx.ExclusionFilters[1].GetIsEnabled())
+	}
+
+	updated, err := c.UpdateLogsIndex("main", idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "main", updated.GetName())
+	assert.Equal(t, "main", idx.GetName())
+	assert.True(t, idx.HasIsRateLimited())
+}
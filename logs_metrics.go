@@ -0,0 +1,116 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+)
+
+// LogsMetric is a metric generated from the logs matching its filter. Its
+// identifier is the name of the metric.
+type LogsMetric struct {
+	Id      *string              `json:"-"`
+	Compute *LogsMetricCompute   `json:"compute,omitempty"`
+	Filter  *FilterConfiguration `json:"filter,omitempty"`
+	GroupBy []LogsMetricGroupBy  `json:"group_by,omitempty"`
+}
+
+// LogsMetricCompute tells how the metric is computed. Path is the attribute
+// holding the value of distribution metrics.
+type LogsMetricCompute struct {
+	AggregationType *string `json:"aggregation_type,omitempty"`
+	Path            *string `json:"path,omitempty"`
+}
+
+// LogsMetricGroupBy tags the metric with the value of a log attribute.
+type LogsMetricGroupBy struct {
+	Path    *string `json:"path,omitempty"`
+	TagName *string `json:"tag_name,omitempty"`
+}
+
+// logsMetricData is the resource envelope used by the logs metrics API.
+type logsMetricData struct {
+	Id         *string     `json:"id,omitempty"`
+	Type       string      `json:"type"`
+	Attributes *LogsMetric `json:"attributes,omitempty"`
+}
+
+// reqLogsMetric is the container for sending and receiving a single metric.
+type reqLogsMetric struct {
+	Data *logsMetricData `json:"data"`
+}
+
+// reqLogsMetrics is the container for receiving many metrics.
+type reqLogsMetrics struct {
+	Data []logsMetricData `json:"data"`
+}
+
+func (d *logsMetricData) logsMetric() *LogsMetric {
+	if d.Attributes == nil {
+		d.Attributes = &LogsMetric{}
+	}
+	d.Attributes.Id = d.Id
+	return d.Attributes
+}
+
+// CreateLogsMetric adds a new logs metric to the system. This returns a
+// pointer to a LogsMetric so you can pass that to UpdateLogsMetric later if
+// needed.
+func (client *Client) CreateLogsMetric(metric *LogsMetric) (*LogsMetric, error) {
+	var out reqLogsMetric
+	in := reqLogsMetric{Data: &logsMetricData{Id: metric.Id, Type: "logs_metrics", Attributes: metric}}
+	if err := client.doJsonRequest("POST", "/v2/logs/config/metrics", in, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no logs metric returned")
+	}
+	return out.Data.logsMetric(), nil
+}
+
+// UpdateLogsMetric takes a logs metric that was previously retrieved through
+// some method and sends it back to the server. The way a metric is computed
+// can't be changed, so only its filter and group by are updated.
+func (client *Client) UpdateLogsMetric(metric *LogsMetric) error {
+	attributes := &LogsMetric{Filter: metric.Filter, GroupBy: metric.GroupBy}
+	in := reqLogsMetric{Data: &logsMetricData{Type: "logs_metrics", Attributes: attributes}}
+	return client.doJsonRequest("PATCH", fmt.Sprintf("/v2/logs/config/metrics/%s", metric.GetId()),
+		in, nil)
+}
+
+// GetLogsMetric retrieves a logs metric by identifier.
+func (client *Client) GetLogsMetric(id string) (*LogsMetric, error) {
+	var out reqLogsMetric
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v2/logs/config/metrics/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no logs metric returned")
+	}
+	return out.Data.logsMetric(), nil
+}
+
+// DeleteLogsMetric removes a logs metric from the system.
+func (client *Client) DeleteLogsMetric(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/logs/config/metrics/%s", id),
+		nil, nil)
+}
+
+// GetLogsMetrics returns a slice of all logs metrics.
+func (client *Client) GetLogsMetrics() ([]LogsMetric, error) {
+	var out reqLogsMetrics
+	if err := client.doJsonRequest("GET", "/v2/logs/config/metrics", nil, &out); err != nil {
+		return nil, err
+	}
+	metrics := make([]LogsMetric, 0, len(out.Data))
+	for i := range out.Data {
+		metrics = append(metrics, *out.Data[i].logsMetric())
+	}
+	return metrics, nil
+}
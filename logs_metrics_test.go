@@ -0,0 +1,51 @@
+package datadog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			assert.Equal(t, "/api/v2/logs/config/metrics", r.URL.Path)
+			w.Write([]byte(`{"data": [{
+				"id": "nginx.requests",
+				"type": "logs_metrics",
+				"attributes": {
+					"compute": {"aggregation_type": "count"},
+					"filter": {"query": "source:nginx"},
+					"group_by": [{"path": "@http.status_code", "tag_name": "status_code"}]
+				}
+			}]}`))
+		case "PATCH":
+			assert.Equal(t, "/api/v2/logs/config/metrics/nginx.requests", r.URL.Path)
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data": {"type": "logs_metrics", "attributes": {"filter": {"query": "source:nginx env:prod"}, "group_by": [{"path": "@http.status_code", "tag_name": "status_code"}]}}}`, string(body))
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	metrics, err := c.GetLogsMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, metrics, 1) {
+		metric := metrics[0]
+		assert.Equal(t, "nginx.requests", metric.GetId())
+		assert.Equal(t, "count", metric.Compute.GetAggregationType())
+		assert.Equal(t, "status_code", metric.GroupBy[0].GetTagName())
+
+		metric.Filter.SetQuery("source:nginx env:prod")
+		assert.Nil(t, c.UpdateLogsMetric(&metric))
+	}
+}
@@ -0,0 +1,293 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	GrokParserType        = "grok-parser"
+	DateRemapperType      = "date-remapper"
+	CategoryProcessorType = "category-processor"
+	NestedPipelineType    = "pipeline"
+)
+
+// LogsPipeline is a pipeline of processors applied to the logs matching its
+// filter.
+type LogsPipeline struct {
+	Id         *string              `json:"id,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	IsEnabled  *bool                `json:"is_enabled,omitempty"`
+	IsReadOnly *bool                `json:"is_read_only,omitempty"`
+	Filter     *FilterConfiguration `json:"filter,omitempty"`
+	Processors []LogsProcessor      `json:"processors,omitempty"`
+}
+
+// FilterConfiguration is a logs search query selecting the logs to process.
+type FilterConfiguration struct {
+	Query *string `json:"query,omitempty"`
+}
+
+// LogsProcessor is a single processor of a pipeline. Type selects which of
+// the definitions is used. Decoded processors keep their JSON in Raw, so the
+// processors of a type this library doesn't know about, and the fields it
+// doesn't model, survive being sent back.
+type LogsProcessor struct {
+	Type      *string
+	Name      *string
+	IsEnabled *bool
+
+	GrokParser        *GrokParser
+	DateRemapper      *DateRemapper
+	CategoryProcessor *CategoryProcessor
+	NestedPipeline    *NestedPipeline
+
+	// Raw holds the JSON of the processor.
+	Raw json.RawMessage
+}
+
+// GrokParser extracts attributes from a log attribute with grok rules.
+type GrokParser struct {
+	Source   *string   `json:"source,omitempty"`
+	Samples  []string  `json:"samples,omitempty"`
+	GrokRule *GrokRule `json:"grok,omitempty"`
+}
+
+// GrokRule holds the rules of a GrokParser.
+type GrokRule struct {
+	SupportRules *string `json:"support_rules,omitempty"`
+	MatchRules   *string `json:"match_rules,omitempty"`
+}
+
+// DateRemapper defines the official timestamp of logs from their attributes.
+type DateRemapper struct {
+	Sources []string `json:"sources,omitempty"`
+}
+
+// CategoryProcessor sets Target to the name of the first category matching
+// a log.
+type CategoryProcessor struct {
+	Target     *string    `json:"target,omitempty"`
+	Categories []Category `json:"categories,omitempty"`
+}
+
+// Category is a named filter of a CategoryProcessor.
+type Category struct {
+	Name   *string              `json:"name,omitempty"`
+	Filter *FilterConfiguration `json:"filter,omitempty"`
+}
+
+// NestedPipeline is a pipeline used as a processor of another pipeline.
+type NestedPipeline struct {
+	Filter     *FilterConfiguration `json:"filter,omitempty"`
+	Processors []LogsProcessor      `json:"processors,omitempty"`
+}
+
+// logsProcessorCommon holds the fields shared by all processors.
+type logsProcessorCommon struct {
+	Type      *string `json:"type,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	IsEnabled *bool   `json:"is_enabled,omitempty"`
+}
+
+// definition returns the definition matching the type of the processor, or
+// nil if it isn't set.
+func (p LogsProcessor) definition() interface{} {
+	switch {
+	case p.GetType() == GrokParserType && p.GrokParser != nil:
+		return p.GrokParser
+	case p.GetType() == DateRemapperType && p.DateRemapper != nil:
+		return p.DateRemapper
+	case p.GetType() == CategoryProcessorType && p.CategoryProcessor != nil:
+		return p.CategoryProcessor
+	case p.GetType() == NestedPipelineType && p.NestedPipeline != nil:
+		return p.NestedPipeline
+	}
+	return nil
+}
+
+// MarshalJSON encodes the common fields together with the definition
+// matching the processor type, over the raw JSON of the processor so the
+// fields this library doesn't model survive.
+func (p LogsProcessor) MarshalJSON() ([]byte, error) {
+	definition := p.definition()
+	if definition == nil && p.Raw == nil {
+		return nil, fmt.Errorf("logs processor of type %q has no definition", p.GetType())
+	}
+	fields := map[string]json.RawMessage{}
+	if p.Raw != nil {
+		if err := json.Unmarshal(p.Raw, &fields); err != nil {
+			return nil, err
+		}
+	}
+	if definition != nil {
+		if err := mergeJSONObject(fields, definition); err != nil {
+			return nil, err
+		}
+	}
+
+	common := logsProcessorCommon{Type: p.Type, Name: p.Name, IsEnabled: p.IsEnabled}
+	if err := mergeJSONObject(fields, common); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the processor into the definition matching its type.
+func (p *LogsProcessor) UnmarshalJSON(data []byte) error {
+	var common logsProcessorCommon
+	if err := json.Unmarshal(data, &common); err != nil {
+		return err
+	}
+
+	*p = LogsProcessor{
+		Type:      common.Type,
+		Name:      common.Name,
+		IsEnabled: common.IsEnabled,
+		Raw:       append(json.RawMessage(nil), data...),
+	}
+
+	var target interface{}
+	switch p.GetType() {
+	case GrokParserType:
+		p.GrokParser = &GrokParser{}
+		target = p.GrokParser
+	case DateRemapperType:
+		p.DateRemapper = &DateRemapper{}
+		target = p.DateRemapper
+	case CategoryProcessorType:
+		p.CategoryProcessor = &CategoryProcessor{}
+		target = p.CategoryProcessor
+	case NestedPipelineType:
+		p.NestedPipeline = &NestedPipeline{}
+		target = p.NestedPipeline
+	default:
+		return nil
+	}
+	return json.Unmarshal(data, target)
+}
+
+// mergeJSONObject adds the fields of v, which must encode to a JSON object,
+// to fields. The fields v models are removed first, so the ones left unset in
+// v are cleared rather than kept from fields.
+func mergeJSONObject(fields map[string]json.RawMessage, v interface{}) error {
+	for _, name := range jsonFieldNames(reflect.TypeOf(v)) {
+		delete(fields, name)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var more map[string]json.RawMessage
+	if err := json.Unmarshal(b, &more); err != nil {
+		return err
+	}
+	for k, field := range more {
+		fields[k] = field
+	}
+	return nil
+}
+
+// jsonFieldNames returns the JSON names of the fields of the struct type t,
+// or of the struct t points to.
+func jsonFieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// LogsPipelineOrder is the order in which pipelines are applied to logs.
+type LogsPipelineOrder struct {
+	PipelineIds []string `json:"pipeline_ids"`
+}
+
+// CreateLogsPipeline adds a new pipeline to the system. This returns a
+// pointer to a LogsPipeline so you can pass that to UpdateLogsPipeline later
+// if needed.
+func (client *Client) CreateLogsPipeline(pipeline *LogsPipeline) (*LogsPipeline, error) {
+	var out LogsPipeline
+	if err := client.doJsonRequest("POST", "/v1/logs/config/pipelines", pipeline, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLogsPipeline takes a pipeline that was previously retrieved through
+// some method and sends it back to the server.
+func (client *Client) UpdateLogsPipeline(pipeline *LogsPipeline) error {
+	return client.doJsonRequest("PUT", fmt.Sprintf("/v1/logs/config/pipelines/%s", pipeline.GetId()),
+		pipeline, nil)
+}
+
+// GetLogsPipeline retrieves a pipeline by identifier.
+func (client *Client) GetLogsPipeline(id string) (*LogsPipeline, error) {
+	var out LogsPipeline
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v1/logs/config/pipelines/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteLogsPipeline removes a pipeline from the system.
+func (client *Client) DeleteLogsPipeline(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v1/logs/config/pipelines/%s", id),
+		nil, nil)
+}
+
+// GetLogsPipelines returns a slice of all pipelines.
+func (client *Client) GetLogsPipelines() ([]LogsPipeline, error) {
+	var out []LogsPipeline
+	if err := client.doJsonRequest("GET", "/v1/logs/config/pipelines", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetLogsPipelineOrder returns the order of the pipelines.
+func (client *Client) GetLogsPipelineOrder() (*LogsPipelineOrder, error) {
+	var out LogsPipelineOrder
+	if err := client.doJsonRequest("GET", "/v1/logs/config/pipeline-order", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLogsPipelineOrder changes the order of the pipelines. The order must
+// list all the pipelines.
+func (client *Client) UpdateLogsPipelineOrder(order *LogsPipelineOrder) (*LogsPipelineOrder, error) {
+	var out LogsPipelineOrder
+	if err := client.doJsonRequest("PUT", "/v1/logs/config/pipeline-order", order, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
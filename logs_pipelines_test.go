@@ -0,0 +1,90 @@
+package datadog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsPipelineSerialization(t *testing.T) {
+	raw := `{
+		"id": "abc",
+		"type": "pipeline",
+		"name": "nginx",
+		"is_enabled": true,
+		"filter": {"query": "source:nginx"},
+		"processors": [
+			{"type": "grok-parser", "name": "parse", "is_enabled": true, "source": "message", "samples": ["GET /"], "grok": {"support_rules": "", "match_rules": "rule %{word:verb}"}},
+			{"type": "date-remapper", "name": "date", "is_enabled": false, "sources": ["timestamp"]},
+			{"type": "pipeline", "name": "nested", "is_enabled": true, "filter": {"query": "status:error"}, "processors": [
+				{"type": "category-processor", "name": "categories", "target": "severity", "categories": [{"name": "high", "filter": {"query": "@http.status_code:500"}}]}
+			]},
+			{"type": "geo-ip-parser", "name": "geo", "is_enabled": true, "sources": ["network.client.ip"], "target": "network.client.geoip"}
+		]
+	}`
+
+	var pipeline LogsPipeline
+	if err := json.Unmarshal([]byte(raw), &pipeline); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, pipeline.Processors, 4) {
+		assert.Equal(t, "rule %{word:verb}", pipeline.Processors[0].GrokParser.GrokRule.GetMatchRules())
+		assert.Equal(t, []string{"timestamp"}, pipeline.Processors[1].DateRemapper.Sources)
+		assert.False(t, pipeline.Processors[1].GetIsEnabled())
+
+		nested := pipeline.Processors[2].NestedPipeline
+		assert.Equal(t, "status:error", nested.Filter.GetQuery())
+		assert.Equal(t, "severity", nested.Processors[0].CategoryProcessor.GetTarget())
+
+		assert.Equal(t, "geo-ip-parser", pipeline.Processors[3].GetType())
+		assert.Nil(t, pipeline.Processors[3].GrokParser)
+	}
+
+	t.Run("Pipelines round trip", func(t *testing.T) {
+		b, err := json.Marshal(pipeline)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, raw, string(b))
+	})
+
+	t.Run("Common fields of unknown processors can be changed", func(t *testing.T) {
+		p := pipeline.Processors[3]
+		p.IsEnabled = Bool(false)
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"type": "geo-ip-parser", "name": "geo", "is_enabled": false, "sources": ["network.client.ip"], "target": "network.client.geoip"}`, string(b))
+	})
+	t.Run("Unmodeled fields of known processors survive changes", func(t *testing.T) {
+		var p LogsProcessor
+		err := json.Unmarshal([]byte(`{"type": "date-remapper", "name": "date", "sources": ["timestamp"], "preserve_source": true}`), &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.DateRemapper.Sources = []string{"timestamp", "date"}
+
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"type": "date-remapper", "name": "date", "sources": ["timestamp", "date"], "preserve_source": true}`, string(b))
+	})
+	t.Run("Cleared fields of known processors are removed", func(t *testing.T) {
+		var p LogsProcessor
+		err := json.Unmarshal([]byte(`{"type": "grok-parser", "name": "grok", "source": "message", "samples": ["a sample"], "grok": {"match_rules": "rule %{word:w}"}}`), &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.GrokParser.Samples = nil
+
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"type": "grok-parser", "name": "grok", "source": "message", "grok": {"match_rules": "rule %{word:w}"}}`, string(b))
+	})
+}
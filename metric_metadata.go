@@ -8,7 +8,7 @@
 
 package datadog
 
-import "fmt"
+import "net/url"
 
 // MetricMetadata allows you to edit fields of a metric's metadata.
 type MetricMetadata struct {
@@ -20,20 +20,33 @@ type MetricMetadata struct {
 	StatsdInterval *int    `json:"statsd_interval,omitempty"`
 }
 
-// ViewMetricMetadata allows you to get metadata about a specific metric.
-func (client *Client) ViewMetricMetadata(mn string) (*MetricMetadata, error) {
+// GetMetricMetadata allows you to get metadata about a specific metric.
+func (client *Client) GetMetricMetadata(mn string) (*MetricMetadata, error) {
 	var out MetricMetadata
-	if err := client.doJsonRequest("GET", fmt.Sprintf("/v1/metrics/%s", mn), nil, &out); err != nil {
+	if err := client.doJsonRequest("GET", metricMetadataURI(mn), nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
+// ViewMetricMetadata allows you to get metadata about a specific metric.
+//
+// DEPRECATED: use GetMetricMetadata instead.
+func (client *Client) ViewMetricMetadata(mn string) (*MetricMetadata, error) {
+	return client.GetMetricMetadata(mn)
+}
+
 // EditMetricMetadata edits the metadata for the given metric.
 func (client *Client) EditMetricMetadata(mn string, mm *MetricMetadata) (*MetricMetadata, error) {
 	var out MetricMetadata
-	if err := client.doJsonRequest("PUT", fmt.Sprintf("/v1/metrics/%s", mn), mm, &out); err != nil {
+	if err := client.doJsonRequest("PUT", metricMetadataURI(mn), mm, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
+
+// metricMetadataURI returns the API path for a metric. Metric names are
+// escaped, as they may contain characters like slashes.
+func metricMetadataURI(mn string) string {
+	return "/v1/metrics/" + url.PathEscape(mn)
+}
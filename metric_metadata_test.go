@@ -0,0 +1,33 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricMetadataEscapesName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/metrics/custom.app%2Frequests%3Fcount", r.URL.EscapedPath())
+		assert.Equal(t, "sample_api_key", r.URL.Query().Get("api_key"))
+		w.Write([]byte(`{"type": "count", "unit": "request", "short_name": "reqs"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	mm, err := c.GetMetricMetadata("custom.app/requests?count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "request", mm.GetUnit())
+
+	mm, err = c.EditMetricMetadata("custom.app/requests?count", &MetricMetadata{Unit: String("request")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "reqs", mm.GetShortName())
+}
@@ -0,0 +1,183 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMetricFlushInterval is the interval between the flushes of a
+	// MetricSubmitter when MetricSubmitterOptions.FlushInterval isn't set.
+	DefaultMetricFlushInterval = 10 * time.Second
+	// DefaultMetricFlushSize is the number of buffered metrics which triggers
+	// a flush when MetricSubmitterOptions.FlushSize isn't set.
+	DefaultMetricFlushSize = 1000
+	// DefaultMetricBufferSize is the number of metrics a MetricSubmitter
+	// buffers when MetricSubmitterOptions.BufferSize isn't set.
+	DefaultMetricBufferSize = 10000
+)
+
+// ErrMetricBufferFull is returned by MetricSubmitter.Submit when the metric
+// was dropped because the buffer is full.
+var ErrMetricBufferFull = errors.New("metric buffer is full, metric dropped")
+
+// ErrMetricSubmitterClosed is returned by MetricSubmitter.Submit once the
+// submitter is closed.
+var ErrMetricSubmitterClosed = errors.New("metric submitter is closed")
+
+// MetricSubmitterOptions configures a MetricSubmitter. Zero values keep the
+// defaults.
+type MetricSubmitterOptions struct {
+	// FlushInterval is the interval between flushes.
+	FlushInterval time.Duration
+	// FlushSize is the number of buffered metrics which triggers a flush
+	// before the interval elapses. It is lowered to BufferSize if it is
+	// larger, as the buffer could never reach it.
+	FlushSize int
+	// BufferSize is the number of metrics buffered until they are flushed.
+	// Metrics submitted while the buffer is full are dropped.
+	BufferSize int
+	// OnError is called with the errors of the flushes made in the
+	// background.
+	OnError func(error)
+}
+
+// MetricSubmitter buffers metrics and posts them in the background, in
+// batches compressed with gzip. It is safe for concurrent use. Close must be
+// called to flush the remaining metrics and stop it.
+type MetricSubmitter struct {
+	// post sends a batch of metrics.
+	post    func([]Metric) error
+	options MetricSubmitterOptions
+
+	mu      sync.Mutex
+	buffer  []Metric
+	dropped int
+	closed  bool
+
+	// flushMu makes flushes happen one at a time.
+	flushMu sync.Mutex
+	full    chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewMetricSubmitter returns a MetricSubmitter which posts metrics with
+// client.
+func NewMetricSubmitter(client *Client, options MetricSubmitterOptions) *MetricSubmitter {
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = DefaultMetricFlushInterval
+	}
+	if options.FlushSize <= 0 {
+		options.FlushSize = DefaultMetricFlushSize
+	}
+	if options.BufferSize <= 0 {
+		options.BufferSize = DefaultMetricBufferSize
+	}
+	if options.FlushSize > options.BufferSize {
+		options.FlushSize = options.BufferSize
+	}
+	s := &MetricSubmitter{
+		post: func(batch []Metric) error {
+			return client.doJsonRequest("POST", "/v1/series",
+				gzipBody{reqPostSeries{Series: batch}}, nil)
+		},
+		options: options,
+		full:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+// run flushes the buffer every interval, or when it reaches the flush size,
+// until the submitter is closed.
+func (s *MetricSubmitter) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.options.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		case <-s.full:
+		}
+		if err := s.Flush(); err != nil && s.options.OnError != nil {
+			s.options.OnError(err)
+		}
+	}
+}
+
+// Submit adds a metric to the buffer. ErrMetricBufferFull is returned, and
+// the metric dropped, if the buffer is full.
+func (s *MetricSubmitter) Submit(metric Metric) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrMetricSubmitterClosed
+	}
+	if len(s.buffer) >= s.options.BufferSize {
+		s.dropped++
+		return ErrMetricBufferFull
+	}
+	s.buffer = append(s.buffer, metric)
+	if len(s.buffer) >= s.options.FlushSize {
+		select {
+		case s.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Dropped returns the number of metrics dropped so far because the buffer
+// was full.
+func (s *MetricSubmitter) Dropped() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+// Flush posts the buffered metrics now, in batches of at most
+// MaxMetricsPayloadSize before compression. The errors of the batches are
+// returned together as a *MultiError.
+func (s *MetricSubmitter) Flush() error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	series := s.buffer
+	s.buffer = nil
+	s.mu.Unlock()
+
+	if len(series) == 0 {
+		return nil
+	}
+	return postMetricsBatched(series, MaxMetricsPayloadSize, s.post)
+}
+
+// Close stops the submitter and flushes the remaining metrics.
+func (s *MetricSubmitter) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	close(s.done)
+	s.wg.Wait()
+	return s.Flush()
+}
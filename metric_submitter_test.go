@@ -0,0 +1,99 @@
+package datadog
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricSubmitter(t *testing.T) {
+	var mu sync.Mutex
+	var received []Metric
+	posting := make(chan struct{}, 10)
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/series", r.URL.Path)
+		assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		gz, err := gzip.NewReader(r.Body)
+		if !assert.Nil(t, err) {
+			return
+		}
+		var in reqPostSeries
+		assert.Nil(t, json.NewDecoder(gz).Decode(&in))
+		mu.Lock()
+		received = append(received, in.Series...)
+		mu.Unlock()
+		posting <- struct{}{}
+		<-release
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	// The flush size is lowered to the buffer size, so filling the buffer
+	// flushes it.
+	s := NewMetricSubmitter(c, MetricSubmitterOptions{FlushInterval: time.Hour, BufferSize: 2})
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.a")}))
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.b")}))
+	select {
+	case <-posting:
+	case <-time.After(5 * time.Second):
+		t.Fatal("metrics were not flushed")
+	}
+
+	// The buffer fills up again while the flush is in flight.
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.c")}))
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.d")}))
+	assert.Equal(t, ErrMetricBufferFull, s.Submit(Metric{Metric: String("app.e")}))
+	assert.Equal(t, 1, s.Dropped())
+
+	close(release)
+	assert.Nil(t, s.Close())
+	assert.Equal(t, ErrMetricSubmitterClosed, s.Submit(Metric{Metric: String("app.f")}))
+
+	mu.Lock()
+	defer mu.Unlock()
+	var names []string
+	for _, m := range received {
+		names = append(names, m.GetMetric())
+	}
+	assert.Equal(t, []string{"app.a", "app.b", "app.c", "app.d"}, names)
+}
+
+func TestMetricSubmitterFlushSize(t *testing.T) {
+	posted := make(chan int, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gz, err := gzip.NewReader(r.Body)
+		if !assert.Nil(t, err) {
+			return
+		}
+		var in reqPostSeries
+		assert.Nil(t, json.NewDecoder(gz).Decode(&in))
+		posted <- len(in.Series)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	s := NewMetricSubmitter(c, MetricSubmitterOptions{FlushInterval: time.Hour, FlushSize: 2})
+	defer s.Close()
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.a")}))
+	assert.Nil(t, s.Submit(Metric{Metric: String("app.b")}))
+
+	select {
+	case n := <-posted:
+		assert.Equal(t, 2, n)
+	case <-time.After(5 * time.Second):
+		t.Fatal("metrics were not flushed")
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MetricTagConfiguration restricts the tags a metric can be queried by. Its
+// identifier is the name of the metric.
+type MetricTagConfiguration struct {
+	Metric             *string             `json:"-"`
+	Tags               []string            `json:"tags,omitempty"`
+	MetricType         *string             `json:"metric_type,omitempty"`
+	IncludePercentiles *bool               `json:"include_percentiles,omitempty"`
+	Aggregations       []MetricAggregation `json:"aggregations,omitempty"`
+	CreatedAt          *string             `json:"created_at,omitempty"`
+	ModifiedAt         *string             `json:"modified_at,omitempty"`
+}
+
+// MetricAggregation is a combination of time and space aggregation the metric
+// can be queried with, e.g. {"time": "avg", "space": "max"}.
+type MetricAggregation struct {
+	Time  *string `json:"time,omitempty"`
+	Space *string `json:"space,omitempty"`
+}
+
+// metricTagConfigurationData is the resource envelope used by the metric tag
+// configuration API.
+type metricTagConfigurationData struct {
+	Id         *string                 `json:"id,omitempty"`
+	Type       string                  `json:"type"`
+	Attributes *MetricTagConfiguration `json:"attributes,omitempty"`
+}
+
+// reqMetricTagConfiguration is the container for sending and receiving a
+// single tag configuration.
+type reqMetricTagConfiguration struct {
+	Data *metricTagConfigurationData `json:"data"`
+}
+
+// reqMetricTagConfigurations is the container for receiving many tag
+// configurations.
+type reqMetricTagConfigurations struct {
+	Data []metricTagConfigurationData `json:"data"`
+}
+
+func newMetricTagConfigurationData(config *MetricTagConfiguration, update bool) *metricTagConfigurationData {
+	return &metricTagConfigurationData{Id: config.Metric, Type: "manage_tags", Attributes: metricTagConfigurationToSend(config, update)}
+}
+
+// metricTagConfigurationToSend returns the tag configuration to send to the
+// API, without the fields managed by Datadog, nor the metric type when it is
+// updated, as it can't be changed. The configuration itself is left
+// untouched.
+func metricTagConfigurationToSend(config *MetricTagConfiguration, update bool) *MetricTagConfiguration {
+	writable := *config
+	writable.CreatedAt = nil
+	writable.ModifiedAt = nil
+	if update {
+		writable.MetricType = nil
+	}
+	return &writable
+}
+
+func (d *metricTagConfigurationData) metricTagConfiguration() *MetricTagConfiguration {
+	if d.Attributes == nil {
+		d.Attributes = &MetricTagConfiguration{}
+	}
+	d.Attributes.Metric = d.Id
+	return d.Attributes
+}
+
+// metricTagConfigurationURI returns the API path of the tag configuration of
+// a metric.
+func metricTagConfigurationURI(metric string) string {
+	return fmt.Sprintf("/v2/metrics/%s/tags", url.PathEscape(metric))
+}
+
+// CreateMetricTagConfiguration restricts the tags a metric can be queried by.
+// This returns a pointer to a MetricTagConfiguration so you can pass that to
+// UpdateMetricTagConfiguration later if needed.
+func (client *Client) CreateMetricTagConfiguration(config *MetricTagConfiguration) (*MetricTagConfiguration, error) {
+	var out reqMetricTagConfiguration
+	in := reqMetricTagConfiguration{Data: newMetricTagConfigurationData(config, false)}
+	if err := client.doJsonRequest("POST", metricTagConfigurationURI(config.GetMetric()), in, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no metric tag configuration returned")
+	}
+	return out.Data.metricTagConfiguration(), nil
+}
+
+// UpdateMetricTagConfiguration takes a tag configuration that was previously
+// retrieved through some method and sends it back to the server.
+func (client *Client) UpdateMetricTagConfiguration(config *MetricTagConfiguration) error {
+	in := reqMetricTagConfiguration{Data: newMetricTagConfigurationData(config, true)}
+	return client.doJsonRequest("PATCH", metricTagConfigurationURI(config.GetMetric()), in, nil)
+}
+
+// GetMetricTagConfiguration retrieves the tag configuration of a metric.
+func (client *Client) GetMetricTagConfiguration(metric string) (*MetricTagConfiguration, error) {
+	var out reqMetricTagConfiguration
+	if err := client.doJsonRequest("GET", metricTagConfigurationURI(metric), nil, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no metric tag configuration returned")
+	}
+	return out.Data.metricTagConfiguration(), nil
+}
+
+// DeleteMetricTagConfiguration removes the tag configuration of a metric, so
+// it can be queried by all its tags again.
+func (client *Client) DeleteMetricTagConfiguration(metric string) error {
+	return client.doJsonRequest("DELETE", metricTagConfigurationURI(metric), nil, nil)
+}
+
+// ListTagConfigurations returns a slice of the tag configurations of all
+// metrics which have one.
+func (client *Client) ListTagConfigurations() ([]MetricTagConfiguration, error) {
+	v := url.Values{}
+	v.Add("filter[configured]", "true")
+
+	var out reqMetricTagConfigurations
+	if err := client.doJsonRequest("GET", "/v2/metrics?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	configs := make([]MetricTagConfiguration, 0, len(out.Data))
+	for i := range out.Data {
+		configs = append(configs, *out.Data[i].metricTagConfiguration())
+	}
+	return configs, nil
+}
@@ -0,0 +1,70 @@
+package datadog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricTagConfiguration(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/metrics/app.requests%2Fsec/tags", r.URL.EscapedPath())
+		switch r.Method {
+		case "POST":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data": {
+				"id": "app.requests/sec",
+				"type": "manage_tags",
+				"attributes": {
+					"tags": ["env", "service"],
+					"metric_type": "distribution",
+					"include_percentiles": true,
+					"aggregations": [{"time": "sum", "space": "sum"}]
+				}
+			}}`, string(body))
+			w.Write(body)
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data": {
+				"id": "app.requests/sec",
+				"type": "manage_tags",
+				"attributes": {
+					"tags": ["env"],
+					"include_percentiles": true,
+					"aggregations": [{"time": "sum", "space": "sum"}]
+				}
+			}}`, string(body))
+			w.Write(body)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	config, err := c.CreateMetricTagConfiguration(&MetricTagConfiguration{
+		Metric:             String("app.requests/sec"),
+		Tags:               []string{"env", "service"},
+		MetricType:         String("distribution"),
+		IncludePercentiles: Bool(true),
+		Aggregations:       []MetricAggregation{{Time: String("sum"), Space: String("sum")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "app.requests/sec", config.GetMetric())
+	assert.Equal(t, []string{"env", "service"}, config.Tags)
+
+	config.Tags = []string{"env"}
+	config.CreatedAt = String("2020-01-01T00:00:00Z")
+	config.ModifiedAt = String("2020-01-01T00:05:00Z")
+	assert.Nil(t, c.UpdateMetricTagConfiguration(config))
+	assert.Equal(t, "distribution", config.GetMetricType())
+
+	assert.Nil(t, c.DeleteMetricTagConfiguration("app.requests/sec"))
+}
@@ -0,0 +1,69 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics receives measurements about the requests made by a client, e.g. to
+// export them as Prometheus counters and histograms. Its methods may be
+// called concurrently.
+type Metrics interface {
+	// IncRequest counts a request sent to the API, by the status code of its
+	// response. The status code is 0 when no response was received.
+	IncRequest(status int)
+	// IncRetry counts a request sent again after a failure.
+	IncRetry()
+	// IncRateLimited counts a request rejected because of the rate limit.
+	IncRateLimited()
+	// ObserveLatency records how long a request took.
+	ObserveLatency(d time.Duration)
+}
+
+// NoopMetrics is a Metrics which discards all measurements. It is used when
+// Client.Metrics isn't set.
+type NoopMetrics struct{}
+
+// IncRequest discards the request.
+func (NoopMetrics) IncRequest(status int) {}
+
+// IncRetry discards the retry.
+func (NoopMetrics) IncRetry() {}
+
+// IncRateLimited discards the rate limited request.
+func (NoopMetrics) IncRateLimited() {}
+
+// ObserveLatency discards the latency.
+func (NoopMetrics) ObserveLatency(d time.Duration) {}
+
+// metrics returns the Metrics of the client, or NoopMetrics if it isn't set.
+func (client *Client) metrics() Metrics {
+	if client.Metrics == nil {
+		return NoopMetrics{}
+	}
+	return client.Metrics
+}
+
+// observeRequest records req, which was sent at start and got resp, and the
+// rate limit reported by resp.
+func (client *Client) observeRequest(req *http.Request, start time.Time, resp *http.Response) {
+	m := client.metrics()
+	m.ObserveLatency(time.Since(start))
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+		client.recordRateLimit(req, resp)
+	}
+	m.IncRequest(status)
+	if status == http.StatusTooManyRequests {
+		m.IncRateLimited()
+	}
+}
@@ -0,0 +1,95 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingMetrics struct {
+	mu          sync.Mutex
+	requests    map[int]int
+	retries     int
+	rateLimited int
+	latencies   []time.Duration
+}
+
+func (m *countingMetrics) IncRequest(status int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[status]++
+}
+
+func (m *countingMetrics) IncRetry() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries++
+}
+
+func (m *countingMetrics) IncRateLimited() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rateLimited++
+}
+
+func (m *countingMetrics) ObserveLatency(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies = append(m.latencies, d)
+}
+
+func TestMetrics(t *testing.T) {
+	var statuses []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		w.Header().Set("X-RateLimit-Reset", "0")
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+
+	t.Run("Requests succeed without metrics", func(t *testing.T) {
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	})
+	t.Run("Requests and retries are counted", func(t *testing.T) {
+		m := &countingMetrics{requests: map[int]int{}}
+		c.Metrics = m
+		statuses = []int{http.StatusBadGateway, http.StatusServiceUnavailable}
+
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, map[int]int{502: 1, 503: 1, 200: 1}, m.requests)
+		assert.Equal(t, 2, m.retries)
+		assert.Len(t, m.latencies, 3)
+	})
+	t.Run("Rate limited requests are counted", func(t *testing.T) {
+		m := &countingMetrics{requests: map[int]int{}}
+		c.Metrics = m
+		c.OnRateLimited = func(rl RateLimit) error { return nil }
+		statuses = []int{http.StatusTooManyRequests}
+
+		assert.Nil(t, c.doJsonRequest("POST", "/v1/something", nil, nil))
+		assert.Equal(t, map[int]int{429: 1, 200: 1}, m.requests)
+		assert.Equal(t, 1, m.rateLimited)
+		assert.Equal(t, 1, m.retries)
+	})
+	t.Run("Transport errors are counted with no status", func(t *testing.T) {
+		m := &countingMetrics{requests: map[int]int{}}
+		c.Metrics = m
+		c.DisableRetries = true
+		c.SetBaseUrl("http://127.0.0.1:0")
+
+		assert.NotNil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+		assert.Equal(t, map[int]int{0: 1}, m.requests)
+	})
+}
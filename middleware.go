@@ -0,0 +1,39 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+)
+
+// Doer sends a request and returns its response. *http.Client satisfies it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is a function used as a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending the requests of a client, e.g. to add
+// headers, log requests or answer some of them without calling next.
+type Middleware func(next Doer) Doer
+
+// Use registers middlewares wrapping the requests sent by the client. The
+// middlewares registered first are the outermost ones. They wrap the retries
+// of a request, so they see a request once along with its final response,
+// while the Limiter, Metrics and DebugWriter of the client see every
+// attempt. Use must not be called while requests are in flight.
+func (client *Client) Use(middlewares ...Middleware) {
+	client.middlewares = append(client.middlewares, middlewares...)
+}
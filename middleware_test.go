@@ -0,0 +1,72 @@
+package datadog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// headerMiddleware is an example middleware adding a header to requests.
+func headerMiddleware(name, value string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(name, value)
+			return next.Do(req)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outer,inner", r.Header.Get("X-Order"))
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	var calls int32
+	c.Use(
+		headerMiddleware("X-Order", "outer"),
+		func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&calls, 1)
+				req.Header.Set("X-Order", req.Header.Get("X-Order")+",inner")
+				return next.Do(req)
+			})
+		},
+	)
+
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "expect middlewares to wrap the retries")
+
+	t.Run("Middlewares can answer requests", func(t *testing.T) {
+		c := c.Clone()
+		c.Use(func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"status": "cached"}`))),
+				}, nil
+			})
+		})
+
+		var out struct{ Status string }
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, &out))
+		assert.Equal(t, "cached", out.Status)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "expect no request to be sent")
+	})
+}
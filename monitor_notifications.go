@@ -0,0 +1,122 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// notificationHandleRegexp matches @handle mentions, including email
+	// addresses, which must not follow a word character.
+	notificationHandleRegexp = regexp.MustCompile(`(?:^|[^\w@.])(@[\w.+\-]+(?:@[\w\-]+(?:\.[\w\-]+)+)?)`)
+	// notificationSectionRegexp matches the tags opening and closing
+	// conditional sections, e.g. {{#is_alert}}, {{^is_warning}} and
+	// {{/is_alert}}.
+	notificationSectionRegexp = regexp.MustCompile(`\{\{\s*([#^/])\s*([^}]*?)\s*\}\}`)
+)
+
+// NotificationPreview lists who a monitor message notifies.
+type NotificationPreview struct {
+	// Targets are the handles mentioned in the message, in order.
+	Targets []NotificationTarget
+	// Conditions are the distinct conditions of the sections of the
+	// message, e.g. "is_alert" or "^is_warning" for a negated section.
+	Conditions []string
+}
+
+// NotificationTarget is a handle mentioned in a monitor message, e.g.
+// "@slack-ops" or "@jane@example.com". Conditions are the conditions of the
+// sections the mention is in, outermost first, e.g. ["is_alert"] for a
+// handle only notified when the monitor alerts. It's empty for handles
+// always notified.
+type NotificationTarget struct {
+	Handle     string
+	Conditions []string
+}
+
+// Handles returns the distinct handles of the targets, sorted.
+func (p *NotificationPreview) Handles() []string {
+	seen := map[string]bool{}
+	var handles []string
+	for _, target := range p.Targets {
+		if !seen[target.Handle] {
+			seen[target.Handle] = true
+			handles = append(handles, target.Handle)
+		}
+	}
+	sort.Strings(handles)
+	return handles
+}
+
+// PreviewMonitorNotification parses the message of a monitor to tell who it
+// notifies. Datadog has no API rendering messages, so this is done locally,
+// e.g. to check in CI that the right teams get paged.
+func PreviewMonitorNotification(monitor *Monitor) (*NotificationPreview, error) {
+	return ParseNotificationMessage(monitor.GetMessage())
+}
+
+// ParseNotificationMessage extracts the @handle mentions of a monitor message
+// along with the conditional sections, like {{#is_alert}}, they're in. An
+// error is returned if the sections aren't balanced.
+func ParseNotificationMessage(message string) (*NotificationPreview, error) {
+	preview := &NotificationPreview{}
+	seenConditions := map[string]bool{}
+	var open []string
+
+	sections := notificationSectionRegexp.FindAllStringSubmatchIndex(message, -1)
+	handles := notificationHandleRegexp.FindAllStringSubmatchIndex(message, -1)
+	for len(sections) > 0 || len(handles) > 0 {
+		if len(handles) > 0 && (len(sections) == 0 || handles[0][2] < sections[0][0]) {
+			handle := strings.TrimRight(message[handles[0][2]:handles[0][3]], ".")
+			handles = handles[1:]
+			preview.Targets = append(preview.Targets, NotificationTarget{
+				Handle:     handle,
+				Conditions: append([]string(nil), open...),
+			})
+			continue
+		}
+
+		kind := message[sections[0][2]:sections[0][3]]
+		condition := message[sections[0][4]:sections[0][5]]
+		sections = sections[1:]
+		if kind == "/" {
+			if len(open) == 0 || sectionName(open[len(open)-1]) != sectionName(condition) {
+				return nil, fmt.Errorf("unexpected {{/%s}}", condition)
+			}
+			open = open[:len(open)-1]
+			continue
+		}
+		if kind == "^" {
+			condition = "^" + condition
+		}
+		open = append(open, condition)
+		if !seenConditions[condition] {
+			seenConditions[condition] = true
+			preview.Conditions = append(preview.Conditions, condition)
+		}
+	}
+	if len(open) > 0 {
+		return nil, fmt.Errorf("unclosed section %q", open[len(open)-1])
+	}
+	return preview, nil
+}
+
+// sectionName returns the name of the condition of a section, without its
+// arguments or negation, e.g. "is_match" for `is_match "env" "prod"`.
+func sectionName(condition string) string {
+	condition = strings.TrimPrefix(condition, "^")
+	if i := strings.IndexAny(condition, " \t"); i >= 0 {
+		return condition[:i]
+	}
+	return condition
+}
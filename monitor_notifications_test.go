@@ -0,0 +1,37 @@
+package datadog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNotificationMessage(t *testing.T) {
+	const message = `CPU is high on {{host.name}}. Contact jane@example.com.
+{{#is_alert}}Paging @pagerduty-web and @ops@example.com.{{/is_alert}}
+{{^is_warning}}{{#is_match "env" "prod"}}@slack-prod{{/is_match}}{{/is_warning}}
+@slack-ops`
+
+	preview, err := ParseNotificationMessage(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []NotificationTarget{
+		{Handle: "@pagerduty-web", Conditions: []string{"is_alert"}},
+		{Handle: "@ops@example.com", Conditions: []string{"is_alert"}},
+		{Handle: "@slack-prod", Conditions: []string{"^is_warning", `is_match "env" "prod"`}},
+		{Handle: "@slack-ops"},
+	}, preview.Targets)
+	assert.Equal(t, []string{"is_alert", "^is_warning", `is_match "env" "prod"`}, preview.Conditions)
+	assert.Equal(t, []string{"@ops@example.com", "@pagerduty-web", "@slack-ops", "@slack-prod"}, preview.Handles())
+
+	monitor := &Monitor{Message: String("@slack-ops @slack-ops")}
+	preview, err = PreviewMonitorNotification(monitor)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"@slack-ops"}, preview.Handles())
+
+	_, err = ParseNotificationMessage("{{#is_alert}}@slack-ops")
+	assert.EqualError(t, err, `unclosed section "is_alert"`)
+	_, err = ParseNotificationMessage("{{#is_alert}}@slack-ops{{/is_warning}}")
+	assert.EqualError(t, err, "unexpected {{/is_warning}}")
+}
@@ -9,9 +9,15 @@
 package datadog
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -62,6 +68,12 @@ type Options struct {
 	RequireFullWindow *bool             `json:"require_full_window,omitempty"`
 	Locked            *bool             `json:"locked,omitempty"`
 	EnableLogsSample  *bool             `json:"enable_logs_sample,omitempty"`
+
+	// RenotifyStatuses are the states, "alert", "warn" or "no data", in which
+	// notifications are sent again every RenotifyInterval, at most
+	// RenotifyOccurrences times.
+	RenotifyStatuses    *[]string `json:"renotify_statuses,omitempty"`
+	RenotifyOccurrences *int      `json:"renotify_occurrences,omitempty"`
 }
 
 type TriggeringValue struct {
@@ -98,6 +110,25 @@ type Monitor struct {
 	Tags                 []string `json:"tags"`
 	Options              *Options `json:"options,omitempty"`
 	State                State    `json:"state,omitempty"`
+
+	// RestrictedRoles are the identifiers of the roles allowed to edit the
+	// monitor. Leave it nil to keep the current roles on update, and point it
+	// to an empty slice to lift the restriction.
+	RestrictedRoles *[]string `json:"restricted_roles,omitempty"`
+
+	// MatchingDowntimes is only set by GetMonitorsWithDowntime.
+	MatchingDowntimes []MatchingDowntime `json:"matching_downtimes,omitempty"`
+}
+
+// MatchingDowntime is an active or upcoming downtime silencing a monitor.
+// End is nil for downtimes without an end.
+type MatchingDowntime struct {
+	Id     *int     `json:"id,omitempty"`
+	Scope  []string `json:"scope,omitempty"`
+	Start  *int     `json:"start,omitempty"`
+	End    *int     `json:"end,omitempty"`
+	Active *bool    `json:"active,omitempty"`
+	Groups []string `json:"groups,omitempty"`
 }
 
 // Creator contains the creator of the monitor
@@ -108,27 +139,73 @@ type Creator struct {
 	Name   *string `json:"name,omitempty"`
 }
 
+// compositeMonitorName matches the symbolic names of monitors in the query of
+// a composite monitor.
+var compositeMonitorName = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_.-]*`)
+
+// ResolveCompositeMonitor rewrites the query of a composite monitor which
+// refers to monitors by symbolic names, e.g. "cpu_high && !maintenance", by
+// replacing the names with the identifiers given in ids. Monitor identifiers
+// already in the query are kept. An error is returned if a name has no
+// identifier.
+func ResolveCompositeMonitor(query string, ids map[string]int) (string, error) {
+	var unknown []string
+	resolved := compositeMonitorName.ReplaceAllStringFunc(query, func(name string) string {
+		id, ok := ids[name]
+		if !ok {
+			unknown = append(unknown, name)
+			return name
+		}
+		return strconv.Itoa(id)
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("unknown monitors in composite query %q: %s", query, strings.Join(unknown, ", "))
+	}
+	return resolved, nil
+}
+
 // reqMonitors receives a slice of all monitors
 type reqMonitors struct {
 	Monitors []Monitor `json:"monitors,omitempty"`
 }
 
 // CreateMonitor adds a new monitor to the system. This returns a pointer to a
-// monitor so you can pass that to UpdateMonitor later if needed
+// monitor so you can pass that to UpdateMonitor later if needed. The monitor
+// is checked with Validate first.
 func (client *Client) CreateMonitor(monitor *Monitor) (*Monitor, error) {
+	if err := monitor.Validate(); err != nil {
+		return nil, err
+	}
 	var out Monitor
 	// TODO: is this more pretty of frowned upon?
-	if err := client.doJsonRequest("POST", "/v1/monitor", monitor, &out); err != nil {
+	if err := client.doJsonRequest("POST", "/v1/monitor", client.monitorToSend(monitor), &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
 // UpdateMonitor takes a monitor that was previously retrieved through some method
-// and sends it back to the server
+// and sends it back to the server. The fields which are set are checked like
+// Validate does first, except that the type may be one this library doesn't
+// know about.
 func (client *Client) UpdateMonitor(monitor *Monitor) error {
+	if err := monitor.validateFields(); err != nil {
+		return err
+	}
 	return client.doJsonRequest("PUT", fmt.Sprintf("/v1/monitor/%d", *monitor.Id),
-		monitor, nil)
+		client.monitorToSend(monitor), nil)
+}
+
+// monitorToSend returns the monitor to send to the API, with its tags
+// normalized if NormalizeMonitorTags is set. The monitor itself is left
+// untouched.
+func (client *Client) monitorToSend(monitor *Monitor) *Monitor {
+	if !client.NormalizeMonitorTags {
+		return monitor
+	}
+	normalized := *monitor
+	normalized.Tags = NormalizeTags(monitor.Tags)
+	return &normalized
 }
 
 // GetMonitor retrieves a monitor by identifier
@@ -140,30 +217,173 @@ func (client *Client) GetMonitor(id int) (*Monitor, error) {
 	return &out, nil
 }
 
-// GetMonitor retrieves monitors by name
-func (self *Client) GetMonitorsByName(name string) ([]Monitor, error) {
-	var out reqMonitors
-	query, err := url.ParseQuery(fmt.Sprintf("name=%v", name))
-	if err != nil {
+// GetMonitorWithGroupStates retrieves a monitor by identifier, including the
+// state of its groups which have one of the given states, e.g. "alert",
+// "warn", "no data" or "all". The states are found in the State of the
+// monitor.
+func (client *Client) GetMonitorWithGroupStates(id int, groupStates []string) (*Monitor, error) {
+	uri := fmt.Sprintf("/v1/monitor/%d", id)
+	if len(groupStates) > 0 {
+		query := url.Values{}
+		query.Add("group_states", strings.Join(groupStates, ","))
+		uri += "?" + query.Encode()
+	}
+
+	var out Monitor
+	if err := client.doJsonRequest("GET", uri, nil, &out); err != nil {
 		return nil, err
 	}
+	return &out, nil
+}
+
+// MonitorQueryOptions tells what GetMonitorsBulk includes in the monitors.
+// GroupStates are the states of the groups whose state is included, e.g.
+// "alert" or "all", and WithDowntimes includes the downtimes matching the
+// monitors.
+type MonitorQueryOptions struct {
+	GroupStates   []string
+	WithDowntimes bool
+}
+
+// GetMonitorsBulk retrieves many monitors by identifier. The monitors are
+// retrieved concurrently, at most BatchConcurrency at a time, and rate
+// limited requests are handled by OnRateLimited like any other request. The
+// monitor at index i is the one of ids[i]. A failure to retrieve one monitor
+// doesn't stop the others from being retrieved: the monitors which couldn't
+// be retrieved are left empty, without an Id, and the errors are returned
+// together as a *MultiError.
+func (client *Client) GetMonitorsBulk(ids []int, options *MonitorQueryOptions) ([]Monitor, error) {
+	query := url.Values{}
+	if options != nil {
+		if len(options.GroupStates) > 0 {
+			query.Add("group_states", strings.Join(options.GroupStates, ","))
+		}
+		if options.WithDowntimes {
+			query.Add("with_downtimes", "true")
+		}
+	}
+
+	monitors := make([]Monitor, len(ids))
+	monitorErrs := client.runBatch(len(ids), func(i int) error {
+		uri := fmt.Sprintf("/v1/monitor/%d", ids[i])
+		if len(query) > 0 {
+			uri += "?" + query.Encode()
+		}
+		return client.doJsonRequest("GET", uri, nil, &monitors[i])
+	})
+
+	errs := &MultiError{}
+	for i, err := range monitorErrs {
+		if err != nil {
+			monitors[i] = Monitor{}
+			errs.Errors = append(errs.Errors, fmt.Errorf("monitor %d: %s", ids[i], err))
+		}
+	}
+	return monitors, errs.errorOrNil()
+}
+
+// MonitorStateTransition is a change of the state of groups of a monitor.
+// Status is one of "Alert", "Warn", "No Data" or "OK".
+type MonitorStateTransition struct {
+	EventId   int
+	Timestamp int
+	Groups    []string
+	Status    string
+}
+
+// monitorEventStatus matches the state a monitor went to in the title of its
+// notification events, e.g. "[Triggered on {host:a}] CPU is high".
+var monitorEventStatus = regexp.MustCompile(`(?i)\[(?:re-)?(triggered|recovered|warn|no data)\b`)
+
+// monitorStatuses maps the states in the titles of notification events to
+// the states of monitors.
+var monitorStatuses = map[string]string{
+	"triggered": "Alert",
+	"recovered": "OK",
+	"warn":      "Warn",
+	"no data":   "No Data",
+}
+
+// monitorEventsPageSize is the number of events the event stream returns per
+// page.
+const monitorEventsPageSize = 1000
+
+// GetMonitorStates returns the state transitions of a monitor between from
+// and to (seconds from Unix Epoch), oldest first. The transitions are read
+// from the notification events of the monitor in the event stream, going
+// through all its pages. The state is guessed from the title of the events:
+// only titles with a state in brackets, like "[Triggered on {host:a}]",
+// "[Re-Triggered]", "[Recovered]", "[Warn]" or "[No Data]", are understood,
+// and the events with any other title, like the ones of muting the monitor,
+// are skipped.
+func (client *Client) GetMonitorStates(id int, from, to int64) ([]MonitorStateTransition, error) {
+	var transitions []MonitorStateTransition
+	seen := map[int]bool{}
+	for page := 0; ; page++ {
+		v := url.Values{}
+		v.Add("start", strconv.FormatInt(from, 10))
+		v.Add("end", strconv.FormatInt(to, 10))
+		v.Add("sources", "alert")
+		v.Add("page", strconv.Itoa(page))
+
+		var out reqGetEvents
+		if err := client.doJsonRequest("GET", "/v1/events?"+v.Encode(), nil, &out); err != nil {
+			return nil, err
+		}
+
+		newEvents := 0
+		for _, event := range out.Events {
+			if seen[event.GetId()] {
+				continue
+			}
+			seen[event.GetId()] = true
+			newEvents++
+			if event.GetMonitorId() != id {
+				continue
+			}
+			match := monitorEventStatus.FindStringSubmatch(event.GetTitle())
+			if match == nil {
+				continue
+			}
+			transitions = append(transitions, MonitorStateTransition{
+				EventId:   event.GetId(),
+				Timestamp: event.GetTime(),
+				Groups:    event.MonitorGroups,
+				Status:    monitorStatuses[strings.ToLower(match[1])],
+			})
+		}
+		// Stop on a page with no new events too, in case the page parameter
+		// is ignored.
+		if len(out.Events) < monitorEventsPageSize || newEvents == 0 {
+			break
+		}
+	}
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].Timestamp < transitions[j].Timestamp
+	})
+	return transitions, nil
+}
+
+// GetMonitorsByName retrieves monitors by name
+func (self *Client) GetMonitorsByName(name string) ([]Monitor, error) {
+	var out reqMonitors
+	query := url.Values{}
+	query.Add("name", name)
 
-	err = self.doJsonRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
+	err := self.doJsonRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
 	if err != nil {
 		return nil, err
 	}
 	return out.Monitors, nil
 }
 
-// GetMonitor retrieves monitors by a slice of tags
+// GetMonitorsByTags retrieves monitors by a slice of tags
 func (self *Client) GetMonitorsByTags(tags []string) ([]Monitor, error) {
 	var out reqMonitors
-	query, err := url.ParseQuery(fmt.Sprintf("monitor_tags=%v", strings.Join(tags, ",")))
-	if err != nil {
-		return nil, err
-	}
+	query := url.Values{}
+	query.Add("monitor_tags", strings.Join(tags, ","))
 
-	err = self.doJsonRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
+	err := self.doJsonRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +396,116 @@ func (client *Client) DeleteMonitor(id int) error {
 		nil, nil)
 }
 
+// DeleteMonitors removes several monitors from the system. A failure to
+// delete one monitor doesn't stop the others from being deleted, the errors
+// are returned together as a *MultiError.
+func (client *Client) DeleteMonitors(ids []int) error {
+	errs := &MultiError{}
+	for _, id := range ids {
+		if err := client.DeleteMonitor(id); err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("monitor %d: %s", id, err))
+		}
+	}
+	return errs.errorOrNil()
+}
+
+// CreateMonitorsFromReader creates the monitors of a JSON array of monitor
+// definitions read from r, e.g. monitors exported from another organization.
+// A single definition, like the ones of ExportMonitor, is accepted too. The
+// fields managed by Datadog, like the identifier, creator and state of the
+// monitors, are dropped before they are sent. The restricted roles are
+// dropped too since role identifiers differ between organizations, set them
+// with UpdateMonitor once the monitors are created. The created monitors are
+// returned. A failure to create one monitor doesn't stop the others from
+// being created, the errors are returned together as a *MultiError.
+func (client *Client) CreateMonitorsFromReader(r io.Reader) ([]Monitor, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decoding monitors: %s", err)
+	}
+	var definitions []Monitor
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '{' {
+		definitions = make([]Monitor, 1)
+		if err := json.Unmarshal(raw, &definitions[0]); err != nil {
+			return nil, fmt.Errorf("decoding monitors: %s", err)
+		}
+	} else if err := json.Unmarshal(raw, &definitions); err != nil {
+		return nil, fmt.Errorf("decoding monitors: %s", err)
+	}
+
+	var created []Monitor
+	errs := &MultiError{}
+	for i, definition := range definitions {
+		monitor := Monitor{
+			Type:    definition.Type,
+			Query:   definition.Query,
+			Name:    definition.Name,
+			Message: definition.Message,
+			Tags:    definition.Tags,
+			Options: definition.Options,
+		}
+		out, err := client.CreateMonitor(&monitor)
+		if err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("monitor %d (%s): %s", i, monitor.GetName(), err))
+			continue
+		}
+		created = append(created, *out)
+	}
+	return created, errs.errorOrNil()
+}
+
+// ValidateMonitor checks a monitor definition without creating it. An error
+// describing the problems is returned if the monitor is invalid.
+func (client *Client) ValidateMonitor(monitor *Monitor) error {
+	return client.doJsonRequest("POST", "/v1/monitor/validate", monitor, nil)
+}
+
+// CanDeleteResponse tells which monitors can be deleted. Monitors which can't
+// be deleted, e.g. because they are referenced by a composite monitor or an
+// SLO, are listed in Errors with the reasons.
+type CanDeleteResponse struct {
+	Data   CanDeleteData       `json:"data"`
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// CanDeleteData lists the monitors which can be deleted.
+type CanDeleteData struct {
+	Ok []int `json:"ok,omitempty"`
+}
+
+// CanDeleteMonitors checks whether the given monitors can be deleted.
+func (client *Client) CanDeleteMonitors(ids []int) (*CanDeleteResponse, error) {
+	strIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIds = append(strIds, strconv.Itoa(id))
+	}
+	v := url.Values{}
+	v.Add("monitor_ids", strings.Join(strIds, ","))
+
+	resp, err := client.doRequest("GET", "/v1/monitor/can_delete?"+v.Encode(), nil)
+	if err != nil {
+		return nil, client.redactError(err)
+	}
+	defer resp.Body.Close()
+
+	var out CanDeleteResponse
+	// Some of the monitors can't be deleted, the body still has the details.
+	if resp.StatusCode == http.StatusConflict {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(body, &out); err != nil {
+			return nil, err
+		}
+		return &out, nil
+	}
+	if err := client.handleResponse(resp, &out); err != nil {
+		return nil, client.redactError(err)
+	}
+	return &out, nil
+}
+
 // GetMonitors returns a slice of all monitors
 func (client *Client) GetMonitors() ([]Monitor, error) {
 	var out reqMonitors
@@ -185,6 +515,76 @@ func (client *Client) GetMonitors() ([]Monitor, error) {
 	return out.Monitors, nil
 }
 
+// GetMonitorsWithDowntime returns a slice of all monitors, with the
+// downtimes matching each of them in MatchingDowntimes.
+func (client *Client) GetMonitorsWithDowntime() ([]Monitor, error) {
+	var out reqMonitors
+	if err := client.doJsonRequest("GET", "/v1/monitor?with_downtimes=true", nil, &out.Monitors); err != nil {
+		return nil, err
+	}
+	return out.Monitors, nil
+}
+
+// MonitorSearchResult is the result of a monitor search.
+type MonitorSearchResult struct {
+	Monitors []MonitorSearchItem    `json:"monitors,omitempty"`
+	Counts   *MonitorSearchCounts   `json:"counts,omitempty"`
+	Metadata *MonitorSearchMetadata `json:"metadata,omitempty"`
+}
+
+// MonitorSearchItem is a monitor as returned by a monitor search.
+type MonitorSearchItem struct {
+	Id              *int     `json:"id,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	Type            *string  `json:"type,omitempty"`
+	Status          *string  `json:"status,omitempty"`
+	Query           *string  `json:"query,omitempty"`
+	Classification  *string  `json:"classification,omitempty"`
+	LastTriggeredTs *int     `json:"last_triggered_ts,omitempty"`
+	Creator         *Creator `json:"creator,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Metrics         []string `json:"metrics,omitempty"`
+	Scopes          []string `json:"scopes,omitempty"`
+}
+
+// MonitorSearchCounts holds the facets of a monitor search.
+type MonitorSearchCounts struct {
+	Status []MonitorSearchCount `json:"status,omitempty"`
+	Type   []MonitorSearchCount `json:"type,omitempty"`
+	Muted  []MonitorSearchCount `json:"muted,omitempty"`
+	Tag    []MonitorSearchCount `json:"tag,omitempty"`
+}
+
+// MonitorSearchCount is the number of monitors matching a facet value. Name
+// is a string for most facets, but a bool for the muted facet.
+type MonitorSearchCount struct {
+	Name  interface{} `json:"name"`
+	Count *int        `json:"count,omitempty"`
+}
+
+// MonitorSearchMetadata holds the pagination details of a monitor search.
+type MonitorSearchMetadata struct {
+	Page       *int `json:"page,omitempty"`
+	PageCount  *int `json:"page_count,omitempty"`
+	PerPage    *int `json:"per_page,omitempty"`
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// SearchMonitors searches monitors using the monitor search syntax, e.g.
+// "tag:service:web status:alert". Pages start at 0.
+func (client *Client) SearchMonitors(query string, page, perPage int) (*MonitorSearchResult, error) {
+	v := url.Values{}
+	v.Add("query", query)
+	v.Add("page", strconv.Itoa(page))
+	v.Add("per_page", strconv.Itoa(perPage))
+
+	var out MonitorSearchResult
+	if err := client.doJsonRequest("GET", "/v1/monitor/search?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // MuteMonitors turns off monitoring notifications
 func (client *Client) MuteMonitors() error {
 	return client.doJsonRequest("POST", "/v1/monitor/mute_all", nil, nil)
@@ -200,6 +600,45 @@ func (client *Client) MuteMonitor(id int) error {
 	return client.doJsonRequest("POST", fmt.Sprintf("/v1/monitor/%d/mute", id), nil, nil)
 }
 
+// MuteMonitorOptions tells what to mute with MuteMonitorWithOptions. Scope
+// is the group to mute, e.g. "role:db", all the groups are muted when it's
+// nil. End is the unix timestamp the mute expires at, it never expires when
+// End is nil.
+type MuteMonitorOptions struct {
+	Scope *string `json:"scope,omitempty"`
+	End   *int    `json:"end,omitempty"`
+}
+
+// MuteMonitorWithOptions turns off monitoring notifications for a monitor,
+// or a group of it, until an optional end. The updated monitor is returned,
+// with the mute in its silenced options. Muting a scope which is already
+// muted extends its mute, but never shortens it: the monitor is retrieved
+// first, and the later of both ends is kept.
+func (client *Client) MuteMonitorWithOptions(id int, options MuteMonitorOptions) (*Monitor, error) {
+	current, err := client.GetMonitor(id)
+	if err != nil {
+		return nil, err
+	}
+	scope := "*"
+	if options.Scope != nil {
+		scope = *options.Scope
+	}
+	// A mute without an end never expires, it is stored with an end of 0.
+	if end, ok := current.GetOptions().Silenced[scope]; ok && options.End != nil {
+		if end == 0 {
+			options.End = nil
+		} else if end > *options.End {
+			options.End = Int(end)
+		}
+	}
+
+	var out Monitor
+	if err := client.doJsonRequest("POST", fmt.Sprintf("/v1/monitor/%d/mute", id), options, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UnmuteMonitor turns on monitoring notifications for a monitor
 func (client *Client) UnmuteMonitor(id int) error {
 	return client.doJsonRequest("POST", fmt.Sprintf("/v1/monitor/%d/unmute", id), nil, nil)
@@ -1,6 +1,9 @@
 package datadog_test
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"encoding/json"
@@ -55,3 +58,528 @@ func TestMonitorSerialization(t *testing.T) {
 	assert.Equal(t, *monitor.State.Groups["host:host0"].Name, "host:host0")
 
 }
+
+func TestMonitorRestrictedRoles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		roles    *[]string
+		expected string
+	}{
+		"unset":     {nil, ""},
+		"empty":     {&[]string{}, `[]`},
+		"populated": {&[]string{"role-1"}, `["role-1"]`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(dd.Monitor{RestrictedRoles: tc.roles})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(b, &fields); err != nil {
+				t.Fatal(err)
+			}
+			roles, ok := fields["restricted_roles"]
+			assert.Equal(t, tc.roles != nil, ok)
+			assert.Equal(t, tc.expected, string(roles))
+
+			var monitor dd.Monitor
+			if err := json.Unmarshal(b, &monitor); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.roles, monitor.RestrictedRoles)
+		})
+	}
+}
+
+func TestMonitorRenotifyOptions(t *testing.T) {
+	for name, tc := range map[string]struct {
+		options  dd.Options
+		expected string
+	}{
+		"unset": {dd.Options{}, `{}`},
+		"zero": {
+			dd.Options{RenotifyStatuses: &[]string{}, RenotifyOccurrences: dd.Int(0)},
+			`{"renotify_statuses": [], "renotify_occurrences": 0}`,
+		},
+		"set": {
+			dd.Options{RenotifyInterval: dd.Int(60), RenotifyStatuses: &[]string{"alert", "no data"}, RenotifyOccurrences: dd.Int(3)},
+			`{"renotify_interval": 60, "renotify_statuses": ["alert", "no data"], "renotify_occurrences": 3}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(tc.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tc.expected, string(b))
+
+			var options dd.Options
+			if err := json.Unmarshal(b, &options); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.options, options)
+		})
+	}
+}
+
+func TestMonitorLockedAndEscalationMessage(t *testing.T) {
+	for name, tc := range map[string]struct {
+		options  dd.Options
+		expected string
+	}{
+		"unset":    {dd.Options{}, `{}`},
+		"unlocked": {dd.Options{Locked: dd.Bool(false)}, `{"locked": false}`},
+		"locked": {
+			dd.Options{Locked: dd.Bool(true), EscalationMessage: dd.String("Still failing @pagerduty-ops")},
+			`{"locked": true, "escalation_message": "Still failing @pagerduty-ops"}`,
+		},
+		"empty escalation message": {dd.Options{EscalationMessage: dd.String("")}, `{"escalation_message": ""}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(tc.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tc.expected, string(b))
+
+			var options dd.Options
+			if err := json.Unmarshal(b, &options); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.options, options)
+		})
+	}
+
+	t.Run("Locked monitors stay locked on update", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				w.Write([]byte(`{"id": 1, "type": "metric alert", "query": "avg(last_5m):avg:system.cpu.user{*} > 90",
+					"options": {"locked": true, "escalation_message": "Still failing"}}`))
+				return
+			}
+			var in struct {
+				Options map[string]interface{} `json:"options"`
+			}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, true, in.Options["locked"])
+			assert.Equal(t, "Still failing", in.Options["escalation_message"])
+			w.Write([]byte(`{}`))
+		}))
+		defer ts.Close()
+
+		c := dd.NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl(ts.URL)
+
+		monitor, err := c.GetMonitor(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		monitor.SetName("renamed")
+		assert.Nil(t, c.UpdateMonitor(monitor))
+	})
+}
+
+func TestGetMonitorsBulk(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "alert,warn", r.URL.Query().Get("group_states"))
+		assert.Equal(t, "true", r.URL.Query().Get("with_downtimes"))
+		switch r.URL.Path {
+		case "/api/v1/monitor/2":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": ["Monitor not found"]}`))
+		default:
+			id := strings.TrimPrefix(r.URL.Path, "/api/v1/monitor/")
+			w.Write([]byte(`{"id": ` + id + `, "state": {"groups": {"host:a": {"status": "Alert"}}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.BatchConcurrency = 2
+
+	monitors, err := c.GetMonitorsBulk([]int{5, 1, 2, 4, 3}, &dd.MonitorQueryOptions{
+		GroupStates:   []string{"alert", "warn"},
+		WithDowntimes: true,
+	})
+	if assert.IsType(t, &dd.MultiError{}, err) {
+		assert.Len(t, err.(*dd.MultiError).Errors, 1)
+		assert.Contains(t, err.Error(), "monitor 2")
+	}
+	var ids []int
+	for _, monitor := range monitors {
+		ids = append(ids, monitor.GetId())
+		if monitor.HasId() {
+			assert.Equal(t, "Alert", *monitor.State.Groups["host:a"].Status)
+		}
+	}
+	assert.Equal(t, []int{5, 1, 0, 4, 3}, ids)
+	assert.False(t, monitors[2].HasId())
+}
+
+func TestSearchMonitors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/monitor/search", r.URL.Path)
+		assert.Equal(t, "tag:service:web status:alert", r.URL.Query().Get("query"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Write([]byte(`{
+			"counts": {
+				"status": [{"count": 2, "name": "Alert"}],
+				"muted": [{"count": 2, "name": false}]
+			},
+			"monitors": [
+				{"id": 1, "name": "web latency", "status": "Alert", "tags": ["service:web"]},
+				{"id": 2, "name": "web errors", "status": "Alert", "tags": ["service:web"]}
+			],
+			"metadata": {"page": 1, "per_page": 50, "page_count": 2, "total_count": 52}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	result, err := c.SearchMonitors("tag:service:web status:alert", 1, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, result.Monitors, 2)
+	assert.Equal(t, "web errors", result.Monitors[1].GetName())
+	assert.Equal(t, "Alert", result.Counts.Status[0].Name)
+	assert.Equal(t, false, result.Counts.Muted[0].Name)
+	assert.Equal(t, 52, result.Metadata.GetTotalCount())
+	assert.Equal(t, 2, result.Metadata.GetPageCount())
+}
+
+func TestGetMonitorsByName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "disk & cpu", r.URL.Query().Get("name"))
+		w.Write([]byte(`[{"id": 1, "name": "disk & cpu"}]`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	monitors, err := c.GetMonitorsByName("disk & cpu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, monitors, 1)
+}
+
+func TestGetMonitorWithGroupStates(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/monitor/1", r.URL.Path)
+		assert.Equal(t, "alert,no data", r.URL.Query().Get("group_states"))
+		w.Write([]byte(`{
+			"id": 1,
+			"overall_state": "Alert",
+			"state": {
+				"groups": {
+					"host:web-1": {"name": "host:web-1", "status": "Alert", "last_triggered_ts": 1481909160},
+					"host:web-2": {"name": "host:web-2", "status": "No Data", "last_nodata_ts": 1481909100}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	monitor, err := c.GetMonitorWithGroupStates(1, []string{"alert", "no data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, monitor.State.Groups, 2)
+	group := monitor.State.Groups["host:web-1"]
+	assert.Equal(t, "Alert", group.GetStatus())
+	assert.Equal(t, 1481909160, group.GetLastTriggeredTs())
+	_, ok := group.GetLastNoDataTsOk()
+	assert.False(t, ok)
+	group = monitor.State.Groups["host:web-2"]
+	assert.Equal(t, 1481909100, group.GetLastNoDataTs())
+}
+
+func TestDeleteMonitors(t *testing.T) {
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		if r.URL.Path == "/api/v1/monitor/2" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": ["Monitor not found"]}`))
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	err := c.DeleteMonitors([]int{1, 2, 3})
+	assert.Equal(t, []string{"/api/v1/monitor/1", "/api/v1/monitor/3"}, deleted)
+	if merr, ok := err.(*dd.MultiError); assert.True(t, ok) {
+		assert.Len(t, merr.Errors, 1)
+		assert.Contains(t, merr.Error(), "monitor 2")
+	}
+
+	assert.Nil(t, c.DeleteMonitors([]int{1}))
+}
+
+func TestCanDeleteMonitors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/monitor/can_delete", r.URL.Path)
+		assert.Equal(t, "1,2", r.URL.Query().Get("monitor_ids"))
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"data": {"ok": [1]}, "errors": {"2": ["monitor [2] is referenced in composite monitors with ID(s) [3]"]}}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	resp, err := c.CanDeleteMonitors([]int{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []int{1}, resp.Data.Ok)
+	assert.Len(t, resp.Errors["2"], 1)
+}
+
+func TestValidateMonitor(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v1/monitor/validate", r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors": ["The value provided for parameter 'query' is invalid"]}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	err := c.ValidateMonitor(&dd.Monitor{Type: dd.String("metric alert"), Query: dd.String("nonsense")})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "parameter 'query' is invalid")
+	}
+}
+
+func TestResolveCompositeMonitor(t *testing.T) {
+	ids := map[string]int{"cpu_high": 12345, "disk.full": 67890, "maintenance-window": 111}
+
+	query, err := dd.ResolveCompositeMonitor("(cpu_high || disk.full) && !maintenance-window && 42", ids)
+	assert.Nil(t, err)
+	assert.Equal(t, "(12345 || 67890) && !111 && 42", query)
+
+	_, err = dd.ResolveCompositeMonitor("cpu_high && memory_high", ids)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "memory_high")
+	}
+}
+
+func TestGetMonitorsWithDowntime(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("with_downtimes"))
+		w.Write([]byte(`[
+			{"id": 1, "matching_downtimes": [{"id": 7, "scope": ["env:staging"], "start": 1577836800, "end": null, "active": true}]},
+			{"id": 2, "matching_downtimes": []}
+		]`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	monitors, err := c.GetMonitorsWithDowntime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, monitors, 2) && assert.Len(t, monitors[0].MatchingDowntimes, 1) {
+		downtime := monitors[0].MatchingDowntimes[0]
+		assert.Equal(t, 7, downtime.GetId())
+		assert.Equal(t, []string{"env:staging"}, downtime.Scope)
+		assert.True(t, downtime.GetActive())
+		assert.False(t, downtime.HasEnd())
+	}
+	assert.Empty(t, monitors[1].MatchingDowntimes)
+}
+
+func TestGetMonitorStates(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/events", r.URL.Path)
+		assert.Equal(t, "alert", r.URL.Query().Get("sources"))
+		assert.Equal(t, "1000", r.URL.Query().Get("start"))
+		assert.Equal(t, "2000", r.URL.Query().Get("end"))
+		w.Write([]byte(`{"events": [
+			{"id": 3, "date_happened": 1300, "monitor_id": 12, "monitor_groups": ["host:a"], "title": "[Recovered on {host:a}] CPU is high"},
+			{"id": 2, "date_happened": 1200, "monitor_id": 34, "title": "[Triggered] Disk is full"},
+			{"id": 1, "date_happened": 1100, "monitor_id": 12, "monitor_groups": ["host:a"], "title": "[P1] [Triggered on {host:a}] CPU is high"},
+			{"id": 4, "date_happened": 1400, "monitor_id": 12, "title": "Monitor muted"},
+			{"id": 5, "date_happened": 1500, "monitor_id": 12, "title": "CPU is high again"},
+			{"id": 6, "date_happened": 1600, "monitor_id": 12, "title": "[Alert] CPU is high"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	transitions, err := c.GetMonitorStates(12, 1000, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The events whose title has no known state are skipped.
+	assert.Equal(t, []dd.MonitorStateTransition{
+		{EventId: 1, Timestamp: 1100, Groups: []string{"host:a"}, Status: "Alert"},
+		{EventId: 3, Timestamp: 1300, Groups: []string{"host:a"}, Status: "OK"},
+	}, transitions)
+}
+
+func TestGetMonitorStatesPages(t *testing.T) {
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		events := []map[string]interface{}{}
+		if page == "0" {
+			for i := 0; i < 1000; i++ {
+				events = append(events, map[string]interface{}{
+					"id": i, "date_happened": 1000 + i, "monitor_id": 12, "title": "[Warn] CPU is high",
+				})
+			}
+		} else {
+			events = append(events, map[string]interface{}{
+				"id": 1000, "date_happened": 2000, "monitor_id": 12, "title": "[Recovered] CPU is high",
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"events": events})
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	transitions, err := c.GetMonitorStates(12, 1000, 3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"0", "1"}, pages)
+	if assert.Len(t, transitions, 1001) {
+		assert.Equal(t, "OK", transitions[1000].Status)
+	}
+}
+
+func TestCreateMonitorsFromReader(t *testing.T) {
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/monitor", r.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		if body["name"] == "Broken" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": ["The value provided for parameter 'query' is invalid"]}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 100 + len(bodies), "name": body["name"]})
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	monitors, err := c.CreateMonitorsFromReader(strings.NewReader(`[
+		{"id": 1, "name": "CPU", "type": "metric alert", "query": "avg(last_5m):avg:system.cpu.user{*} > 90",
+		 "overall_state": "Alert", "creator": {"email": "jane@example.com"}, "state": {"groups": {}}, "tags": ["team:core"],
+		 "restricted_roles": ["00000000-0000-1111-0000-000000000000"]},
+		{"id": 2, "name": "Broken", "type": "metric alert", "query": "avg(last_5m):"},
+		{"id": 3, "name": "Disk", "type": "metric alert", "query": "avg(last_5m):avg:system.disk.in_use{*} > 0.9"}
+	]`))
+	if assert.IsType(t, &dd.MultiError{}, err) {
+		assert.Contains(t, err.Error(), "monitor 1 (Broken)")
+	}
+	if assert.Len(t, monitors, 2) {
+		assert.Equal(t, 101, monitors[0].GetId())
+		assert.Equal(t, "Disk", monitors[1].GetName())
+	}
+	if assert.Len(t, bodies, 3) {
+		for _, field := range []string{"id", "overall_state", "creator", "restricted_roles"} {
+			assert.NotContains(t, bodies[0], field)
+		}
+		assert.Equal(t, []interface{}{"team:core"}, bodies[0]["tags"])
+	}
+
+	_, err = c.CreateMonitorsFromReader(strings.NewReader(`{"name": "not an array"}`))
+	assert.NotNil(t, err)
+}
+
+func TestMuteMonitorWithOptions(t *testing.T) {
+	// The server keeps a single end per scope, like Datadog does.
+	silenced := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, "/api/v1/monitor/12345", r.URL.Path)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":      12345,
+				"options": map[string]interface{}{"silenced": silenced},
+			})
+			return
+		}
+		assert.Equal(t, "/api/v1/monitor/12345/mute", r.URL.Path)
+		var in struct {
+			Scope string `json:"scope"`
+			End   int    `json:"end"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&in))
+		if in.Scope == "" {
+			in.Scope = "*"
+		}
+		silenced[in.Scope] = in.End
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":      12345,
+			"options": map[string]interface{}{"silenced": silenced},
+		})
+	}))
+	defer ts.Close()
+
+	c := dd.NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	monitor, err := c.MuteMonitorWithOptions(12345, dd.MuteMonitorOptions{
+		Scope: dd.String("role:db"),
+		End:   dd.Int(1577836800),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]int{"role:db": 1577836800}, monitor.Options.Silenced)
+
+	// Muting the scope again with a later end extends the mute.
+	monitor, err = c.MuteMonitorWithOptions(12345, dd.MuteMonitorOptions{
+		Scope: dd.String("role:db"),
+		End:   dd.Int(1577840400),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"role:db": 1577840400}, monitor.Options.Silenced)
+
+	// Muting it with an earlier end doesn't shorten it.
+	monitor, err = c.MuteMonitorWithOptions(12345, dd.MuteMonitorOptions{
+		Scope: dd.String("role:db"),
+		End:   dd.Int(1577838600),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"role:db": 1577840400}, monitor.Options.Silenced)
+
+	monitor, err = c.MuteMonitorWithOptions(12345, dd.MuteMonitorOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"role:db": 1577840400, "*": 0}, monitor.Options.Silenced)
+
+	// Nor does an end shorten a mute which never expires.
+	monitor, err = c.MuteMonitorWithOptions(12345, dd.MuteMonitorOptions{End: dd.Int(1577836800)})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"role:db": 1577840400, "*": 0}, monitor.Options.Silenced)
+}
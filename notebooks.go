@@ -0,0 +1,262 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	NotebookCellTypeMarkdown   = "markdown"
+	NotebookCellTypeTimeseries = "timeseries"
+	NotebookCellTypeToplist    = "toplist"
+	NotebookCellTypeLogStream  = "log_stream"
+)
+
+// Notebook represents a Datadog notebook, a document that combines text and
+// graphs.
+type Notebook struct {
+	Id                *int               `json:"-"`
+	Name              *string            `json:"name,omitempty"`
+	Cells             []NotebookCell     `json:"cells,omitempty"`
+	Time              *NotebookTime      `json:"time,omitempty"`
+	Status            *string            `json:"status,omitempty"`
+	TemplateVariables []TemplateVariable `json:"template_variables,omitempty"`
+}
+
+// NotebookTime is the global time frame of a notebook or a cell.
+type NotebookTime struct {
+	LiveSpan *string `json:"live_span,omitempty"`
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+}
+
+// NotebookGraphRequest represents a query for a graph cell.
+type NotebookGraphRequest struct {
+	Query   *string `json:"q,omitempty"`
+	Display *string `json:"display_type,omitempty"`
+}
+
+// NotebookMarkdownDefinition is the definition of a markdown cell.
+type NotebookMarkdownDefinition struct {
+	Text *string `json:"text,omitempty"`
+}
+
+// NotebookGraphDefinition is the definition of a timeseries or toplist cell.
+type NotebookGraphDefinition struct {
+	Title    *string                `json:"title,omitempty"`
+	Requests []NotebookGraphRequest `json:"requests,omitempty"`
+}
+
+// NotebookLogStreamDefinition is the definition of a log stream cell.
+type NotebookLogStreamDefinition struct {
+	Query   *string  `json:"query,omitempty"`
+	Indexes []string `json:"indexes,omitempty"`
+	Columns []string `json:"columns,omitempty"`
+}
+
+// NotebookCell is a single cell of a notebook. Type selects which of the
+// definitions is used. Decoded cells keep their raw definition in Definition,
+// so the cells of a type this library doesn't know about, and the fields it
+// doesn't model, survive being sent back.
+type NotebookCell struct {
+	Id   *string
+	Type *string
+
+	Markdown   *NotebookMarkdownDefinition
+	Timeseries *NotebookGraphDefinition
+	Toplist    *NotebookGraphDefinition
+	LogStream  *NotebookLogStreamDefinition
+
+	// Definition holds the raw definition of the cell.
+	Definition json.RawMessage
+}
+
+// notebookCellJSON is the wire format of a notebook cell.
+type notebookCellJSON struct {
+	Id         *string `json:"id,omitempty"`
+	Type       string  `json:"type"`
+	Attributes struct {
+		Definition json.RawMessage `json:"definition"`
+	} `json:"attributes"`
+}
+
+// MarshalJSON encodes the definition matching the cell type, over the raw
+// definition of the cell so the fields this library doesn't model survive.
+func (c NotebookCell) MarshalJSON() ([]byte, error) {
+	var definition interface{}
+	switch {
+	case c.GetType() == NotebookCellTypeMarkdown && c.Markdown != nil:
+		definition = c.Markdown
+	case c.GetType() == NotebookCellTypeTimeseries && c.Timeseries != nil:
+		definition = c.Timeseries
+	case c.GetType() == NotebookCellTypeToplist && c.Toplist != nil:
+		definition = c.Toplist
+	case c.GetType() == NotebookCellTypeLogStream && c.LogStream != nil:
+		definition = c.LogStream
+	}
+
+	raw := c.Definition
+	if definition != nil {
+		var err error
+		raw, err = mergeDefinition(c.Definition, definition, c.GetType())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("notebook cell of type %q has no definition", c.GetType())
+	}
+
+	out := notebookCellJSON{Id: c.Id, Type: "notebook_cells"}
+	out.Attributes.Definition = raw
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the cell definition into the field matching its type.
+func (c *NotebookCell) UnmarshalJSON(data []byte) error {
+	var in notebookCellJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	var discriminator struct {
+		Type *string `json:"type"`
+	}
+	if len(in.Attributes.Definition) > 0 {
+		if err := json.Unmarshal(in.Attributes.Definition, &discriminator); err != nil {
+			return err
+		}
+	}
+
+	*c = NotebookCell{
+		Id:         in.Id,
+		Type:       discriminator.Type,
+		Definition: in.Attributes.Definition,
+	}
+
+	var target interface{}
+	switch c.GetType() {
+	case NotebookCellTypeMarkdown:
+		c.Markdown = &NotebookMarkdownDefinition{}
+		target = c.Markdown
+	case NotebookCellTypeTimeseries:
+		c.Timeseries = &NotebookGraphDefinition{}
+		target = c.Timeseries
+	case NotebookCellTypeToplist:
+		c.Toplist = &NotebookGraphDefinition{}
+		target = c.Toplist
+	case NotebookCellTypeLogStream:
+		c.LogStream = &NotebookLogStreamDefinition{}
+		target = c.LogStream
+	default:
+		return nil
+	}
+	return json.Unmarshal(in.Attributes.Definition, target)
+}
+
+// mergeDefinition encodes definition over the fields of the raw definition,
+// if any, so the fields this library doesn't model are kept, and adds the type
+// discriminator.
+func mergeDefinition(raw json.RawMessage, definition interface{}, cellType string) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+	}
+	if err := mergeJSONObject(fields, definition); err != nil {
+		return nil, err
+	}
+	t, err := json.Marshal(cellType)
+	if err != nil {
+		return nil, err
+	}
+	fields["type"] = t
+	return json.Marshal(fields)
+}
+
+// notebookData is the resource envelope used by the notebooks API.
+type notebookData struct {
+	Id         *int      `json:"id,omitempty"`
+	Type       string    `json:"type"`
+	Attributes *Notebook `json:"attributes"`
+}
+
+// reqNotebook is the container for sending and receiving a single notebook.
+type reqNotebook struct {
+	Data *notebookData `json:"data"`
+}
+
+// reqNotebooks is the container for receiving many notebooks.
+type reqNotebooks struct {
+	Data []notebookData `json:"data"`
+}
+
+func (d *notebookData) notebook() *Notebook {
+	if d.Attributes == nil {
+		d.Attributes = &Notebook{}
+	}
+	d.Attributes.Id = d.Id
+	return d.Attributes
+}
+
+// CreateNotebook adds a new notebook to the system. This returns a pointer to
+// a Notebook so you can pass that to UpdateNotebook later if needed.
+func (client *Client) CreateNotebook(notebook *Notebook) (*Notebook, error) {
+	var out reqNotebook
+	in := reqNotebook{Data: &notebookData{Type: "notebooks", Attributes: notebook}}
+	if err := client.doJsonRequest("POST", "/v1/notebooks", in, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no notebook returned")
+	}
+	return out.Data.notebook(), nil
+}
+
+// UpdateNotebook takes a notebook that was previously retrieved through some
+// method and sends it back to the server.
+func (client *Client) UpdateNotebook(notebook *Notebook) error {
+	in := reqNotebook{Data: &notebookData{Type: "notebooks", Attributes: notebook}}
+	return client.doJsonRequest("PUT", fmt.Sprintf("/v1/notebooks/%d", notebook.GetId()),
+		in, nil)
+}
+
+// GetNotebook retrieves a notebook by identifier.
+func (client *Client) GetNotebook(id int) (*Notebook, error) {
+	var out reqNotebook
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v1/notebooks/%d", id), nil, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no notebook returned")
+	}
+	return out.Data.notebook(), nil
+}
+
+// DeleteNotebook removes a notebook from the system.
+func (client *Client) DeleteNotebook(id int) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v1/notebooks/%d", id),
+		nil, nil)
+}
+
+// GetNotebooks returns a slice of all notebooks.
+func (client *Client) GetNotebooks() ([]Notebook, error) {
+	var out reqNotebooks
+	if err := client.doJsonRequest("GET", "/v1/notebooks", nil, &out); err != nil {
+		return nil, err
+	}
+	notebooks := make([]Notebook, 0, len(out.Data))
+	for i := range out.Data {
+		notebooks = append(notebooks, *out.Data[i].notebook())
+	}
+	return notebooks, nil
+}
@@ -0,0 +1,89 @@
+package datadog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebookCellSerialization(t *testing.T) {
+	raw := `[
+		{"id": "a", "type": "notebook_cells", "attributes": {"definition": {"type": "markdown", "text": "# Investigation"}}},
+		{"id": "b", "type": "notebook_cells", "attributes": {"definition": {"type": "timeseries", "requests": [{"q": "avg:system.load.1{*}", "display_type": "line"}]}}},
+		{"id": "c", "type": "notebook_cells", "attributes": {"definition": {"type": "heatmap", "requests": [{"q": "avg:system.cpu.user{*}"}]}}}
+	]`
+
+	var cells []NotebookCell
+	if err := json.Unmarshal([]byte(raw), &cells); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, cells, 3) {
+		assert.Equal(t, NotebookCellTypeMarkdown, cells[0].GetType())
+		assert.Equal(t, "# Investigation", cells[0].Markdown.GetText())
+
+		assert.Equal(t, NotebookCellTypeTimeseries, cells[1].GetType())
+		assert.Equal(t, "avg:system.load.1{*}", cells[1].Timeseries.Requests[0].GetQuery())
+
+		assert.Equal(t, "heatmap", cells[2].GetType())
+		assert.Nil(t, cells[2].Markdown)
+		assert.Nil(t, cells[2].Timeseries)
+	}
+
+	t.Run("Unknown cell types round trip", func(t *testing.T) {
+		b, err := json.Marshal(cells[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"id": "c", "type": "notebook_cells", "attributes": {"definition": {"type": "heatmap", "requests": [{"q": "avg:system.cpu.user{*}"}]}}}`, string(b))
+	})
+
+	t.Run("Known cell types marshal their definition", func(t *testing.T) {
+		cell := NotebookCell{
+			Type:     String(NotebookCellTypeMarkdown),
+			Markdown: &NotebookMarkdownDefinition{Text: String("hello")},
+		}
+		b, err := json.Marshal(cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"type": "notebook_cells", "attributes": {"definition": {"type": "markdown", "text": "hello"}}}`, string(b))
+	})
+
+	t.Run("Unmodeled fields of known cell types survive changes", func(t *testing.T) {
+		var cell NotebookCell
+		err := json.Unmarshal([]byte(`{"id": "a", "type": "notebook_cells", "attributes": {"definition": {"type": "timeseries", "title": "Load", "show_legend": true, "requests": [{"q": "avg:system.load.1{*}"}]}}}`), &cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cell.Timeseries.Title = String("Load average")
+
+		b, err := json.Marshal(cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"id": "a", "type": "notebook_cells", "attributes": {"definition": {"type": "timeseries", "title": "Load average", "show_legend": true, "requests": [{"q": "avg:system.load.1{*}"}]}}}`, string(b))
+	})
+
+	t.Run("Cleared fields of known cell types are removed", func(t *testing.T) {
+		var cell NotebookCell
+		err := json.Unmarshal([]byte(`{"id": "a", "type": "notebook_cells", "attributes": {"definition": {"type": "timeseries", "title": "Load", "show_legend": true, "requests": [{"q": "avg:system.load.1{*}"}]}}}`), &cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cell.Timeseries.Title = nil
+		cell.Timeseries.Requests = nil
+
+		b, err := json.Marshal(cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"id": "a", "type": "notebook_cells", "attributes": {"definition": {"type": "timeseries", "show_legend": true}}}`, string(b))
+	})
+
+	t.Run("Cells without a definition fail to marshal", func(t *testing.T) {
+		_, err := json.Marshal(NotebookCell{Type: String(NotebookCellTypeToplist)})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,99 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ProcessSummary is a snapshot of a process running on a host, as reported
+// by live processes.
+type ProcessSummary struct {
+	Id        *string  `json:"-"`
+	Cmdline   *string  `json:"cmdline,omitempty"`
+	Host      *string  `json:"host,omitempty"`
+	Pid       *int     `json:"pid,omitempty"`
+	Ppid      *int     `json:"ppid,omitempty"`
+	Start     *string  `json:"start,omitempty"`
+	Timestamp *string  `json:"timestamp,omitempty"`
+	User      *string  `json:"user,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+}
+
+// ProcessList is a page of processes. Pass After to GetProcessesPage to get
+// the next page, it is empty on the last page.
+type ProcessList struct {
+	Processes []ProcessSummary
+	After     string
+}
+
+// processData is the resource envelope used by the processes API.
+type processData struct {
+	Id         *string         `json:"id,omitempty"`
+	Type       string          `json:"type"`
+	Attributes *ProcessSummary `json:"attributes,omitempty"`
+}
+
+// reqProcesses is the container for receiving a page of processes.
+type reqProcesses struct {
+	Data []processData `json:"data"`
+	Meta struct {
+		Page struct {
+			After string `json:"after"`
+		} `json:"page"`
+	} `json:"meta"`
+}
+
+// GetProcesses returns the first page of the processes matching search and
+// tags, e.g. "env:prod,service:web", which ran between from and to (seconds
+// from Unix Epoch). Empty strings and zero times leave the matching filter
+// out.
+func (client *Client) GetProcesses(search, tags string, from, to int64) (*ProcessList, error) {
+	return client.GetProcessesPage(search, tags, from, to, "")
+}
+
+// GetProcessesPage is like GetProcesses, but returns the page following
+// cursor, the After of the previous page.
+func (client *Client) GetProcessesPage(search, tags string, from, to int64, cursor string) (*ProcessList, error) {
+	v := url.Values{}
+	if search != "" {
+		v.Add("search", search)
+	}
+	if tags != "" {
+		v.Add("tags", tags)
+	}
+	if from != 0 {
+		v.Add("from", strconv.FormatInt(from, 10))
+	}
+	if to != 0 {
+		v.Add("to", strconv.FormatInt(to, 10))
+	}
+	if cursor != "" {
+		v.Add("page[cursor]", cursor)
+	}
+
+	var out reqProcesses
+	if err := client.doJsonRequest("GET", "/v2/processes?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	list := &ProcessList{
+		Processes: make([]ProcessSummary, 0, len(out.Data)),
+		After:     out.Meta.Page.After,
+	}
+	for _, d := range out.Data {
+		process := ProcessSummary{}
+		if d.Attributes != nil {
+			process = *d.Attributes
+		}
+		process.Id = d.Id
+		list.Processes = append(list.Processes, process)
+	}
+	return list, nil
+}
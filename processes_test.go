@@ -0,0 +1,57 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProcesses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/processes", r.URL.Path)
+		assert.Equal(t, "nginx", r.URL.Query().Get("search"))
+		assert.Equal(t, "env:prod", r.URL.Query().Get("tags"))
+		assert.Equal(t, "100", r.URL.Query().Get("from"))
+		assert.Equal(t, "200", r.URL.Query().Get("to"))
+		switch r.URL.Query().Get("page[cursor]") {
+		case "":
+			w.Write([]byte(`{
+				"data": [{
+					"id": "p-1",
+					"type": "process",
+					"attributes": {"cmdline": "nginx: master process", "host": "web-1", "pid": 1234, "ppid": 1, "user": "www-data", "tags": ["env:prod"]}
+				}],
+				"meta": {"page": {"after": "cursor-1", "size": 1}}
+			}`))
+		case "cursor-1":
+			w.Write([]byte(`{"data": [{"id": "p-2", "type": "process", "attributes": {"pid": 1235}}], "meta": {"page": {"size": 1}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	list, err := c.GetProcesses("nginx", "env:prod", 100, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "cursor-1", list.After)
+	if assert.Len(t, list.Processes, 1) {
+		assert.Equal(t, "p-1", list.Processes[0].GetId())
+		assert.Equal(t, 1234, list.Processes[0].GetPid())
+		assert.Equal(t, "www-data", list.Processes[0].GetUser())
+		assert.Equal(t, "web-1", list.Processes[0].GetHost())
+	}
+
+	list, err = c.GetProcessesPage("nginx", "env:prod", 100, 200, list.After)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "", list.After)
+	if assert.Len(t, list.Processes, 1) {
+		assert.Equal(t, 1235, list.Processes[0].GetPid())
+	}
+}
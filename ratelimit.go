@@ -0,0 +1,97 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the state of the rate limit an API response was subject to,
+// as reported by the X-RateLimit-* headers.
+type RateLimit struct {
+	// Name of the rate limit, endpoints may share one.
+	Name string
+	// Limit is the number of requests allowed per Period.
+	Limit  int
+	Period time.Duration
+	// Remaining is the number of requests left in the current period.
+	Remaining int
+	// Reset is the time until the current period ends.
+	Reset time.Duration
+}
+
+// parseRateLimit reads the rate limit from the headers of a response. Missing
+// or malformed headers leave the matching fields empty.
+func parseRateLimit(header http.Header) RateLimit {
+	return RateLimit{
+		Name:      header.Get("X-RateLimit-Name"),
+		Limit:     headerInt(header, "X-RateLimit-Limit"),
+		Period:    time.Duration(headerInt(header, "X-RateLimit-Period")) * time.Second,
+		Remaining: headerInt(header, "X-RateLimit-Remaining"),
+		Reset:     time.Duration(headerInt(header, "X-RateLimit-Reset")) * time.Second,
+	}
+}
+
+func headerInt(header http.Header, name string) int {
+	i, err := strconv.Atoi(header.Get(name))
+	if err != nil {
+		return 0
+	}
+	return i
+}
+
+// RateLimitSnapshot returns the rate limits last reported by the responses
+// to the requests of the client, keyed by the name of the rate limit or, if
+// the response doesn't name it, by the family of the endpoint, e.g.
+// "v1/monitor". Endpoints families like reads, writes and logs are subject to
+// separate limits.
+func (client *Client) RateLimitSnapshot() map[string]RateLimit {
+	client.rateLimitsMu.Lock()
+	defer client.rateLimitsMu.Unlock()
+	snapshot := make(map[string]RateLimit, len(client.rateLimits))
+	for key, rl := range client.rateLimits {
+		snapshot[key] = rl
+	}
+	return snapshot
+}
+
+// recordRateLimit keeps the rate limit reported by the response to req, if
+// any, for RateLimitSnapshot. The path of req is used rather than the one of
+// resp.Request, which responses built by a custom transport may not set.
+func (client *Client) recordRateLimit(req *http.Request, resp *http.Response) {
+	if resp.Header.Get("X-RateLimit-Limit") == "" {
+		return
+	}
+	rl := parseRateLimit(resp.Header)
+	key := rl.Name
+	if key == "" {
+		key = client.endpointFamily(req.URL.Path)
+	}
+
+	client.rateLimitsMu.Lock()
+	defer client.rateLimitsMu.Unlock()
+	if client.rateLimits == nil {
+		client.rateLimits = map[string]RateLimit{}
+	}
+	client.rateLimits[key] = rl
+}
+
+// endpointFamily returns the version and resource of an API path, e.g.
+// "v1/monitor" for "/api/v1/monitor/123".
+func (client *Client) endpointFamily(path string) string {
+	path = strings.TrimPrefix(path, client.GetAPIPathPrefix())
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,185 @@
+package datadog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Name", "monitors")
+	header.Set("X-RateLimit-Limit", "3000")
+	header.Set("X-RateLimit-Period", "10")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "7")
+
+	assert.Equal(t, RateLimit{
+		Name:      "monitors",
+		Limit:     3000,
+		Period:    10 * time.Second,
+		Remaining: 0,
+		Reset:     7 * time.Second,
+	}, parseRateLimit(header))
+
+	assert.Equal(t, RateLimit{}, parseRateLimit(http.Header{}))
+}
+
+func TestOnRateLimited(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("X-RateLimit-Limit", "10")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+
+	t.Run("Rate limited requests fail by default", func(t *testing.T) {
+		requests = 0
+		assert.NotNil(t, c.doJsonRequest("POST", "/v1/something", nil, nil))
+		assert.Equal(t, 1, requests)
+	})
+	t.Run("Requests are sent again when the hook allows it", func(t *testing.T) {
+		requests = 0
+		var limits []RateLimit
+		c.OnRateLimited = func(rl RateLimit) error {
+			limits = append(limits, rl)
+			return nil
+		}
+
+		assert.Nil(t, c.doJsonRequest("POST", "/v1/something", map[string]string{"a": "b"}, nil))
+		assert.Equal(t, 2, requests)
+		if assert.Len(t, limits, 1) {
+			assert.Equal(t, 10, limits[0].Limit)
+		}
+	})
+	t.Run("Errors of the hook are returned", func(t *testing.T) {
+		requests = 0
+		c.OnRateLimited = func(rl RateLimit) error {
+			return fmt.Errorf("rate limited, giving up")
+		}
+
+		err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "rate limited, giving up", err.Error())
+		}
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func TestOnRateLimitedWithoutReset(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = 10 * time.Millisecond
+	c.RetryMaxInterval = 10 * time.Millisecond
+	var calls int
+	c.OnRateLimited = func(rl RateLimit) error {
+		calls++
+		return nil
+	}
+
+	start := time.Now()
+	_, meta, err := c.GetRaw("GET", "/v1/something", nil)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "429")
+	}
+	assert.Equal(t, http.StatusTooManyRequests, meta.StatusCode)
+	assert.Equal(t, maxRateLimitedRetries+1, requests)
+	assert.Equal(t, maxRateLimitedRetries, calls)
+	assert.True(t, time.Since(start) >= 5*time.Duration(maxRateLimitedRetries)*time.Millisecond, "expect waits between attempts")
+}
+
+func TestRateLimitOnErrorResponses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	_, meta, err := c.GetRaw("GET", "/v1/something", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, meta.StatusCode)
+	assert.Equal(t, 10, meta.RateLimit.Limit)
+
+	c.OnRateLimited = func(rl RateLimit) error {
+		return fmt.Errorf("rate limited, giving up")
+	}
+	_, meta, err = c.GetRaw("GET", "/v1/something", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 10, meta.RateLimit.Limit)
+}
+
+func TestRateLimitSnapshot(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/monitor/1":
+			w.Header().Set("X-RateLimit-Limit", "3000")
+			w.Header().Set("X-RateLimit-Remaining", "2999")
+		case "/api/v1/logs-queries/list":
+			w.Header().Set("X-RateLimit-Name", "logs_query")
+			w.Header().Set("X-RateLimit-Limit", "300")
+			w.Header().Set("X-RateLimit-Remaining", "12")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	assert.Empty(t, c.RateLimitSnapshot())
+
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/monitor/1", nil, nil))
+	assert.Nil(t, c.doJsonRequest("POST", "/v1/logs-queries/list", nil, nil))
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/dash", nil, nil))
+
+	snapshot := c.RateLimitSnapshot()
+	assert.Len(t, snapshot, 2)
+	assert.Equal(t, RateLimit{Limit: 3000, Remaining: 2999}, snapshot["v1/monitor"])
+	assert.Equal(t, 12, snapshot["logs_query"].Remaining)
+
+	t.Run("Responses without a request", func(t *testing.T) {
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.SetBaseUrl("http://datadog.invalid")
+		c.HttpClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			header := http.Header{}
+			header.Set("X-RateLimit-Limit", "3000")
+			header.Set("X-RateLimit-Remaining", "7")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		})}
+
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/monitor/1", nil, nil))
+		assert.Equal(t, 7, c.RateLimitSnapshot()["v1/monitor"].Remaining)
+	})
+}
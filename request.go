@@ -10,13 +10,19 @@ package datadog
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff"
@@ -24,37 +30,143 @@ import (
 
 // Response contains common fields that might be present in any API response.
 type Response struct {
-	Status string `json:"status"`
-	Error  string `json:"error"`
+	Status string          `json:"status"`
+	Error  string          `json:"error"`
+	Errors []ResponseError `json:"errors"`
+}
+
+// ResponseError is a single entry of the errors array of a response. The v1
+// API reports errors as plain strings, while the v2 API uses objects with a
+// title and a detail.
+type ResponseError struct {
+	Title  string `json:"title,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// UnmarshalJSON accepts both the string and the object form of an error.
+func (e *ResponseError) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		e.Detail = message
+		return nil
+	}
+	type alias ResponseError
+	return json.Unmarshal(data, (*alias)(e))
+}
+
+// String returns the most descriptive message of the error.
+func (e ResponseError) String() string {
+	switch {
+	case e.Title != "" && e.Detail != "":
+		return e.Title + ": " + e.Detail
+	case e.Detail != "":
+		return e.Detail
+	}
+	return e.Title
+}
+
+// CorrelationIDHeader is the header carrying the correlation ID of requests
+// made with a context returned by WithCorrelationID.
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// correlationIDKey is the context key of correlation IDs.
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying a correlation ID. Requests
+// made with the returned context send the ID in the CorrelationIDHeader
+// header, so they can be related to the operation which made them.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// IdempotencyKeyHeader is the header carrying the idempotency key of POST
+// requests, so a server honoring it can tell a retried request from a new
+// one. The Datadog API documentation doesn't list endpoints which honor it,
+// so it only helps with proxies or endpoints known to deduplicate requests.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyKey is the context key of idempotency keys.
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying an idempotency key. POST
+// requests made with the returned context, and their retries, send the key
+// in the IdempotencyKeyHeader header.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// newIdempotencyKey returns a random UUID to be used as idempotency key.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40 // Version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// ResponseMetadata holds details about the HTTP response to a request.
+type ResponseMetadata struct {
+	// StatusCode tells submissions which were accepted but not processed
+	// yet, with a 202, from requests fully applied, with a 200.
+	StatusCode int
+	Header     http.Header
+	RateLimit  RateLimit
+
+	// Duration is how long the request took, including retries.
+	Duration time.Duration
+}
+
+func newResponseMetadata(resp *http.Response) ResponseMetadata {
+	return ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		RateLimit:  parseRateLimit(resp.Header),
+	}
 }
 
 // uriForAPI is to be called with something like "/v1/events" and it will give
-// the proper request URI to be posted to.
+// the proper request URI to be posted to. Paths which already start with the
+// API path prefix, like "/api/v2/roles", are used as is.
 func (client *Client) uriForAPI(api string) (string, error) {
-	apiBase, err := url.Parse(client.baseUrl + "/api" + api)
+	prefix := client.GetAPIPathPrefix()
+	if !strings.HasPrefix(api, prefix+"/") {
+		api = prefix + api
+	}
+	apiBase, err := url.Parse(client.GetAPIBaseUrl() + api)
 	if err != nil {
 		return "", err
 	}
+	apiKey, appKey := client.keys()
 	q := apiBase.Query()
-	q.Add("api_key", client.apiKey)
-	q.Add("application_key", client.appKey)
+	q.Add("api_key", apiKey)
+	q.Add("application_key", appKey)
 	apiBase.RawQuery = q.Encode()
 	return apiBase.String(), nil
 }
 
+// redact removes the api and application keys, and the secrets returned by
+// the key management API, from s. It is to be used on anything the client
+// surfaces which might hold them.
+func (client *Client) redact(s string) string {
+	for _, secret := range client.secrets() {
+		if len(secret) > 0 {
+			s = strings.Replace(s, secret, "redacted", -1)
+		}
+	}
+	return s
+}
+
 // redactError removes api and application keys from error strings
 func (client *Client) redactError(err error) error {
 	if err == nil {
 		return nil
 	}
-	errString := err.Error()
-
-	if len(client.apiKey) > 0 {
-		errString = strings.Replace(errString, client.apiKey, "redacted", -1)
-	}
-	if len(client.appKey) > 0 {
-		errString = strings.Replace(errString, client.appKey, "redacted", -1)
+	if exhausted, ok := err.(*RetriesExhaustedError); ok {
+		return &RetriesExhaustedError{Err: client.redactError(exhausted.Err)}
 	}
+	errString := client.redact(err.Error())
 
 	// Return original error if no replacements were made to keep the original,
 	// probably more useful error type information.
@@ -70,7 +182,14 @@ func (client *Client) redactError(err error) error {
 // errors.
 func (client *Client) doJsonRequest(method, api string,
 	reqbody, out interface{}) error {
-	if err := client.doJsonRequestUnredacted(method, api, reqbody, out); err != nil {
+	return client.doJsonRequestWithContext(context.Background(), method, api, reqbody, out)
+}
+
+// doJsonRequestWithContext is like doJsonRequest, but the request is
+// abandoned once ctx is done.
+func (client *Client) doJsonRequestWithContext(ctx context.Context, method, api string,
+	reqbody, out interface{}) error {
+	if err := client.doJsonRequestUnredacted(ctx, method, api, reqbody, out); err != nil {
 		return client.redactError(err)
 	}
 	return nil
@@ -78,41 +197,195 @@ func (client *Client) doJsonRequest(method, api string,
 
 // doJsonRequestUnredacted is the simplest type of request: a method on a URI that returns
 // some JSON result which we unmarshal into the passed interface.
-func (client *Client) doJsonRequestUnredacted(method, api string,
+func (client *Client) doJsonRequestUnredacted(ctx context.Context, method, api string,
 	reqbody, out interface{}) error {
-	req, err := client.createRequest(method, api, reqbody)
+	resp, err := client.doRequestWithContext(ctx, method, api, reqbody)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
+
+	return client.handleResponse(resp, out)
+}
 
-	// Perform the request and retry it if it's not a POST or PUT request
-	var resp *http.Response
-	if method == "POST" || method == "PUT" {
-		resp, err = client.HttpClient.Do(req)
+// doRequest builds the request for a method on a URI and performs it,
+// retrying if it's not a POST, PUT or PATCH request. Rate limited requests are
+// sent again if OnRateLimited allows it. The caller is responsible for
+// closing the body of the returned response.
+func (client *Client) doRequest(method, api string, reqbody interface{}) (*http.Response, error) {
+	return client.doRequestWithContext(context.Background(), method, api, reqbody)
+}
+
+// doRequestWithContext is like doRequest, but the request, its retries and
+// the waits for rate limits to reset are abandoned once ctx is done.
+func (client *Client) doRequestWithContext(ctx context.Context, method, api string, reqbody interface{}) (*http.Response, error) {
+	if _, ok := ctx.Value(idempotencyKeyKey{}).(string); !ok && method == "POST" && client.IdempotencyKeys {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+		ctx = WithIdempotencyKey(ctx, key)
+	}
+
+	release, err := client.acquireRequestSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.doRateLimitedRequest(ctx, method, api, reqbody)
+	if err != nil || resp == nil {
+		release()
 	} else {
-		resp, err = client.doRequestWithRetries(req, client.RetryTimeout)
+		resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	}
+	return resp, err
+}
+
+// acquireRequestSlot blocks until fewer than MaxConcurrentRequests requests
+// are in flight, or until ctx is done. It returns the function releasing the
+// slot, which may be called more than once.
+func (client *Client) acquireRequestSlot(ctx context.Context) (func(), error) {
+	if client.MaxConcurrentRequests <= 0 {
+		return func() {}, nil
 	}
+
+	client.inFlightMu.Lock()
+	if cap(client.inFlight) != client.MaxConcurrentRequests {
+		// Requests in flight release their slot of the previous channel.
+		client.inFlight = make(chan struct{}, client.MaxConcurrentRequests)
+	}
+	inFlight := client.inFlight
+	client.inFlightMu.Unlock()
+
+	select {
+	case inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-inFlight })
+	}, nil
+}
+
+// releasingBody releases the slot of a request once its response body is
+// closed, as the connection is in use until then.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// maxRateLimitedRetries caps the number of times a rate limited request is
+// sent again.
+const maxRateLimitedRetries = 10
+
+// doRateLimitedRequest sends a request, and sends it again when it is rate
+// limited and OnRateLimited allows it, up to maxRateLimitedRetries times.
+func (client *Client) doRateLimitedRequest(ctx context.Context, method, api string, reqbody interface{}) (*http.Response, error) {
+	bo := client.getBackOff(0)
+	for retries := 0; ; retries++ {
+		resp, err := client.sendRequest(ctx, method, api, reqbody)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || client.OnRateLimited == nil || client.DisableRetries {
+			return resp, err
+		}
+		if retries == maxRateLimitedRetries {
+			// The request fails like when OnRateLimited isn't set.
+			return resp, nil
+		}
+
+		rl := parseRateLimit(resp.Header)
+		resp.Body.Close()
+		if err := client.OnRateLimited(rl); err != nil {
+			// The response is returned along with the error so its rate
+			// limit headers remain available.
+			return resp, err
+		}
+		wait := rl.Reset
+		if wait <= 0 {
+			// Without a reset time, back off like retries do rather than
+			// sending the request again right away.
+			wait = bo.NextBackOff()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		client.metrics().IncRetry()
+	}
+}
+
+// sendRequest builds the request for a method on a URI and performs it once
+// through the middlewares of the client, with retries as doWithRetries does.
+func (client *Client) sendRequest(ctx context.Context, method, api string, reqbody interface{}) (*http.Response, error) {
+	req, err := client.createRequest(method, api, reqbody)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if id, ok := ctx.Value(correlationIDKey{}).(string); ok {
+		req.Header.Set(CorrelationIDHeader, id)
+	}
+	if key, ok := ctx.Value(idempotencyKeyKey{}).(string); ok && method == "POST" {
+		req.Header.Set(IdempotencyKeyHeader, key)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return err
+	var doer Doer = DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return client.doWithRetries(req, api)
+	})
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		doer = client.middlewares[i](doer)
+	}
+	return doer.Do(req)
+}
+
+// doWithRetries performs a request for an API path, retrying it if it's not a
+// POST, PUT or PATCH request and retries aren't disabled.
+func (client *Client) doWithRetries(req *http.Request, api string) (*http.Response, error) {
+	method := req.Method
+	if client.DisableRetries {
+		return client.do(req)
+	}
+	if client.RetryPolicy != nil {
+		if config := client.RetryPolicy(method, api); config != nil {
+			if config.Disabled {
+				return client.do(req)
+			}
+			return client.doRequestWithRetries(req, client.retryConfig().merge(config))
 		}
-		return fmt.Errorf("API error %s: %s", resp.Status, body)
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	// Perform the request and retry it if it's not a POST, PUT or PATCH request
+	if method == "POST" || method == "PUT" || method == "PATCH" {
+		return client.do(req)
+	}
+	return client.doRequestWithRetries(req, client.retryConfig())
+}
+
+// handleResponse checks the response for errors and unmarshals its JSON body
+// into the passed interface.
+func (client *Client) handleResponse(resp *http.Response, out interface{}) error {
+	body, err := client.readResponse(resp)
 	if err != nil {
 		return err
 	}
 
 	// If we got no body, by default let's just make an empty JSON dict. This
-	// saves us some work in other parts of the code.
+	// saves us some work in other parts of the code. Slices and maps are left
+	// untouched instead, as an empty one would mean something else than no
+	// data.
 	if len(body) == 0 {
+		if out != nil && client.RequireResponseBody {
+			return ErrEmptyResponse
+		}
+		if isSliceOrMapPointer(out) {
+			return nil
+		}
 		body = []byte{'{', '}'}
 	}
 
@@ -130,6 +403,13 @@ func (client *Client) doJsonRequestUnredacted(method, api string,
 	if common != nil && common.Status == "error" {
 		return fmt.Errorf("API returned error: %s", common.Error)
 	}
+	if common != nil && len(common.Errors) > 0 {
+		messages := make([]string, 0, len(common.Errors))
+		for _, e := range common.Errors {
+			messages = append(messages, e.String())
+		}
+		return fmt.Errorf("API returned error: %s", strings.Join(messages, "; "))
+	}
 
 	// If they don't care about the body, then we don't care to give them one,
 	// so bail out because we're done.
@@ -140,18 +420,87 @@ func (client *Client) doJsonRequestUnredacted(method, api string,
 	return json.Unmarshal(body, &out)
 }
 
-// doRequestWithRetries performs an HTTP request repeatedly for maxTime or until
-// no error and no acceptable HTTP response code was returned.
-func (client *Client) doRequestWithRetries(req *http.Request, maxTime time.Duration) (*http.Response, error) {
+// isSliceOrMapPointer tells whether v is a pointer to a slice or a map.
+func isSliceOrMapPointer(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return false
+	}
+	kind := rv.Elem().Kind()
+	return kind == reflect.Slice || kind == reflect.Map
+}
+
+// readResponse returns the body of a response, or an error if the response
+// doesn't have a 2xx status code. Only the first MaxErrorBodyBytes of the body
+// of an error response are read, the rest is discarded. 5xx responses which
+// aren't JSON give a *ServiceUnavailableError.
+func (client *Client) readResponse(resp *http.Response) ([]byte, error) {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return ioutil.ReadAll(resp.Body)
+	}
+
+	maxBytes := client.MaxErrorBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxErrorBodyBytes
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, int64(maxBytes)))
+	if err != nil {
+		return nil, err
+	}
+	// Drain the body so the connection can be reused.
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 500 && !json.Valid(body) {
+		return nil, newServiceUnavailableError(resp, body)
+	}
+	return nil, fmt.Errorf("API error %s: %s", resp.Status, body)
+}
+
+// doRawRequest performs a request like doJsonRequest does, but returns the
+// body of the response as is instead of decoding it as JSON. This is needed
+// for endpoints that return text or binary data. The metadata, including the
+// rate limit, is returned whenever a response was received, even along with
+// an error.
+func (client *Client) doRawRequest(method, api string, reqbody interface{}) ([]byte, ResponseMetadata, error) {
+	var meta ResponseMetadata
+	start := time.Now()
+	resp, err := client.doRequest(method, api, reqbody)
+	if resp != nil {
+		defer resp.Body.Close()
+		meta = newResponseMetadata(resp)
+	}
+	meta.Duration = time.Since(start)
+	if err != nil {
+		return nil, meta, client.redactError(err)
+	}
+
+	body, err := client.readResponse(resp)
+	if err != nil {
+		return nil, meta, client.redactError(err)
+	}
+	return body, meta, nil
+}
+
+// GetRaw performs a request against an API path, e.g. "/v1/usage/hosts", and
+// returns the undecoded body of the response. It is an escape hatch for
+// endpoints which aren't modeled by this library yet. The body, if any, is
+// sent as JSON.
+func (client *Client) GetRaw(method, api string, body interface{}) ([]byte, ResponseMetadata, error) {
+	return client.doRawRequest(method, api, body)
+}
+
+// doRequestWithRetries performs an HTTP request repeatedly, with the backoff
+// and within the limits of config, until no error and no acceptable HTTP
+// response code was returned. Retries stop once the context of the request is
+// done.
+func (client *Client) doRequestWithRetries(req *http.Request, config RetryConfig) (*http.Response, error) {
 	var (
 		err  error
 		resp *http.Response
-		bo   = backoff.NewExponentialBackOff()
+		bo   = &contextBackOff{delegate: config.backOff(), ctx: req.Context()}
 		body []byte
 	)
 
-	bo.MaxElapsedTime = maxTime
-
 	// Save the body for retries
 	if req.Body != nil {
 		body, err = ioutil.ReadAll(req.Body)
@@ -160,41 +509,223 @@ func (client *Client) doRequestWithRetries(req *http.Request, maxTime time.Durat
 		}
 	}
 
+	attempts := 0
 	operation := func() error {
+		if attempts > 0 {
+			client.metrics().IncRetry()
+		}
+		attempts++
 		if body != nil {
 			r := bytes.NewReader(body)
 			req.Body = ioutil.NopCloser(r)
 		}
 
-		resp, err = client.HttpClient.Do(req)
+		resp, err = client.do(req)
+		if !client.shouldRetry(resp, err) {
+			return nil
+		}
 		if err != nil {
 			return err
 		}
+		return fmt.Errorf("Received HTTP status code %d", resp.StatusCode)
+	}
 
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			// 2xx all done
-			return nil
-		} else if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-			// 4xx are not retryable
-			return nil
+	if retryErr := backoff.Retry(operation, bo); retryErr != nil {
+		if req.Context().Err() != nil {
+			return resp, retryErr
 		}
+		if err == nil && resp != nil {
+			// Report the last response like any failed request, e.g. as a
+			// *ServiceUnavailableError.
+			if _, respErr := client.readResponse(resp); respErr != nil {
+				retryErr = respErr
+			}
+			resp.Body.Close()
+		}
+		return resp, &RetriesExhaustedError{Err: retryErr}
+	}
+	return resp, err
+}
 
-		return fmt.Errorf("Received HTTP status code %d", resp.StatusCode)
+// shouldRetry tells whether a request should be retried given its outcome.
+// Unless overridden by ShouldRetry, transport errors and any response but
+// 2xx and 4xx are retried.
+func (client *Client) shouldRetry(resp *http.Response, err error) bool {
+	if client.ShouldRetry != nil {
+		return client.ShouldRetry(resp, err)
+	}
+	if err != nil {
+		return true
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		// 2xx all done
+		return false
+	} else if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		// 4xx are not retryable
+		return false
 	}
+	return true
+}
 
-	err = backoff.Retry(operation, bo)
+// RetryConfig holds the retry settings of requests, as returned by
+// Client.RetryPolicy. Zero values keep the settings of the client.
+type RetryConfig struct {
+	// Disabled makes requests be sent exactly once.
+	Disabled bool
 
-	return resp, err
+	// Timeout limits the time spent retrying, like Client.RetryTimeout.
+	Timeout time.Duration
+	// MaxRetries limits the number of retries, like Client.MaxRetries.
+	MaxRetries int
+
+	// The exponential backoff between retries.
+	InitialInterval     time.Duration
+	MaxInterval         time.Duration
+	Multiplier          float64
+	RandomizationFactor float64
+}
+
+// retryConfig returns the retry settings of the client.
+func (client *Client) retryConfig() RetryConfig {
+	return RetryConfig{
+		Timeout:             client.RetryTimeout,
+		MaxRetries:          client.MaxRetries,
+		InitialInterval:     client.RetryInitialInterval,
+		MaxInterval:         client.RetryMaxInterval,
+		Multiplier:          client.RetryMultiplier,
+		RandomizationFactor: client.RetryRandomizationFactor,
+	}
+}
+
+// merge returns config with the settings set in override.
+func (config RetryConfig) merge(override *RetryConfig) RetryConfig {
+	if override.Timeout > 0 {
+		config.Timeout = override.Timeout
+	}
+	if override.MaxRetries > 0 {
+		config.MaxRetries = override.MaxRetries
+	}
+	if override.InitialInterval > 0 {
+		config.InitialInterval = override.InitialInterval
+	}
+	if override.MaxInterval > 0 {
+		config.MaxInterval = override.MaxInterval
+	}
+	if override.Multiplier > 0 {
+		config.Multiplier = override.Multiplier
+	}
+	if override.RandomizationFactor > 0 {
+		config.RandomizationFactor = override.RandomizationFactor
+	}
+	return config
+}
+
+// backOff returns the backoff policy for retrying a request with config.
+// Zero values keep the defaults of github.com/cenkalti/backoff.
+func (config RetryConfig) backOff() backoff.BackOff {
+	bo := backoff.NewExponentialBackOff()
+	if config.InitialInterval > 0 {
+		bo.InitialInterval = config.InitialInterval
+	}
+	if config.MaxInterval > 0 {
+		bo.MaxInterval = config.MaxInterval
+	}
+	if config.Multiplier > 0 {
+		bo.Multiplier = config.Multiplier
+	}
+	if config.RandomizationFactor > 0 {
+		bo.RandomizationFactor = math.Min(config.RandomizationFactor, 1)
+	}
+	bo.MaxElapsedTime = config.Timeout
+	if config.MaxRetries > 0 {
+		return &maxRetriesBackOff{delegate: bo, max: config.MaxRetries}
+	}
+	return bo
+}
+
+// getBackOff returns the backoff policy for retrying a request for maxTime,
+// configured with the retry settings of the client.
+func (client *Client) getBackOff(maxTime time.Duration) backoff.BackOff {
+	config := client.retryConfig()
+	config.Timeout = maxTime
+	return config.backOff()
+}
+
+// maxRetriesBackOff stops retrying once max retries were made, or when the
+// backoff it delegates to says so.
+type maxRetriesBackOff struct {
+	delegate backoff.BackOff
+	max      int
+	retries  int
+}
+
+func (b *maxRetriesBackOff) NextBackOff() time.Duration {
+	if b.retries >= b.max {
+		return backoff.Stop
+	}
+	b.retries++
+	return b.delegate.NextBackOff()
+}
+
+func (b *maxRetriesBackOff) Reset() {
+	b.retries = 0
+	b.delegate.Reset()
+}
+
+// contextBackOff stops retrying once ctx is done, or when waiting for the
+// next retry would outlast its deadline. The error of ctx is kept in ctxErr
+// when it stops because of ctx.
+type contextBackOff struct {
+	delegate backoff.BackOff
+	ctx      context.Context
+	ctxErr   error
+}
+
+func (b *contextBackOff) NextBackOff() time.Duration {
+	if err := b.ctx.Err(); err != nil {
+		b.ctxErr = err
+		return backoff.Stop
+	}
+	next := b.delegate.NextBackOff()
+	if deadline, ok := b.ctx.Deadline(); ok && next != backoff.Stop && time.Now().Add(next).After(deadline) {
+		b.ctxErr = context.DeadlineExceeded
+		return backoff.Stop
+	}
+	return next
+}
+
+func (b *contextBackOff) Reset() {
+	b.delegate.Reset()
+}
+
+// gzipBody is a request body sent compressed with gzip.
+type gzipBody struct {
+	body interface{}
 }
 
 func (client *Client) createRequest(method, api string, reqbody interface{}) (*http.Request, error) {
 	// Handle the body if they gave us one.
 	var bodyReader io.Reader
+	compressed, compress := reqbody.(gzipBody)
+	if compress {
+		reqbody = compressed.body
+	}
 	if method != "GET" && reqbody != nil {
 		bjson, err := json.Marshal(reqbody)
 		if err != nil {
 			return nil, err
 		}
+		if compress {
+			var buf bytes.Buffer
+			w := gzip.NewWriter(&buf)
+			if _, err := w.Write(bjson); err != nil {
+				return nil, err
+			}
+			if err := w.Close(); err != nil {
+				return nil, err
+			}
+			bjson = buf.Bytes()
+		}
 		bodyReader = bytes.NewReader(bjson)
 	}
 
@@ -206,8 +737,16 @@ func (client *Client) createRequest(method, api string, reqbody interface{}) (*h
 	if err != nil {
 		return nil, err
 	}
+	for name, values := range client.Headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
 	if bodyReader != nil {
-		req.Header.Add("Content-Type", "application/json")
+		req.Header.Set("Content-Type", "application/json")
+		if compress {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
 	}
 	return req, nil
 }
@@ -1,11 +1,18 @@
 package datadog
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/cenkalti/backoff"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,6 +35,54 @@ func TestUriForApi(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "https://base.datadoghq.com/api/v1/events?api_key=sample_api_key&application_key=sample_app_key", uri)
 	})
+	t.Run("Get Uri for api with a separate api base url", func(t *testing.T) {
+		c.SetAPIBaseUrl("https://api.datadoghq.com")
+		defer c.SetAPIBaseUrl("")
+		uri, err := c.uriForAPI("/v1/events")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://api.datadoghq.com/api/v1/events?api_key=sample_api_key&application_key=sample_app_key", uri)
+		assert.Equal(t, "https://base.datadoghq.com", c.GetBaseUrl())
+	})
+	t.Run("Get Uri for api with the prefix already in the path", func(t *testing.T) {
+		uri, err := c.uriForAPI("/api/v2/roles")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://base.datadoghq.com/api/v2/roles?api_key=sample_api_key&application_key=sample_app_key", uri)
+	})
+	t.Run("Get Uri for api with another path prefix", func(t *testing.T) {
+		defer c.SetAPIPathPrefix("")
+		for prefix, expected := range map[string]string{
+			"/proxy/datadog/": "https://base.datadoghq.com/proxy/datadog/v2/roles",
+			"/":               "https://base.datadoghq.com/v2/roles",
+		} {
+			c.SetAPIPathPrefix(prefix)
+			uri, err := c.uriForAPI("/v2/roles")
+			assert.Nil(t, err)
+			assert.Equal(t, expected+"?api_key=sample_api_key&application_key=sample_app_key", uri)
+		}
+	})
+}
+
+func TestSetKeysConcurrently(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			c.SetKeys(fmt.Sprintf("api_key_%d", i), fmt.Sprintf("app_key_%d", i))
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	}
+	<-done
 }
 
 func TestRedactError(t *testing.T) {
@@ -104,6 +159,40 @@ func TestErrorHandling(t *testing.T) {
 			}
 		}
 	})
+	t.Run("Returns error if v1 errors are reported", func(t *testing.T) {
+		s := makeTestServer(200, `{"errors": ["something wrong"]}`)
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		for _, method := range []string{"GET", "POST", "PUT"} {
+			err := c.doJsonRequest(method, "/v1/something", nil, nil)
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), "something wrong")
+			}
+		}
+	})
+	t.Run("Returns error if v2 errors are reported", func(t *testing.T) {
+		s := makeTestServer(200, `{"errors": [{"title": "Bad Request", "detail": "something wrong"}, {"detail": "something else"}]}`)
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		for _, method := range []string{"GET", "POST", "PUT"} {
+			err := c.doJsonRequest(method, "/v1/something", nil, nil)
+			if assert.NotNil(t, err) {
+				assert.Equal(t, "API returned error: Bad Request: something wrong; something else", err.Error())
+			}
+		}
+	})
+	t.Run("Does not return error for v2 data envelopes", func(t *testing.T) {
+		s := makeTestServer(200, `{"data": {"type": "roles", "id": "abc"}}`)
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		for _, method := range []string{"GET", "POST", "PUT"} {
+			err := c.doJsonRequest(method, "/v1/something", nil, nil)
+			assert.Nil(t, err)
+		}
+	})
 	t.Run("Does not return error if status is ok", func(t *testing.T) {
 		s := makeTestServer(200, `{"status": "ok"}`)
 		defer s.Close()
@@ -115,3 +204,418 @@ func TestErrorHandling(t *testing.T) {
 		}
 	})
 }
+
+func TestCustomHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-1", r.Header.Get("X-Tenant-Id"))
+		assert.Equal(t, []string{"a", "b"}, r.Header["X-Multi"])
+		assert.Equal(t, "sample_api_key", r.URL.Query().Get("api_key"))
+		assert.Equal(t, "sample_app_key", r.URL.Query().Get("application_key"))
+		if r.Method == "POST" {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		}
+		w.Write([]byte(`{"valid": true}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.Headers = http.Header{}
+	c.Headers.Set("X-Tenant-Id", "tenant-1")
+	c.Headers.Set("Content-Type", "text/plain")
+	c.Headers.Add("X-Multi", "a")
+	c.Headers.Add("X-Multi", "b")
+
+	t.Run("Headers are sent with JSON requests", func(t *testing.T) {
+		assert.Nil(t, c.doJsonRequest("POST", "/v1/something", map[string]string{"a": "b"}, nil))
+	})
+	t.Run("Headers are sent when validating", func(t *testing.T) {
+		valid, err := c.Validate()
+		assert.Nil(t, err)
+		assert.True(t, valid)
+	})
+	t.Run("Errors are still redacted", func(t *testing.T) {
+		c.SetBaseUrl("http://127.0.0.1:0")
+		c.RetryTimeout = 1
+
+		err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+		if assert.NotNil(t, err) {
+			assert.NotContains(t, err.Error(), "sample_api_key")
+		}
+	})
+}
+
+func TestGetRaw(t *testing.T) {
+	c := Client{
+		apiKey:       "sample_api_key",
+		appKey:       "sample_app_key",
+		HttpClient:   &http.Client{},
+		RetryTimeout: 1000,
+	}
+	t.Run("Returns the body as is", func(t *testing.T) {
+		s := makeTestServer(200, "a,b\n1,2\n")
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		body, meta, err := c.GetRaw("GET", "/v1/something", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "a,b\n1,2\n", string(body))
+		assert.Equal(t, 200, meta.StatusCode)
+		assert.Equal(t, "application/json", meta.Header.Get("Content-Type"))
+	})
+	t.Run("Returns error on http error codes", func(t *testing.T) {
+		s := makeTestServer(403, `{"errors": ["Forbidden"]}`)
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		_, meta, err := c.GetRaw("GET", "/v1/something", nil)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "Forbidden")
+		}
+		assert.Equal(t, 403, meta.StatusCode)
+	})
+	t.Run("Returns the duration of the request", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+			w.Write([]byte(`{}`))
+		}))
+		defer s.Close()
+		c.SetBaseUrl(s.URL)
+
+		_, meta, err := c.GetRaw("GET", "/v1/something", nil)
+		assert.Nil(t, err)
+		assert.True(t, meta.Duration >= 50*time.Millisecond, "expect a duration of at least 50ms. Got %s", meta.Duration)
+		assert.True(t, meta.Duration < time.Second, "expect a duration under 1s. Got %s", meta.Duration)
+	})
+}
+
+func TestGetBackOff(t *testing.T) {
+	t.Run("Defaults are kept when unset", func(t *testing.T) {
+		c := Client{}
+		bo, ok := c.getBackOff(time.Minute).(*backoff.ExponentialBackOff)
+		if assert.True(t, ok) {
+			assert.Equal(t, backoff.DefaultInitialInterval, bo.InitialInterval)
+			assert.Equal(t, backoff.DefaultMaxInterval, bo.MaxInterval)
+			assert.Equal(t, backoff.DefaultMultiplier, bo.Multiplier)
+			assert.Equal(t, backoff.DefaultRandomizationFactor, bo.RandomizationFactor)
+			assert.Equal(t, time.Minute, bo.MaxElapsedTime)
+		}
+	})
+	t.Run("Retry settings of the client are used", func(t *testing.T) {
+		c := Client{
+			RetryInitialInterval:     time.Second,
+			RetryMaxInterval:         10 * time.Second,
+			RetryMultiplier:          3,
+			RetryRandomizationFactor: 2,
+		}
+		bo, ok := c.getBackOff(time.Minute).(*backoff.ExponentialBackOff)
+		if assert.True(t, ok) {
+			assert.Equal(t, time.Second, bo.InitialInterval)
+			assert.Equal(t, 10*time.Second, bo.MaxInterval)
+			assert.Equal(t, float64(3), bo.Multiplier)
+			assert.Equal(t, float64(1), bo.RandomizationFactor)
+		}
+	})
+}
+
+func TestMaxRetries(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(500)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.MaxRetries = 3
+
+	err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+	if assert.IsType(t, &RetriesExhaustedError{}, err) {
+		assert.Contains(t, err.(*RetriesExhaustedError).Unwrap().Error(), "500 Internal Server Error")
+	}
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
+
+func TestRetriesExhaustedErrorOfLastResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("<html>Down for maintenance</html>"))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.MaxRetries = 2
+
+	_, err := c.GetMonitor(1)
+	assert.IsType(t, &RetriesExhaustedError{}, err)
+	var unavailable *ServiceUnavailableError
+	if assert.True(t, errors.As(err, &unavailable)) {
+		assert.Equal(t, http.StatusServiceUnavailable, unavailable.StatusCode)
+		assert.Equal(t, 30*time.Second, unavailable.RetryAfter)
+		assert.Contains(t, unavailable.Body, "Down for maintenance")
+	}
+}
+
+func TestRetriesExhaustedErrorIsRedacted(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl("http://127.0.0.1:0")
+	c.RetryInitialInterval = time.Millisecond
+	c.MaxRetries = 1
+
+	err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+	if assert.IsType(t, &RetriesExhaustedError{}, err) {
+		assert.NotContains(t, err.Error(), "sample_api_key")
+		assert.NotContains(t, err.Error(), "sample_app_key")
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.ShouldRetry = func(resp *http.Response, err error) bool {
+		return err != nil || resp.StatusCode == http.StatusConflict
+	}
+
+	err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	c.ShouldRetry = func(resp *http.Response, err error) bool { return false }
+	atomic.StoreInt32(&requests, -10)
+	err = c.doJsonRequest("GET", "/v1/something", nil, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(-9), atomic.LoadInt32(&requests))
+}
+
+func TestDeleteWithBodyRetries(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"public_ids": ["abc-def-ghi"]}`, string(body))
+
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+
+	reqbody := map[string][]string{"public_ids": {"abc-def-ghi"}}
+	assert.Nil(t, c.doJsonRequest("DELETE", "/v1/something", reqbody, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestDisableRetries(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.DisableRetries = true
+
+	err := c.doJsonRequest("GET", "/v1/something", nil, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.MaxRetries = 1
+	c.RetryPolicy = func(method, api string) *RetryConfig {
+		switch {
+		case api == "/v1/series":
+			return &RetryConfig{MaxRetries: 2}
+		case method == "GET" && strings.HasPrefix(api, "/v1/monitor"):
+			return &RetryConfig{MaxRetries: 4}
+		case api == "/v1/dash":
+			return &RetryConfig{Disabled: true}
+		}
+		return nil
+	}
+
+	for _, tc := range []struct {
+		method, api string
+		expected    int32
+	}{
+		{"POST", "/v1/series", 3},
+		{"GET", "/v1/monitor/1", 5},
+		{"GET", "/v1/dash", 1},
+		{"GET", "/v1/events", 2},
+		{"POST", "/v1/events", 1},
+	} {
+		atomic.StoreInt32(&requests, 0)
+		assert.NotNil(t, c.doJsonRequest(tc.method, tc.api, nil, nil))
+		assert.Equal(t, tc.expected, atomic.LoadInt32(&requests), "%s %s", tc.method, tc.api)
+	}
+}
+
+func TestCorrelationID(t *testing.T) {
+	var ids []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(CorrelationIDHeader))
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	ctx := WithCorrelationID(context.Background(), "op-1234")
+	assert.Nil(t, c.doJsonRequestWithContext(ctx, "GET", "/v1/something", nil, nil))
+	assert.Nil(t, c.doJsonRequestWithContext(ctx, "POST", "/v1/something", map[string]string{}, nil))
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	assert.Equal(t, []string{"op-1234", "op-1234", ""}, ids)
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys) == 1 {
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.OnRateLimited = func(rl RateLimit) error { return nil }
+	c.IdempotencyKeys = true
+
+	assert.Nil(t, c.doJsonRequest("POST", "/v1/something", map[string]string{}, nil))
+	assert.Nil(t, c.doJsonRequest("POST", "/v1/something", map[string]string{}, nil))
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	ctx := WithIdempotencyKey(context.Background(), "create-1234")
+	assert.Nil(t, c.doJsonRequestWithContext(ctx, "POST", "/v1/something", map[string]string{}, nil))
+
+	if assert.Len(t, keys, 5) {
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, keys[0])
+		assert.Equal(t, keys[0], keys[1], "expect retries to send the same key")
+		assert.NotEqual(t, keys[1], keys[2])
+		assert.Equal(t, "", keys[3])
+		assert.Equal(t, "create-1234", keys[4])
+	}
+}
+
+func TestEmptyResponseBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	var monitor Monitor
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/monitor/1", nil, &monitor))
+	var monitors []Monitor
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/monitor", nil, &monitors))
+	assert.Nil(t, monitors)
+
+	c.RequireResponseBody = true
+	assert.Equal(t, ErrEmptyResponse, c.doJsonRequest("GET", "/v1/monitor/1", nil, &monitor))
+	assert.Equal(t, ErrEmptyResponse, c.doJsonRequest("GET", "/v1/monitor", nil, &monitors))
+	assert.Nil(t, c.doJsonRequest("DELETE", "/v1/monitor/1", nil, nil))
+}
+
+func TestServiceUnavailableError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Retry-After", "900")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("<html><body>" + strings.Repeat("Down for maintenance. ", 50) + "</body></html>"))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	err := c.doJsonRequest("POST", "/v1/something", nil, nil)
+	if assert.IsType(t, &ServiceUnavailableError{}, err) {
+		unavailable := err.(*ServiceUnavailableError)
+		assert.Equal(t, http.StatusServiceUnavailable, unavailable.StatusCode)
+		assert.Equal(t, 15*time.Minute, unavailable.RetryAfter)
+		assert.Len(t, unavailable.Body, maxErrorBodySize+len("..."))
+		assert.Contains(t, err.Error(), "retry after 15m0s: <html><body>Down for maintenance.")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"soon":                          0,
+		"Sat, 01 Jun 2019 13:00:00 GMT": time.Hour,
+		"Sat, 01 Jun 2019 11:00:00 GMT": 0,
+	} {
+		header := http.Header{}
+		if value != "" {
+			header.Set("Retry-After", value)
+		}
+		assert.Equal(t, expected, parseRetryAfter(header, now), value)
+	}
+}
+
+func TestMaxErrorBodyBytes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		w.Write([]byte(strings.Repeat("x", 1<<20)))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	prefix := "API error 413 Request Entity Too Large: "
+	err := c.doJsonRequest("POST", "/v1/something", nil, nil)
+	if assert.NotNil(t, err) {
+		assert.Len(t, err.Error(), len(prefix)+DefaultMaxErrorBodyBytes)
+	}
+
+	c.MaxErrorBodyBytes = 10
+	_, _, err = c.GetRaw("POST", "/v1/something", nil)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, prefix+"xxxxxxxxxx", err.Error())
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+)
+
+// Role is a named set of permissions that can be granted to users.
+type Role struct {
+	Id         *string `json:"-"`
+	Name       *string `json:"name,omitempty"`
+	CreatedAt  *string `json:"created_at,omitempty"`
+	ModifiedAt *string `json:"modified_at,omitempty"`
+	UserCount  *int    `json:"user_count,omitempty"`
+
+	// Permissions holds the identifiers of the permissions granted by the role.
+	// When it isn't nil, CreateRole and UpdateRole grant exactly these
+	// permissions, otherwise the permissions of the role are left unchanged.
+	Permissions []string `json:"-"`
+}
+
+// resourceIdentifier identifies a related resource in a v2 API envelope.
+type resourceIdentifier struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+// reqRelationship is the container for sending a single related resource.
+type reqRelationship struct {
+	Data resourceIdentifier `json:"data"`
+}
+
+// relationshipList is a to-many relationship in a v2 API envelope.
+type relationshipList struct {
+	Data []resourceIdentifier `json:"data"`
+}
+
+type roleRelationships struct {
+	Permissions relationshipList `json:"permissions"`
+}
+
+// roleData is the resource envelope used by the roles API.
+type roleData struct {
+	Id            *string            `json:"id,omitempty"`
+	Type          string             `json:"type"`
+	Attributes    *Role              `json:"attributes,omitempty"`
+	Relationships *roleRelationships `json:"relationships,omitempty"`
+}
+
+// reqRole is the container for sending and receiving a single role.
+type reqRole struct {
+	Data *roleData `json:"data"`
+}
+
+// reqRoles is the container for receiving many roles.
+type reqRoles struct {
+	Data []roleData `json:"data"`
+}
+
+func newRoleData(role *Role) *roleData {
+	data := &roleData{Id: role.Id, Type: "roles", Attributes: roleToSend(role)}
+	if role.Permissions != nil {
+		permissions := make([]resourceIdentifier, 0, len(role.Permissions))
+		for _, id := range role.Permissions {
+			permissions = append(permissions, resourceIdentifier{Type: "permissions", Id: id})
+		}
+		data.Relationships = &roleRelationships{Permissions: relationshipList{Data: permissions}}
+	}
+	return data
+}
+
+// roleToSend returns the role to send to the API, without the fields managed
+// by Datadog. The role itself is left untouched.
+func roleToSend(role *Role) *Role {
+	writable := *role
+	writable.CreatedAt = nil
+	writable.ModifiedAt = nil
+	writable.UserCount = nil
+	return &writable
+}
+
+func (d *roleData) role() *Role {
+	if d.Attributes == nil {
+		d.Attributes = &Role{}
+	}
+	d.Attributes.Id = d.Id
+	if d.Relationships != nil {
+		d.Attributes.Permissions = make([]string, 0, len(d.Relationships.Permissions.Data))
+		for _, p := range d.Relationships.Permissions.Data {
+			d.Attributes.Permissions = append(d.Attributes.Permissions, p.Id)
+		}
+	}
+	return d.Attributes
+}
+
+// CreateRole adds a new role to the system. This returns a pointer to a Role
+// so you can pass that to UpdateRole later if needed.
+func (client *Client) CreateRole(role *Role) (*Role, error) {
+	var out reqRole
+	if err := client.doJsonRequest("POST", "/v2/roles", reqRole{Data: newRoleData(role)}, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no role returned")
+	}
+	return out.Data.role(), nil
+}
+
+// UpdateRole takes a role that was previously retrieved through some method
+// and sends it back to the server.
+func (client *Client) UpdateRole(role *Role) error {
+	return client.doJsonRequest("PATCH", fmt.Sprintf("/v2/roles/%s", role.GetId()),
+		reqRole{Data: newRoleData(role)}, nil)
+}
+
+// GetRole retrieves a role by identifier.
+func (client *Client) GetRole(id string) (*Role, error) {
+	var out reqRole
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v2/roles/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	if out.Data == nil {
+		return nil, fmt.Errorf("no role returned")
+	}
+	return out.Data.role(), nil
+}
+
+// DeleteRole removes a role from the system.
+func (client *Client) DeleteRole(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/roles/%s", id),
+		nil, nil)
+}
+
+// GetRoles returns a slice of all roles.
+func (client *Client) GetRoles() ([]Role, error) {
+	var out reqRoles
+	if err := client.doJsonRequest("GET", "/v2/roles", nil, &out); err != nil {
+		return nil, err
+	}
+	roles := make([]Role, 0, len(out.Data))
+	for i := range out.Data {
+		roles = append(roles, *out.Data[i].role())
+	}
+	return roles, nil
+}
+
+// AddPermissionToRole grants a permission to a role.
+func (client *Client) AddPermissionToRole(roleId, permissionId string) error {
+	return client.doJsonRequest("POST", fmt.Sprintf("/v2/roles/%s/permissions", roleId),
+		reqRelationship{Data: resourceIdentifier{Type: "permissions", Id: permissionId}}, nil)
+}
+
+// RemovePermissionFromRole revokes a permission from a role.
+func (client *Client) RemovePermissionFromRole(roleId, permissionId string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/roles/%s/permissions", roleId),
+		reqRelationship{Data: resourceIdentifier{Type: "permissions", Id: permissionId}}, nil)
+}
+
+// AddUserToRole adds a user to a role.
+func (client *Client) AddUserToRole(roleId, userId string) error {
+	return client.doJsonRequest("POST", fmt.Sprintf("/v2/roles/%s/users", roleId),
+		reqRelationship{Data: resourceIdentifier{Type: "users", Id: userId}}, nil)
+}
+
+// RemoveUserFromRole removes a user from a role.
+func (client *Client) RemoveUserFromRole(roleId, userId string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/roles/%s/users", roleId),
+		reqRelationship{Data: resourceIdentifier{Type: "users", Id: userId}}, nil)
+}
@@ -0,0 +1,103 @@
+package datadog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRole(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/roles/abc-123", r.URL.Path)
+		w.Write([]byte(`{
+			"data": {
+				"type": "roles",
+				"id": "abc-123",
+				"attributes": {"name": "Developers", "user_count": 4},
+				"relationships": {
+					"permissions": {"data": [{"type": "permissions", "id": "p1"}, {"type": "permissions", "id": "p2"}]}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{baseUrl: ts.URL, HttpClient: http.DefaultClient}
+
+	role, err := c.GetRole("abc-123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "abc-123", role.GetId())
+	assert.Equal(t, "Developers", role.GetName())
+	assert.Equal(t, 4, role.GetUserCount())
+	assert.Equal(t, []string{"p1", "p2"}, role.Permissions)
+}
+
+func TestCreateRole(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/roles", r.URL.Path)
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"data": {
+			"type": "roles",
+			"attributes": {"name": "Developers"},
+			"relationships": {
+				"permissions": {"data": [{"type": "permissions", "id": "p1"}, {"type": "permissions", "id": "p2"}]}
+			}
+		}}`, string(body))
+		w.Write(body)
+	}))
+	defer ts.Close()
+
+	c := Client{baseUrl: ts.URL, HttpClient: http.DefaultClient}
+
+	role, err := c.CreateRole(&Role{Name: String("Developers"), Permissions: []string{"p1", "p2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"p1", "p2"}, role.Permissions)
+}
+
+func TestUpdateRole(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/v2/roles/abc-123", r.URL.Path)
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var in map[string]interface{}
+		if err := json.Unmarshal(body, &in); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":       "roles",
+				"id":         "abc-123",
+				"attributes": map[string]interface{}{"name": "Operators"},
+			},
+		}, in)
+	}))
+	defer ts.Close()
+
+	c := Client{baseUrl: ts.URL, HttpClient: http.DefaultClient}
+
+	err := c.UpdateRole(&Role{
+		Id:         String("abc-123"),
+		Name:       String("Operators"),
+		CreatedAt:  String("2020-01-01T00:00:00Z"),
+		ModifiedAt: String("2020-01-01T00:05:00Z"),
+		UserCount:  Int(3),
+	})
+	assert.Nil(t, err)
+}
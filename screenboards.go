@@ -10,6 +10,7 @@ package datadog
 
 import (
 	"fmt"
+	"strings"
 )
 
 // Screenboard represents a user created screenboard. This is the full screenboard
@@ -47,6 +48,23 @@ func (client *Client) GetScreenboard(id int) (*Screenboard, error) {
 	return out, nil
 }
 
+// GetScreenboardTemplateVariables returns the template variables of a
+// screenboard, e.g. to reuse them on another board.
+func (client *Client) GetScreenboardTemplateVariables(id int) ([]TemplateVariable, error) {
+	board, err := client.GetScreenboard(id)
+	if err != nil {
+		return nil, err
+	}
+	return board.TemplateVariables, nil
+}
+
+// ScreenboardURL returns the URL of a screenboard in the Datadog
+// application, e.g. to link to it. It is derived from the base URL of the
+// client.
+func (client *Client) ScreenboardURL(id int) string {
+	return fmt.Sprintf("%s/screen/%d", strings.TrimSuffix(client.GetBaseUrl(), "/"), id)
+}
+
 // GetScreenboards returns a list of all screenboards created on this account.
 func (client *Client) GetScreenboards() ([]*ScreenboardLite, error) {
 	var out reqGetScreenboards
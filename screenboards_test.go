@@ -1,10 +1,13 @@
 package datadog
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetScreenboard(t *testing.T) {
@@ -93,3 +96,31 @@ func validateWidget(t *testing.T, wd Widget) {
 		t.Fatalf("expect url %s. Got %s", expectedURL, url)
 	}
 }
+
+func TestScreenboardTemplateVariablesJSON(t *testing.T) {
+	board := Screenboard{Title: String("board")}
+	b, err := json.Marshal(board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"board_title": "board", "widgets": null}`, string(b))
+
+	const fixture = `{
+		"board_title": "board",
+		"template_variables": [{"name": "env", "prefix": "env", "default": "*"}],
+		"widgets": []
+	}`
+	var in Screenboard
+	if err := json.Unmarshal([]byte(fixture), &in); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []TemplateVariable{
+		{Name: String("env"), Prefix: String("env"), Default: String("*")},
+	}, in.TemplateVariables)
+
+	b, err = json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, fixture, string(b))
+}
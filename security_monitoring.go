@@ -0,0 +1,130 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// securityMonitoringRulesPageSize is the number of rules requested per page
+// when listing rules.
+const securityMonitoringRulesPageSize = 100
+
+// SecurityMonitoringRule is a detection rule which generates security signals.
+type SecurityMonitoringRule struct {
+	Id        *string                        `json:"id,omitempty"`
+	Name      *string                        `json:"name,omitempty"`
+	IsEnabled *bool                          `json:"isEnabled,omitempty"`
+	IsDefault *bool                          `json:"isDefault,omitempty"`
+	CreatedAt *int                           `json:"createdAt,omitempty"`
+	Message   *string                        `json:"message,omitempty"`
+	Tags      []string                       `json:"tags,omitempty"`
+	Queries   []SecurityMonitoringRuleQuery  `json:"queries,omitempty"`
+	Cases     []SecurityMonitoringRuleCase   `json:"cases,omitempty"`
+	Options   *SecurityMonitoringRuleOptions `json:"options,omitempty"`
+}
+
+// SecurityMonitoringRuleQuery is a query whose results are evaluated by the
+// cases of a rule.
+type SecurityMonitoringRuleQuery struct {
+	Name           *string  `json:"name,omitempty"`
+	Query          *string  `json:"query,omitempty"`
+	Aggregation    *string  `json:"aggregation,omitempty"`
+	Metric         *string  `json:"metric,omitempty"`
+	GroupByFields  []string `json:"groupByFields,omitempty"`
+	DistinctFields []string `json:"distinctFields,omitempty"`
+}
+
+// SecurityMonitoringRuleCase generates a signal with the given status when
+// its condition is met.
+type SecurityMonitoringRuleCase struct {
+	Name          *string  `json:"name,omitempty"`
+	Status        *string  `json:"status,omitempty"`
+	Condition     *string  `json:"condition,omitempty"`
+	Notifications []string `json:"notifications,omitempty"`
+}
+
+// SecurityMonitoringRuleOptions holds the evaluation settings of a rule, all
+// in seconds.
+type SecurityMonitoringRuleOptions struct {
+	EvaluationWindow  *int `json:"evaluationWindow,omitempty"`
+	KeepAlive         *int `json:"keepAlive,omitempty"`
+	MaxSignalDuration *int `json:"maxSignalDuration,omitempty"`
+}
+
+// reqSecurityMonitoringRules is the container for receiving a page of rules.
+type reqSecurityMonitoringRules struct {
+	Data []SecurityMonitoringRule `json:"data"`
+}
+
+// CreateSecurityMonitoringRule adds a new rule to the system. This returns a
+// pointer to a rule so you can pass that to UpdateSecurityMonitoringRule
+// later if needed.
+func (client *Client) CreateSecurityMonitoringRule(rule *SecurityMonitoringRule) (*SecurityMonitoringRule, error) {
+	var out SecurityMonitoringRule
+	if err := client.doJsonRequest("POST", "/v2/security_monitoring/rules", rule, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSecurityMonitoringRule takes a rule that was previously retrieved
+// through some method and sends it back to the server.
+func (client *Client) UpdateSecurityMonitoringRule(rule *SecurityMonitoringRule) error {
+	return client.doJsonRequest("PUT", fmt.Sprintf("/v2/security_monitoring/rules/%s", rule.GetId()),
+		rule, nil)
+}
+
+// GetSecurityMonitoringRule retrieves a rule by identifier.
+func (client *Client) GetSecurityMonitoringRule(id string) (*SecurityMonitoringRule, error) {
+	var out SecurityMonitoringRule
+	if err := client.doJsonRequest("GET", fmt.Sprintf("/v2/security_monitoring/rules/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSecurityMonitoringRule removes a rule from the system.
+func (client *Client) DeleteSecurityMonitoringRule(id string) error {
+	return client.doJsonRequest("DELETE", fmt.Sprintf("/v2/security_monitoring/rules/%s", id),
+		nil, nil)
+}
+
+// ListSecurityMonitoringRules returns a slice of all rules. The rules are
+// requested page by page.
+func (client *Client) ListSecurityMonitoringRules() ([]SecurityMonitoringRule, error) {
+	var rules []SecurityMonitoringRule
+	seen := map[string]bool{}
+	for page := 0; ; page++ {
+		v := url.Values{}
+		v.Add("page[size]", strconv.Itoa(securityMonitoringRulesPageSize))
+		v.Add("page[number]", strconv.Itoa(page))
+
+		var out reqSecurityMonitoringRules
+		if err := client.doJsonRequest("GET", "/v2/security_monitoring/rules?"+v.Encode(), nil, &out); err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, rule := range out.Data {
+			if !seen[rule.GetId()] {
+				seen[rule.GetId()] = true
+				rules = append(rules, rule)
+				added++
+			}
+		}
+
+		// A page without new rules means the paging parameters were ignored
+		// and every rule was returned at once.
+		if added == 0 || len(out.Data) < securityMonitoringRulesPageSize {
+			return rules, nil
+		}
+	}
+}
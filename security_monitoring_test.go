@@ -0,0 +1,78 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSecurityMonitoringRules(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/security_monitoring/rules", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("page[size]"))
+
+		// Two pages: a full one and one with a single rule.
+		page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		count := 100
+		if page == 1 {
+			count = 1
+		}
+		rules := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			rules = append(rules, fmt.Sprintf(`{
+				"id": "rule-%d-%d",
+				"name": "rule",
+				"isEnabled": true,
+				"queries": [{"query": "source:cloudtrail", "groupByFields": ["@usr.id"]}],
+				"cases": [{"status": "high", "condition": "a > 3"}],
+				"options": {"evaluationWindow": 300, "keepAlive": 3600}
+			}`, page, i))
+		}
+		w.Write([]byte(`{"data": [` + strings.Join(rules, ",") + `]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	rules, err := c.ListSecurityMonitoringRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, rules, 101) {
+		rule := rules[100]
+		assert.Equal(t, "rule-1-0", rule.GetId())
+		assert.True(t, rule.GetIsEnabled())
+		assert.Equal(t, []string{"@usr.id"}, rule.Queries[0].GroupByFields)
+		assert.Equal(t, "a > 3", rule.Cases[0].GetCondition())
+		assert.Equal(t, 300, rule.Options.GetEvaluationWindow())
+	}
+}
+
+func TestListSecurityMonitoringRulesIgnoredPaging(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		rules := make([]string, 0, 100)
+		for i := 0; i < 100; i++ {
+			rules = append(rules, fmt.Sprintf(`{"id": "rule-%d"}`, i))
+		}
+		w.Write([]byte(`{"data": [` + strings.Join(rules, ",") + `]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	rules, err := c.ListSecurityMonitoringRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, rules, 100)
+	assert.Equal(t, 2, requests)
+}
@@ -9,14 +9,63 @@
 package datadog
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"net/url"
 	"strconv"
+	"time"
 )
 
+// MaxMetricsPayloadSize is the default size limit, in bytes, of the batches
+// sent by PostMetricsBatched. Datadog rejects larger payloads.
+const MaxMetricsPayloadSize = 3200000
+
 // DataPoint is a tuple of [UNIX timestamp, value]. This has to use floats
 // because the value could be non-integer.
 type DataPoint [2]*float64
 
+// Point is a single value of a metric at a point in time. It is encoded as
+// the [UNIX timestamp, value] array Datadog expects, with the timestamp in
+// seconds. It can be converted to a DataPoint to be set in a Metric.
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// DataPoint returns the point as a DataPoint.
+func (p Point) DataPoint() DataPoint {
+	ts := p.unixSeconds()
+	value := p.Value
+	return DataPoint{&ts, &value}
+}
+
+// MarshalJSON encodes the point as a [UNIX timestamp, value] array.
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]float64{p.unixSeconds(), p.Value})
+}
+
+// unixSeconds returns the timestamp of the point in seconds, keeping the
+// fractional part.
+func (p Point) unixSeconds() float64 {
+	return float64(p.Timestamp.Unix()) + float64(p.Timestamp.Nanosecond())/1e9
+}
+
+// UnmarshalJSON decodes a [UNIX timestamp, value] array.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var dp DataPoint
+	if err := json.Unmarshal(data, &dp); err != nil {
+		return err
+	}
+	if dp[0] == nil || dp[1] == nil {
+		return fmt.Errorf("invalid point %s", data)
+	}
+	sec, frac := math.Modf(*dp[0])
+	p.Timestamp = time.Unix(int64(sec), int64(frac*1e9))
+	p.Value = *dp[1]
+	return nil
+}
+
 // Metric represents a collection of data points that we might send or receive
 // on one single metric line.
 type Metric struct {
@@ -57,6 +106,13 @@ type Series struct {
 	Units       *UnitPair   `json:"unit,omitempty"`
 }
 
+// AddPoints appends points to the points of the metric.
+func (m *Metric) AddPoints(points ...Point) {
+	for _, p := range points {
+		m.Points = append(m.Points, p.DataPoint())
+	}
+}
+
 // reqPostSeries from /api/v1/series
 type reqPostSeries struct {
 	Series []Metric `json:"series,omitempty"`
@@ -67,6 +123,11 @@ type reqMetrics struct {
 	Series []Series `json:"series,omitempty"`
 }
 
+// reqActiveMetrics is the container for receiving the active metrics.
+type reqActiveMetrics struct {
+	Metrics []string `json:"metrics,omitempty"`
+}
+
 // PostMetrics takes as input a slice of metrics and then posts them up to the
 // server for posting data.
 func (client *Client) PostMetrics(series []Metric) error {
@@ -74,6 +135,115 @@ func (client *Client) PostMetrics(series []Metric) error {
 		reqPostSeries{Series: series}, nil)
 }
 
+// PostMetricsWithMetadata posts metrics like PostMetrics does, and returns
+// the metadata of the response. Its StatusCode is 202 when the metrics were
+// accepted but not processed yet.
+func (client *Client) PostMetricsWithMetadata(series []Metric) (ResponseMetadata, error) {
+	_, meta, err := client.doRawRequest("POST", "/v1/series", reqPostSeries{Series: series})
+	return meta, err
+}
+
+// PostMetricsBatched posts metrics like PostMetrics does, but splits them in
+// batches whose payload is at most maxBytes, sent one after the other. A
+// maxBytes of zero means MaxMetricsPayloadSize. The points of a metric are
+// never split, a metric bigger than maxBytes is sent on its own. A failure to
+// send one batch doesn't stop the others from being sent, the errors are
+// returned together as a *MultiError.
+func (client *Client) PostMetricsBatched(series []Metric, maxBytes int) error {
+	return postMetricsBatched(series, maxBytes, client.PostMetrics)
+}
+
+// postMetricsBatched splits series like PostMetricsBatched does, and sends
+// each batch with post.
+func postMetricsBatched(series []Metric, maxBytes int, post func([]Metric) error) error {
+	if maxBytes <= 0 {
+		maxBytes = MaxMetricsPayloadSize
+	}
+	// The size of the payload without any metric.
+	envelopeSize := len(`{"series":[]}`)
+
+	errs := &MultiError{}
+	send := func(from, to int) {
+		if err := post(series[from:to]); err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("metrics %d to %d: %s", from, to-1, err))
+		}
+	}
+
+	from, size := 0, envelopeSize
+	for i, metric := range series {
+		b, err := json.Marshal(metric)
+		if err != nil {
+			return err
+		}
+		// Metrics are separated by a comma.
+		metricSize := len(b) + 1
+		if i > from && size+metricSize > maxBytes {
+			send(from, i)
+			from, size = i, envelopeSize
+		}
+		size += metricSize
+	}
+	if from < len(series) {
+		send(from, len(series))
+	}
+	return errs.errorOrNil()
+}
+
+// DistributionMetric is a metric whose points are distributions of values,
+// which Datadog aggregates globally, e.g. to compute percentiles.
+type DistributionMetric struct {
+	Metric *string             `json:"metric,omitempty"`
+	Points []DistributionPoint `json:"points,omitempty"`
+	Host   *string             `json:"host,omitempty"`
+	Tags   []string            `json:"tags,omitempty"`
+}
+
+// DistributionPoint is the distribution of the values of a metric at a point
+// in time. It is encoded as a [UNIX timestamp, [values...]] array.
+type DistributionPoint struct {
+	Timestamp time.Time
+	Values    []float64
+}
+
+// MarshalJSON encodes the point as a [UNIX timestamp, [values...]] array.
+func (p DistributionPoint) MarshalJSON() ([]byte, error) {
+	values := p.Values
+	if values == nil {
+		values = []float64{}
+	}
+	return json.Marshal([]interface{}{p.Timestamp.Unix(), values})
+}
+
+// UnmarshalJSON decodes a [UNIX timestamp, [values...]] array.
+func (p *DistributionPoint) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("invalid distribution point %s", data)
+	}
+	var ts float64
+	if err := json.Unmarshal(raw[0], &ts); err != nil {
+		return err
+	}
+	sec, frac := math.Modf(ts)
+	p.Timestamp = time.Unix(int64(sec), int64(frac*1e9))
+	return json.Unmarshal(raw[1], &p.Values)
+}
+
+// reqPostDistributionPoints from /api/v1/distribution_points
+type reqPostDistributionPoints struct {
+	Series []DistributionMetric `json:"series,omitempty"`
+}
+
+// PostDistributionMetrics takes as input a slice of distribution metrics and
+// then posts them up to the server.
+func (client *Client) PostDistributionMetrics(series []DistributionMetric) error {
+	return client.doJsonRequest("POST", "/v1/distribution_points",
+		reqPostDistributionPoints{Series: series}, nil)
+}
+
 // QueryMetrics takes as input from, to (seconds from Unix Epoch) and query string and then requests
 // timeseries data for that time peried
 func (client *Client) QueryMetrics(from, to int64, query string) ([]Series, error) {
@@ -89,3 +259,76 @@ func (client *Client) QueryMetrics(from, to int64, query string) ([]Series, erro
 	}
 	return out.Series, nil
 }
+
+// QueryMetricsBatch runs many queries over the same time window, from and to
+// (seconds from Unix Epoch). The queries are run concurrently, at most
+// BatchConcurrency at a time, and rate limited requests are handled by
+// OnRateLimited like any other request. The series of queries[i] are returned
+// at index i. If any query fails, no series are returned and the errors are
+// returned together as a *MultiError.
+func (client *Client) QueryMetricsBatch(from, to int64, queries []string) ([][]Series, error) {
+	series, err := client.queryMetricsBatch(from, to, queries)
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// QueryMetricsBatchPartial is like QueryMetricsBatch, but tolerates failed
+// queries: the series of the queries which succeeded are returned along with
+// the *MultiError holding the errors of the others, whose series are nil.
+func (client *Client) QueryMetricsBatchPartial(from, to int64, queries []string) ([][]Series, error) {
+	return client.queryMetricsBatch(from, to, queries)
+}
+
+// queryMetricsBatch runs the queries and returns the series of each query,
+// nil for those which failed, along with the errors of the latter.
+func (client *Client) queryMetricsBatch(from, to int64, queries []string) ([][]Series, error) {
+	results := make([][]Series, len(queries))
+	queryErrs := client.runBatch(len(queries), func(i int) error {
+		var err error
+		results[i], err = client.QueryMetrics(from, to, queries[i])
+		return err
+	})
+
+	errs := &MultiError{}
+	for i, err := range queryErrs {
+		if err != nil {
+			errs.Errors = append(errs.Errors, fmt.Errorf("query %s: %s", queries[i], err))
+		}
+	}
+	return results, errs.errorOrNil()
+}
+
+// GetActiveMetrics returns the names of the metrics that have been reporting
+// since from (seconds from Unix Epoch). If host is given, only the metrics
+// reported by that host are returned.
+func (client *Client) GetActiveMetrics(from int64, host string) ([]string, error) {
+	v := url.Values{}
+	v.Add("from", strconv.FormatInt(from, 10))
+	if host != "" {
+		v.Add("host", host)
+	}
+
+	return client.getActiveMetrics(v)
+}
+
+// ListActiveMetricsByTag returns the names of the metrics that have been
+// reporting since from (seconds from Unix Epoch) with a tag, e.g.
+// "env:prod".
+func (client *Client) ListActiveMetricsByTag(from int64, tag string) ([]string, error) {
+	v := url.Values{}
+	v.Add("from", strconv.FormatInt(from, 10))
+	v.Add("tag_filter", tag)
+	return client.getActiveMetrics(v)
+}
+
+// getActiveMetrics returns the names of the active metrics matching the query
+// parameters in v.
+func (client *Client) getActiveMetrics(v url.Values) ([]string, error) {
+	var out reqActiveMetrics
+	if err := client.doJsonRequest("GET", "/v1/metrics?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Metrics, nil
+}
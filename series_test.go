@@ -0,0 +1,259 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointJSON(t *testing.T) {
+	const fixture = `[[1577836800, 1.5], [1577836860, 42]]`
+
+	var points []Point
+	if err := json.Unmarshal([]byte(fixture), &points); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []Point{
+		{Timestamp: time.Unix(1577836800, 0), Value: 1.5},
+		{Timestamp: time.Unix(1577836860, 0), Value: 42},
+	}, points)
+
+	b, err := json.Marshal(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, fixture, string(b))
+
+	assert.NotNil(t, json.Unmarshal([]byte(`[1577836800, null]`), &Point{}))
+
+	t.Run("Fractional timestamps", func(t *testing.T) {
+		in := Point{Timestamp: time.Unix(1577836800, 250000000), Value: 1}
+		b, err := json.Marshal(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `[1577836800.25, 1]`, string(b))
+
+		var out Point
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, in.Timestamp.Equal(out.Timestamp), "got %s", out.Timestamp)
+		assert.Equal(t, 1577836800.25, *in.DataPoint()[0])
+	})
+}
+
+func TestPostMetricsPoints(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"series": [{"metric": "app.requests", "points": [[1577836800, 3]]}]}`, string(body))
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	metric := Metric{Metric: String("app.requests")}
+	metric.AddPoints(Point{Timestamp: time.Unix(1577836800, 0), Value: 3})
+	assert.Nil(t, c.PostMetrics([]Metric{metric}))
+}
+
+func TestPostMetricsWithMetadata(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/series", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	metric := Metric{Metric: String("app.requests")}
+	metric.AddPoints(Point{Timestamp: time.Unix(1577836800, 0), Value: 3})
+	meta, err := c.PostMetricsWithMetadata([]Metric{metric})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, meta.StatusCode)
+}
+
+func TestPostDistributionMetrics(t *testing.T) {
+	const fixture = `{"series": [{
+		"metric": "app.latency",
+		"points": [[1577836800, [0.1, 0.25, 1.5]], [1577836810, []]],
+		"tags": ["env:prod"]
+	}]}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/distribution_points", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, fixture, string(body))
+
+		var in reqPostDistributionPoints
+		assert.Nil(t, json.Unmarshal(body, &in))
+		assert.Equal(t, []float64{0.1, 0.25, 1.5}, in.Series[0].Points[0].Values)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	err := c.PostDistributionMetrics([]DistributionMetric{{
+		Metric: String("app.latency"),
+		Points: []DistributionPoint{
+			{Timestamp: time.Unix(1577836800, 0), Values: []float64{0.1, 0.25, 1.5}},
+			{Timestamp: time.Unix(1577836810, 0)},
+		},
+		Tags: []string{"env:prod"},
+	}})
+	assert.Nil(t, err)
+}
+
+func TestPostMetricsBatched(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		payloads []int
+		points   int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var in reqPostSeries
+		assert.Nil(t, json.Unmarshal(body, &in))
+
+		mu.Lock()
+		payloads = append(payloads, len(body))
+		for _, m := range in.Series {
+			points += len(m.Points)
+		}
+		mu.Unlock()
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	var series []Metric
+	for i := 0; i < 100; i++ {
+		metric := Metric{Metric: String(fmt.Sprintf("app.metric.%d", i))}
+		for j := 0; j < 50; j++ {
+			metric.AddPoints(Point{Timestamp: time.Unix(1577836800+int64(j), 0), Value: float64(j)})
+		}
+		series = append(series, metric)
+	}
+
+	const maxBytes = 20000
+	assert.Nil(t, c.PostMetricsBatched(series, maxBytes))
+	assert.True(t, len(payloads) > 1, "expect several batches. Got %d", len(payloads))
+	for _, size := range payloads {
+		assert.True(t, size <= maxBytes, "expect batches of at most %d bytes. Got %d", maxBytes, size)
+	}
+	assert.Equal(t, 100*50, points)
+}
+
+func TestQueryMetricsBatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("query")
+		assert.Equal(t, "100", r.URL.Query().Get("from"))
+		assert.Equal(t, "200", r.URL.Query().Get("to"))
+		if query == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": ["Error parsing query"]}`))
+			return
+		}
+		fmt.Fprintf(w, `{"series": [{"expression": %q}]}`, query)
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.BatchConcurrency = 2
+
+	queries := []string{"avg:a{*}", "avg:b{*}", "avg:c{*}", "avg:d{*}"}
+	series, err := c.QueryMetricsBatch(100, 200, queries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, series, len(queries)) {
+		for i, query := range queries {
+			if assert.Len(t, series[i], 1) {
+				assert.Equal(t, query, series[i][0].GetExpression())
+			}
+		}
+	}
+
+	queries = []string{"avg:a{*}", "bad", "avg:c{*}"}
+	series, err = c.QueryMetricsBatch(100, 200, queries)
+	assert.Nil(t, series)
+	if assert.IsType(t, &MultiError{}, err) {
+		assert.Len(t, err.(*MultiError).Errors, 1)
+	}
+
+	series, err = c.QueryMetricsBatchPartial(100, 200, queries)
+	if assert.IsType(t, &MultiError{}, err) {
+		assert.Contains(t, err.Error(), "query bad")
+	}
+	if assert.Len(t, series, 3) {
+		assert.Equal(t, "avg:a{*}", series[0][0].GetExpression())
+		assert.Nil(t, series[1])
+		assert.Equal(t, "avg:c{*}", series[2][0].GetExpression())
+	}
+}
+
+func TestGetActiveMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v1/metrics", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("from"))
+		if r.URL.Query().Get("host") == "web-1" {
+			w.Write([]byte(`{"metrics": ["system.load.1"], "from": "100"}`))
+			return
+		}
+		_, ok := r.URL.Query()["host"]
+		assert.False(t, ok, "expect no host parameter")
+		w.Write([]byte(`{"metrics": ["system.load.1", "system.cpu.user"], "from": "100"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	metrics, err := c.GetActiveMetrics(100, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"system.load.1", "system.cpu.user"}, metrics)
+
+	metrics, err = c.GetActiveMetrics(100, "web-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"system.load.1"}, metrics)
+}
+
+func TestListActiveMetricsByTag(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/metrics", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("from"))
+		assert.Equal(t, "env:prod", r.URL.Query().Get("tag_filter"))
+		w.Write([]byte(`{"metrics": ["system.load.1", "system.cpu.user"], "from": "100"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	metrics, err := c.ListActiveMetricsByTag(100, "env:prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"system.load.1", "system.cpu.user"}, metrics)
+}
@@ -0,0 +1,33 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ServiceDependency lists the services an APM service calls.
+type ServiceDependency struct {
+	Calls []string `json:"calls"`
+}
+
+// GetServiceDependencies returns the service map of an APM environment, keyed
+// by service name, as seen since start, a POSIX timestamp.
+func (client *Client) GetServiceDependencies(env string, start int64) (map[string]ServiceDependency, error) {
+	v := url.Values{}
+	v.Add("env", env)
+	v.Add("start", strconv.FormatInt(start, 10))
+
+	var out map[string]ServiceDependency
+	if err := client.doJsonRequest("GET", "/v1/service_dependencies?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,34 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceDependencies(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/service_dependencies", r.URL.Path)
+		assert.Equal(t, "prod", r.URL.Query().Get("env"))
+		assert.Equal(t, "1500000000", r.URL.Query().Get("start"))
+		w.Write([]byte(`{
+			"web": {"calls": ["api", "postgres"]},
+			"api": {"calls": ["redis"]},
+			"redis": {"calls": []}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	deps, err := c.GetServiceDependencies("prod", 1500000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, deps, 3)
+	assert.Equal(t, []string{"api", "postgres"}, deps["web"].Calls)
+	assert.Empty(t, deps["redis"].Calls)
+}
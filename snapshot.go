@@ -9,9 +9,13 @@
 package datadog
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/cenkalti/backoff"
 )
 
 func (client *Client) doSnapshotRequest(values url.Values) (string, error) {
@@ -43,3 +47,48 @@ func (client *Client) SnapshotGeneric(options map[string]string, start, end time
 
 	return client.doSnapshotRequest(v)
 }
+
+// WaitForSnapshot polls the URL of a snapshot until its image is rendered,
+// i.e. until its URL answers with a 2xx. The snapshot isn't ready while its
+// URL answers with a 404 or a 5xx, any other failure is returned right away.
+// Polling follows the retry settings of the client, including RetryTimeout,
+// and stops with the error of ctx when ctx is done.
+func (client *Client) WaitForSnapshot(ctx context.Context, snapshotURL string) error {
+	req, err := http.NewRequest("GET", snapshotURL, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	var terminal error
+	operation := func() error {
+		resp, err := client.do(req)
+		if err != nil {
+			terminal = err
+			return nil
+		}
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return nil
+		case resp.StatusCode == http.StatusNotFound || resp.StatusCode >= 500:
+			return fmt.Errorf("snapshot not ready: received HTTP status code %d", resp.StatusCode)
+		}
+		terminal = fmt.Errorf("snapshot failed: received HTTP status code %d", resp.StatusCode)
+		return nil
+	}
+
+	bo := &contextBackOff{delegate: client.retryConfig().backOff(), ctx: ctx}
+	err = backoff.Retry(operation, bo)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if bo.ctxErr != nil {
+		return bo.ctxErr
+	}
+	if err != nil {
+		return err
+	}
+	return terminal
+}
@@ -0,0 +1,74 @@
+package datadog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForSnapshot(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.RetryInitialInterval = time.Millisecond
+
+	t.Run("Waits until the snapshot is rendered", func(t *testing.T) {
+		var requests int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch atomic.AddInt32(&requests, 1) {
+			case 1:
+				w.WriteHeader(http.StatusNotFound)
+			case 2:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				w.WriteHeader(http.StatusAccepted)
+			}
+		}))
+		defer ts.Close()
+
+		assert.Nil(t, c.WaitForSnapshot(context.Background(), ts.URL+"/snapshot/view/abc.png"))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+	t.Run("Other failures are terminal", func(t *testing.T) {
+		var requests int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer ts.Close()
+
+		assert.NotNil(t, c.WaitForSnapshot(context.Background(), ts.URL))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+	t.Run("Stops when the context is done", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		assert.Equal(t, context.DeadlineExceeded, c.WaitForSnapshot(ctx, ts.URL))
+		assert.True(t, time.Since(start) < time.Second, "expect polling to stop at the deadline")
+	})
+	t.Run("Stops after the retry timeout", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		c := NewClient("sample_api_key", "sample_app_key")
+		c.RetryInitialInterval = time.Millisecond
+		c.RetryTimeout = 50 * time.Millisecond
+		start := time.Now()
+		err := c.WaitForSnapshot(context.Background(), ts.URL)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "snapshot not ready")
+		}
+		assert.True(t, time.Since(start) < time.Second, "expect polling to stop after RetryTimeout")
+	})
+}
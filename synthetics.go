@@ -0,0 +1,128 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	SyntheticsResultPending = "pending"
+	SyntheticsResultPassed  = "passed"
+	SyntheticsResultFailed  = "failed"
+)
+
+// SyntheticsTriggerResult is the result of triggering synthetics tests. The
+// results of the tests are fetched with GetSyntheticsTestResults, using the
+// ids of Results.
+type SyntheticsTriggerResult struct {
+	BatchId           *string                     `json:"batch_id,omitempty"`
+	Locations         []SyntheticsTriggerLocation `json:"locations,omitempty"`
+	Results           []SyntheticsTriggeredTest   `json:"results,omitempty"`
+	TriggeredCheckIds []string                    `json:"triggered_check_ids,omitempty"`
+}
+
+// SyntheticsTriggerLocation is a location the triggered tests run from.
+type SyntheticsTriggerLocation struct {
+	Id          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Region      *string `json:"region,omitempty"`
+}
+
+// SyntheticsTriggeredTest is a run of a test started by a trigger, for a
+// single location and device.
+type SyntheticsTriggeredTest struct {
+	PublicId *string `json:"public_id,omitempty"`
+	ResultId *string `json:"result_id,omitempty"`
+	Location *int    `json:"location,omitempty"`
+	Device   *string `json:"device,omitempty"`
+}
+
+// SyntheticsTestResult is the outcome of a run of a test.
+type SyntheticsTestResult struct {
+	ResultId  *string                      `json:"result_id,omitempty"`
+	CheckTime *float64                     `json:"check_time,omitempty"`
+	ProbeDC   *string                      `json:"probe_dc,omitempty"`
+	Status    *int                         `json:"status,omitempty"`
+	Result    *SyntheticsTestResultDetails `json:"result,omitempty"`
+}
+
+// RunStatus returns SyntheticsResultPending while the run is in progress,
+// then SyntheticsResultPassed or SyntheticsResultFailed.
+func (r *SyntheticsTestResult) RunStatus() string {
+	if r.Result == nil {
+		return SyntheticsResultPending
+	}
+	if r.Result.GetPassed() {
+		return SyntheticsResultPassed
+	}
+	return SyntheticsResultFailed
+}
+
+// SyntheticsTestResultDetails tells whether a run passed.
+type SyntheticsTestResultDetails struct {
+	Passed       *bool              `json:"passed,omitempty"`
+	ErrorCode    *string            `json:"errorCode,omitempty"`
+	ErrorMessage *string            `json:"errorMessage,omitempty"`
+	Timings      map[string]float64 `json:"timings,omitempty"`
+}
+
+// reqTriggerSyntheticsTests is the body sent to trigger tests.
+type reqTriggerSyntheticsTests struct {
+	Tests []syntheticsTestToTrigger `json:"tests"`
+}
+
+type syntheticsTestToTrigger struct {
+	PublicId string `json:"public_id"`
+}
+
+// TriggerSyntheticsTests starts a run of the tests with the given public
+// ids, e.g. from a CI pipeline. The runs are asynchronous: poll their
+// results with GetSyntheticsTestResults.
+func (client *Client) TriggerSyntheticsTests(ids []string) (*SyntheticsTriggerResult, error) {
+	in := reqTriggerSyntheticsTests{Tests: make([]syntheticsTestToTrigger, 0, len(ids))}
+	for _, id := range ids {
+		in.Tests = append(in.Tests, syntheticsTestToTrigger{PublicId: id})
+	}
+
+	var out SyntheticsTriggerResult
+	if err := client.doJsonRequest("POST", "/v1/synthetics/tests/trigger/ci", in, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSyntheticsTestResults returns the results of the given runs of a test,
+// in the order of resultIDs. Runs which are still in progress are answered
+// with a 404 by the API, so their result only holds its ResultId, and its
+// RunStatus is SyntheticsResultPending. The results which couldn't be fetched
+// are pending too, and their errors are returned together as a *MultiError.
+func (client *Client) GetSyntheticsTestResults(testID string, resultIDs []string) ([]SyntheticsTestResult, error) {
+	results := make([]SyntheticsTestResult, len(resultIDs))
+	errs := &MultiError{}
+	for i, resultID := range resultIDs {
+		results[i].ResultId = String(resultID)
+		body, meta, err := client.doRawRequest("GET", fmt.Sprintf("/v1/synthetics/tests/%s/results/%s", url.PathEscape(testID), url.PathEscape(resultID)), nil)
+		if meta.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err == nil {
+			err = json.Unmarshal(body, &results[i])
+		}
+		if err != nil {
+			results[i] = SyntheticsTestResult{ResultId: String(resultID)}
+			errs.Errors = append(errs.Errors, fmt.Errorf("result %s: %s", resultID, err))
+		}
+	}
+	return results, errs.errorOrNil()
+}
@@ -0,0 +1,107 @@
+package datadog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriggerSyntheticsTests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/synthetics/tests/trigger/ci":
+			assert.Equal(t, "POST", r.Method)
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"tests": [{"public_id": "abc-def-ghi"}]}`, string(body))
+			w.Write([]byte(`{
+				"batch_id": "batch-1",
+				"locations": [{"id": 30005, "name": "aws:us-east-1", "display_name": "N. Virginia (AWS)"}],
+				"results": [{"public_id": "abc-def-ghi", "result_id": "123", "location": 30005, "device": "laptop_large"}],
+				"triggered_check_ids": ["abc-def-ghi"]
+			}`))
+		case "/api/v1/synthetics/tests/abc-def-ghi/results/123":
+			w.Write([]byte(`{"result_id": "123", "check_time": 1577836800000, "probe_dc": "aws:us-east-1", "status": 0, "result": {"passed": true, "timings": {"total": 120.5}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": ["Not found"]}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	trigger, err := c.TriggerSyntheticsTests([]string{"abc-def-ghi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "batch-1", trigger.GetBatchId())
+	assert.Equal(t, "aws:us-east-1", trigger.Locations[0].GetName())
+	if assert.Len(t, trigger.Results, 1) {
+		assert.Equal(t, "123", trigger.Results[0].GetResultId())
+		assert.Equal(t, 30005, trigger.Results[0].GetLocation())
+	}
+
+	results, err := c.GetSyntheticsTestResults("abc-def-ghi", []string{trigger.Results[0].GetResultId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, results, 1) {
+		assert.True(t, results[0].Result.GetPassed())
+		assert.Equal(t, 120.5, results[0].Result.Timings["total"])
+		assert.Equal(t, SyntheticsResultPassed, results[0].RunStatus())
+	}
+}
+
+func TestGetSyntheticsTestResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v1/synthetics/tests/abc-def-ghi/results/1":
+			w.Write([]byte(`{"result_id": "1", "result": {"passed": true}}`))
+		case "/api/v1/synthetics/tests/abc-def-ghi/results/2":
+			w.Write([]byte(`{"result_id": "2", "result": {"passed": false, "errorCode": "TIMEOUT"}}`))
+		case "/api/v1/synthetics/tests/abc-def-ghi/results/3":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": ["Not found"]}`))
+		case "/api/v1/synthetics/tests/abc-def-ghi/results/..%2F5":
+			w.Write([]byte(`{"result_id": "../5", "result": {"passed": true}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors": ["Forbidden"]}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	results, err := c.GetSyntheticsTestResults("abc-def-ghi", []string{"1", "2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, SyntheticsResultPassed, results[0].RunStatus())
+		assert.Equal(t, SyntheticsResultFailed, results[1].RunStatus())
+		assert.Equal(t, "TIMEOUT", results[1].Result.GetErrorCode())
+		assert.Equal(t, SyntheticsResultPending, results[2].RunStatus())
+		assert.Equal(t, "3", results[2].GetResultId())
+	}
+
+	results, err = c.GetSyntheticsTestResults("abc-def-ghi", []string{"../5"})
+	assert.Nil(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, SyntheticsResultPassed, results[0].RunStatus())
+	}
+
+	results, err = c.GetSyntheticsTestResults("abc-def-ghi", []string{"1", "4"})
+	if assert.IsType(t, &MultiError{}, err) {
+		assert.Contains(t, err.Error(), "result 4")
+	}
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, SyntheticsResultPassed, results[0].RunStatus())
+		assert.Equal(t, SyntheticsResultPending, results[1].RunStatus())
+	}
+}
@@ -8,6 +8,13 @@
 
 package datadog
 
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
 // TagMap is used to receive the format given to us by the API.
 type TagMap map[string][]string
 
@@ -91,3 +98,64 @@ func (client *Client) RemoveHostTags(host, source string) error {
 	}
 	return client.doJsonRequest("DELETE", uri, nil, nil)
 }
+
+// BatchAddHostTags adds the given tags to many hosts, with the default source.
+// The hosts are tagged concurrently, at most BatchConcurrency at a time, and
+// rate limited requests are handled by OnRateLimited like any other request.
+// A failure to tag one host doesn't stop the others from being tagged, the
+// errors are returned together as a *MultiError.
+func (client *Client) BatchAddHostTags(hosts []string, tags []string) error {
+	_, err := client.batchHosts(hosts, func(host string) error {
+		return client.AddTagsToHost(host, "", tags)
+	})
+	return err
+}
+
+// maxTagLength is the length tags are truncated to by Datadog.
+const maxTagLength = 200
+
+// invalidTagChars matches the runs of characters Datadog replaces with an
+// underscore in tags.
+var invalidTagChars = regexp.MustCompile(`[^\pL\pN_:./-]+|__+`)
+
+// NormalizeTags returns the tags the way Datadog stores them, so they can be
+// compared to the tags it returns. Each tag is:
+//
+//   - trimmed of surrounding spaces, and of the leading characters other than
+//     letters, as tags must start with a letter,
+//   - lowercased up to its first colon, i.e. its key, while its value keeps
+//     its case,
+//   - stripped of the characters other than letters, numbers, underscores,
+//     minuses, colons, periods and slashes, each run of which becomes a single
+//     underscore, as do runs of underscores,
+//   - trimmed of trailing underscores and truncated to 200 characters.
+//
+// Empty tags are dropped, and the result is sorted and deduplicated.
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimLeftFunc(tag, func(r rune) bool { return !unicode.IsLetter(r) })
+		tag = strings.TrimRightFunc(tag, unicode.IsSpace)
+		if i := strings.Index(tag, ":"); i >= 0 {
+			tag = strings.ToLower(tag[:i]) + tag[i:]
+		} else {
+			tag = strings.ToLower(tag)
+		}
+		tag = invalidTagChars.ReplaceAllString(tag, "_")
+		if runes := []rune(tag); len(runes) > maxTagLength {
+			tag = string(runes[:maxTagLength])
+		}
+		tag = strings.TrimRight(tag, "_")
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
@@ -0,0 +1,108 @@
+package datadog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchAddHostTags(t *testing.T) {
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+		tagged            []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"tags": ["role:web"]}`, string(body))
+
+		mu.Lock()
+		inFlight--
+		host := strings.TrimPrefix(r.URL.Path, "/api/v1/tags/hosts/")
+		tagged = append(tagged, host)
+		mu.Unlock()
+
+		if host == "bad-host" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": ["Host not found"]}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.BatchConcurrency = 2
+
+	hosts := []string{"host-1", "host-2", "bad-host", "host-3", "host-4"}
+	err := c.BatchAddHostTags(hosts, []string{"role:web"})
+	if assert.IsType(t, &MultiError{}, err) {
+		errs := err.(*MultiError).Errors
+		if assert.Len(t, errs, 1) {
+			assert.Contains(t, errs[0].Error(), "host bad-host: ")
+		}
+	}
+	assert.ElementsMatch(t, hosts, tagged)
+	assert.True(t, maxSeen <= 2, "expect at most 2 requests in flight. Got %d", maxSeen)
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Nil(t, NormalizeTags(nil))
+	assert.Equal(t, []string{
+		"env:Prod-EU",
+		"env:prod",
+		"region:us",
+		"service:Web",
+		"team:core_platform",
+		"version:1.2/3-a",
+	}, NormalizeTags([]string{
+		" Service:Web ",
+		"env:prod",
+		"ENV:Prod-EU",
+		"team:core  platform!",
+		"version:1.2/3-a",
+		"_1Region:us",
+		"!!",
+		"42",
+		"",
+	}))
+	assert.Len(t, NormalizeTags([]string{strings.Repeat("a", 250)})[0], 200)
+}
+
+func TestNormalizeMonitorTags(t *testing.T) {
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.NormalizeMonitorTags = true
+
+	monitor := &Monitor{
+		Id:    Int(1),
+		Type:  String("metric alert"),
+		Query: String("avg(last_5m):avg:system.load.1{*} > 2"),
+		Tags:  []string{"Team:Core", "env:prod"},
+	}
+	assert.Nil(t, c.UpdateMonitor(monitor))
+	assert.Contains(t, string(body), `"tags":["env:prod","team:Core"]`)
+	assert.Equal(t, []string{"Team:Core", "env:prod"}, monitor.Tags, "expect the monitor to be left untouched")
+}
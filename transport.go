@@ -0,0 +1,38 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+	"time"
+)
+
+// InstrumentedTransport returns a RoundTripper which sends requests with base,
+// or http.DefaultTransport if base is nil, and calls onComplete after each
+// request with its response or error and how long it took. Set it as the
+// Transport of Client.HttpClient to trace or measure the requests of the
+// client. Every attempt of a retried request is a separate round trip.
+func InstrumentedTransport(base http.RoundTripper, onComplete func(*http.Request, *http.Response, time.Duration, error)) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &instrumentedTransport{base: base, onComplete: onComplete}
+}
+
+type instrumentedTransport struct {
+	base       http.RoundTripper
+	onComplete func(*http.Request, *http.Response, time.Duration, error)
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	t.onComplete(req, resp, time.Since(start), err)
+	return resp, err
+}
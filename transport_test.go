@@ -0,0 +1,37 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentedTransport(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	var statuses []int
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+	c.RetryInitialInterval = time.Millisecond
+	c.HttpClient.Transport = InstrumentedTransport(nil, func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		assert.Nil(t, err)
+		assert.Equal(t, "/api/v1/something", req.URL.Path)
+		assert.True(t, d > 0)
+		statuses = append(statuses, resp.StatusCode)
+	})
+
+	assert.Nil(t, c.doJsonRequest("GET", "/v1/something", nil, nil))
+	assert.Equal(t, []int{500, 200}, statuses)
+}
@@ -0,0 +1,109 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// usageAttributionPageSize is the number of records requested per page by
+// GetUsageAttribution, the largest the API allows.
+const usageAttributionPageSize = 5000
+
+// UsageAttribution is the usage of an organization broken down by tags.
+type UsageAttribution struct {
+	Usage    []UsageAttributionBody    `json:"usage,omitempty"`
+	Metadata *UsageAttributionMetadata `json:"metadata,omitempty"`
+}
+
+// UsageAttributionBody is the usage of a month for a combination of tags.
+// Values holds the usage of each requested field, e.g. "infra_host_usage".
+type UsageAttributionBody struct {
+	Month           *string             `json:"month,omitempty"`
+	OrgName         *string             `json:"org_name,omitempty"`
+	PublicId        *string             `json:"public_id,omitempty"`
+	TagConfigSource *string             `json:"tag_config_source,omitempty"`
+	Tags            map[string][]string `json:"tags,omitempty"`
+	UpdatedAt       *string             `json:"updated_at,omitempty"`
+	Values          map[string]float64  `json:"values,omitempty"`
+}
+
+// UsageAttributionMetadata holds the totals of the usage fields and the
+// paging of the records.
+type UsageAttributionMetadata struct {
+	Aggregates []UsageAttributionAggregate `json:"aggregates,omitempty"`
+	Pagination *UsageAttributionPagination `json:"pagination,omitempty"`
+}
+
+// UsageAttributionAggregate is the total of a usage field.
+type UsageAttributionAggregate struct {
+	AggType *string  `json:"agg_type,omitempty"`
+	Field   *string  `json:"field,omitempty"`
+	Value   *float64 `json:"value,omitempty"`
+}
+
+// UsageAttributionPagination tells which records a page holds.
+type UsageAttributionPagination struct {
+	Limit                *int `json:"limit,omitempty"`
+	Offset               *int `json:"offset,omitempty"`
+	TotalNumberOfRecords *int `json:"total_number_of_records,omitempty"`
+}
+
+// GetUsageAttribution returns the usage broken down by tags from startMonth,
+// e.g. "2019-01", on, for the given usage fields, e.g. "infra_host_usage" or
+// "custom_timeseries_usage". The records are requested page by page.
+func (client *Client) GetUsageAttribution(startMonth string, fields []string) (*UsageAttribution, error) {
+	var out *UsageAttribution
+	var previous []UsageAttributionBody
+	for offset := 0; ; {
+		page, err := client.GetUsageAttributionPage(startMonth, fields, offset, usageAttributionPageSize)
+		if err != nil {
+			return nil, err
+		}
+		// Records have no identifier: the same page again means the offset
+		// was ignored and every record was returned at once.
+		if previous != nil && reflect.DeepEqual(page.Usage, previous) {
+			return out, nil
+		}
+		if out == nil {
+			out = page
+		} else {
+			out.Usage = append(out.Usage, page.Usage...)
+		}
+		offset += len(page.Usage)
+		previous = page.Usage
+
+		total := -1
+		if page.Metadata != nil && page.Metadata.Pagination != nil {
+			total = page.Metadata.Pagination.GetTotalNumberOfRecords()
+		}
+		if len(page.Usage) < usageAttributionPageSize || (total >= 0 && offset >= total) {
+			return out, nil
+		}
+	}
+}
+
+// GetUsageAttributionPage returns limit records at most of the usage broken
+// down by tags, starting at the record at index offset.
+func (client *Client) GetUsageAttributionPage(startMonth string, fields []string, offset, limit int) (*UsageAttribution, error) {
+	v := url.Values{}
+	v.Add("start_month", startMonth)
+	v.Add("fields", strings.Join(fields, ","))
+	v.Add("offset", strconv.Itoa(offset))
+	v.Add("limit", strconv.Itoa(limit))
+
+	var out UsageAttribution
+	if err := client.doJsonRequest("GET", "/v1/usage/attribution?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
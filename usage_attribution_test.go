@@ -0,0 +1,81 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUsageAttribution(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/usage/attribution", r.URL.Path)
+		assert.Equal(t, "2019-01", r.URL.Query().Get("start_month"))
+		assert.Equal(t, "infra_host_usage,custom_timeseries_usage", r.URL.Query().Get("fields"))
+		assert.Equal(t, "5000", r.URL.Query().Get("limit"))
+
+		record := map[string]interface{}{
+			"month":  "2019-01",
+			"tags":   map[string][]string{"team": {"ops"}},
+			"values": map[string]float64{"infra_host_usage": 2, "custom_timeseries_usage": 10},
+		}
+		var usage []interface{}
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			for i := 0; i < 5000; i++ {
+				usage = append(usage, record)
+			}
+		case "5000":
+			usage = append(usage, record)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"usage": usage,
+			"metadata": map[string]interface{}{
+				"aggregates": []interface{}{map[string]interface{}{"agg_type": "sum", "field": "infra_host_usage", "value": 10002}},
+				"pagination": map[string]interface{}{"limit": 5000, "offset": 0, "total_number_of_records": 5001},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	attribution, err := c.GetUsageAttribution("2019-01", []string{"infra_host_usage", "custom_timeseries_usage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, attribution.Usage, 5001) {
+		assert.Equal(t, []string{"ops"}, attribution.Usage[5000].Tags["team"])
+		assert.Equal(t, float64(10), attribution.Usage[5000].Values["custom_timeseries_usage"])
+	}
+	if assert.Len(t, attribution.Metadata.Aggregates, 1) {
+		assert.Equal(t, float64(10002), attribution.Metadata.Aggregates[0].GetValue())
+	}
+}
+
+func TestGetUsageAttributionIgnoredPaging(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var usage []interface{}
+		for i := 0; i < 5000; i++ {
+			usage = append(usage, map[string]interface{}{"month": "2019-01", "public_id": fmt.Sprint(i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"usage": usage})
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	attribution, err := c.GetUsageAttribution("2019-01", []string{"infra_host_usage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, attribution.Usage, 5000)
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,119 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// UserV2 is a user as returned by the v2 users API.
+type UserV2 struct {
+	Id         *string `json:"-"`
+	Name       *string `json:"name,omitempty"`
+	Handle     *string `json:"handle,omitempty"`
+	Email      *string `json:"email,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	Disabled   *bool   `json:"disabled,omitempty"`
+	Verified   *bool   `json:"verified,omitempty"`
+	CreatedAt  *string `json:"created_at,omitempty"`
+	ModifiedAt *string `json:"modified_at,omitempty"`
+
+	// Roles are the roles of the user. Only their identifiers are set for
+	// roles the response doesn't include.
+	Roles []Role `json:"-"`
+}
+
+// UsersPage is a page of users returned by ListUsersV2.
+type UsersPage struct {
+	Users []UserV2
+	// TotalCount is the number of users of the organization.
+	TotalCount int
+	// TotalFilteredCount is the number of users matching the filter.
+	TotalFilteredCount int
+}
+
+type userRelationships struct {
+	Roles relationshipList `json:"roles"`
+}
+
+// userV2Data is the resource envelope used by the v2 users API.
+type userV2Data struct {
+	Id            *string            `json:"id,omitempty"`
+	Type          string             `json:"type"`
+	Attributes    *UserV2            `json:"attributes,omitempty"`
+	Relationships *userRelationships `json:"relationships,omitempty"`
+}
+
+// reqUsersV2 is the container for receiving a page of users.
+type reqUsersV2 struct {
+	Data     []userV2Data `json:"data"`
+	Included []roleData   `json:"included"`
+	Meta     struct {
+		Page struct {
+			TotalCount         int `json:"total_count"`
+			TotalFilteredCount int `json:"total_filtered_count"`
+		} `json:"page"`
+	} `json:"meta"`
+}
+
+// user returns the user of the envelope, with its roles looked up in roles.
+func (d *userV2Data) user(roles map[string]*Role) *UserV2 {
+	if d.Attributes == nil {
+		d.Attributes = &UserV2{}
+	}
+	d.Attributes.Id = d.Id
+	if d.Relationships != nil {
+		d.Attributes.Roles = make([]Role, 0, len(d.Relationships.Roles.Data))
+		for _, r := range d.Relationships.Roles.Data {
+			if role, ok := roles[r.Id]; ok {
+				d.Attributes.Roles = append(d.Attributes.Roles, *role)
+			} else {
+				d.Attributes.Roles = append(d.Attributes.Roles, Role{Id: String(r.Id)})
+			}
+		}
+	}
+	return d.Attributes
+}
+
+// ListUsersV2 returns a page of the users matching filter, which is matched
+// against their name, email and status. An empty filter matches every user.
+// Pages are numbered from 0, and a size of 0 keeps the default page size.
+func (client *Client) ListUsersV2(filter string, page, size int) (*UsersPage, error) {
+	v := url.Values{}
+	if filter != "" {
+		v.Add("filter", filter)
+	}
+	v.Add("page[number]", strconv.Itoa(page))
+	if size > 0 {
+		v.Add("page[size]", strconv.Itoa(size))
+	}
+
+	var out reqUsersV2
+	if err := client.doJsonRequest("GET", "/v2/users?"+v.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+
+	roles := map[string]*Role{}
+	for i := range out.Included {
+		if out.Included[i].Type == "roles" && out.Included[i].Id != nil {
+			roles[*out.Included[i].Id] = out.Included[i].role()
+		}
+	}
+	users := &UsersPage{
+		Users:              make([]UserV2, 0, len(out.Data)),
+		TotalCount:         out.Meta.Page.TotalCount,
+		TotalFilteredCount: out.Meta.Page.TotalFilteredCount,
+	}
+	for i := range out.Data {
+		users.Users = append(users.Users, *out.Data[i].user(roles))
+	}
+	return users, nil
+}
@@ -0,0 +1,49 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListUsersV2(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/users", r.URL.Path)
+		assert.Equal(t, "active", r.URL.Query().Get("filter"))
+		assert.Equal(t, "2", r.URL.Query().Get("page[number]"))
+		assert.Equal(t, "50", r.URL.Query().Get("page[size]"))
+		w.Write([]byte(`{
+			"data": [{
+				"id": "user-1",
+				"type": "users",
+				"attributes": {"name": "Jane", "email": "jane@example.com", "status": "Active"},
+				"relationships": {"roles": {"data": [{"type": "roles", "id": "role-1"}, {"type": "roles", "id": "role-2"}]}}
+			}],
+			"included": [{"id": "role-1", "type": "roles", "attributes": {"name": "Datadog Admin Role"}}],
+			"meta": {"page": {"total_count": 120, "total_filtered_count": 101}}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	page, err := c.ListUsersV2("active", 2, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 120, page.TotalCount)
+	assert.Equal(t, 101, page.TotalFilteredCount)
+	if assert.Len(t, page.Users, 1) {
+		user := page.Users[0]
+		assert.Equal(t, "user-1", user.GetId())
+		assert.Equal(t, "jane@example.com", user.GetEmail())
+		if assert.Len(t, user.Roles, 2) {
+			assert.Equal(t, "Datadog Admin Role", user.Roles[0].GetName())
+			assert.Equal(t, "role-2", user.Roles[1].GetId())
+			assert.False(t, user.Roles[1].HasName())
+		}
+	}
+}
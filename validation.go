@@ -0,0 +1,150 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// monitorTypes are the known types of monitors.
+var monitorTypes = map[string]bool{
+	"composite":                 true,
+	"event alert":               true,
+	"log alert":                 true,
+	"metric alert":              true,
+	"process alert":             true,
+	"query alert":               true,
+	"rum alert":                 true,
+	"service check":             true,
+	"slo alert":                 true,
+	"synthetics alert":          true,
+	"trace-analytics alert":     true,
+	"event-v2 alert":            true,
+	"audit alert":               true,
+	"ci-pipelines alert":        true,
+	"ci-tests alert":            true,
+	"error-tracking alert":      true,
+	"database-monitoring alert": true,
+}
+
+// renotifyStatuses are the states of monitors notifications can be sent
+// again in.
+var renotifyStatuses = map[string]bool{
+	"alert":   true,
+	"warn":    true,
+	"no data": true,
+}
+
+// Validate checks the monitor for obvious mistakes before it is sent to the
+// API, which would reject it with a less helpful error. Fields it doesn't
+// know about are ignored.
+func (m *Monitor) Validate() error {
+	if !m.HasType() {
+		return fmt.Errorf("invalid monitor: type is required")
+	}
+	if !m.HasQuery() {
+		return fmt.Errorf("invalid monitor: query is required")
+	}
+	// Monitors returned by the API may keep a no_data_timeframe after
+	// notify_no_data was turned off, so this is only checked here and not on
+	// update.
+	if m.Options != nil && m.Options.NoDataTimeframe != 0 && !m.Options.GetNotifyNoData() {
+		return fmt.Errorf("invalid monitor: no_data_timeframe is only used when notify_no_data is true")
+	}
+	// Existing monitors may be of types added since, so this is only checked
+	// here and not on update.
+	if !monitorTypes[m.GetType()] {
+		return fmt.Errorf("invalid monitor: unknown type %q", m.GetType())
+	}
+	return m.validateFields()
+}
+
+// validateFields checks the fields of the monitor which are set.
+func (m *Monitor) validateFields() error {
+	if t, ok := m.GetTypeOk(); ok && t == "" {
+		return fmt.Errorf("invalid monitor: type must not be empty")
+	}
+	if m.Options == nil {
+		return nil
+	}
+	if m.Options.NoDataTimeframe < 0 {
+		return fmt.Errorf("invalid monitor: no_data_timeframe must be positive")
+	}
+	if m.Options.GetRenotifyInterval() < 0 {
+		return fmt.Errorf("invalid monitor: renotify_interval must be positive")
+	}
+	for _, status := range m.Options.GetRenotifyStatuses() {
+		if !renotifyStatuses[status] {
+			return fmt.Errorf("invalid monitor: unknown renotify status %q", status)
+		}
+	}
+	if m.Options.GetRenotifyOccurrences() < 0 {
+		return fmt.Errorf("invalid monitor: renotify_occurrences must be positive")
+	}
+	if m.Options.GetTimeoutH() < 0 {
+		return fmt.Errorf("invalid monitor: timeout_h must be positive")
+	}
+	if m.Options.GetNewHostDelay() < 0 {
+		return fmt.Errorf("invalid monitor: new_host_delay must be positive")
+	}
+	if m.Options.GetEvaluationDelay() < 0 {
+		return fmt.Errorf("invalid monitor: evaluation_delay must be positive")
+	}
+	if windows := m.Options.ThresholdWindows; windows != nil {
+		if query, ok := m.GetQueryOk(); ok && !isAnomalyQuery(query) {
+			return fmt.Errorf("invalid monitor: threshold_windows is only used by anomaly monitors")
+		}
+		if !windows.HasRecoveryWindow() || !windows.HasTriggerWindow() {
+			return fmt.Errorf("invalid monitor: threshold_windows needs both recovery_window and trigger_window")
+		}
+	}
+	return nil
+}
+
+// isAnomalyQuery tells whether a monitor query uses the anomalies function.
+// The parameters of the anomaly and forecast algorithms are arguments of
+// their functions in the query, so only the threshold windows of anomaly
+// monitors are separate options.
+func isAnomalyQuery(query string) bool {
+	return strings.Contains(query, "anomalies(")
+}
+
+// Validate checks the downtime for obvious mistakes before it is sent to the
+// API.
+func (d *Downtime) Validate() error {
+	if len(d.Scope) == 0 && len(d.MonitorTags) == 0 {
+		return fmt.Errorf("invalid downtime: scope or monitor_tags is required")
+	}
+	if d.HasStart() && d.HasEnd() && d.GetEnd() <= d.GetStart() {
+		return fmt.Errorf("invalid downtime: end must be after start")
+	}
+	return nil
+}
+
+// Validate checks the event for obvious mistakes before it is posted.
+func (e *Event) Validate() error {
+	if e.GetTitle() == "" {
+		return fmt.Errorf("invalid event: title is required")
+	}
+	if !e.HasText() {
+		return fmt.Errorf("invalid event: text is required")
+	}
+	switch e.GetAlertType() {
+	case "", "error", "warning", "info", "success":
+	default:
+		return fmt.Errorf("invalid event: unknown alert_type %q", e.GetAlertType())
+	}
+	switch e.GetPriority() {
+	case "", "normal", "low":
+	default:
+		return fmt.Errorf("invalid event: unknown priority %q", e.GetPriority())
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorValidate(t *testing.T) {
+	valid := func() *Monitor {
+		return &Monitor{
+			Type:    String("metric alert"),
+			Query:   String("avg(last_5m):avg:system.load.1{*} > 2"),
+			Options: &Options{NotifyNoData: Bool(true), NoDataTimeframe: 10},
+		}
+	}
+	assert.Nil(t, valid().Validate())
+
+	anomaly := valid()
+	anomaly.SetType("query alert")
+	anomaly.SetQuery("avg(last_4h):anomalies(avg:system.cpu.user{*}, 'basic', 2) >= 1")
+	anomaly.Options.ThresholdWindows = &ThresholdWindows{RecoveryWindow: String("last_15m"), TriggerWindow: String("last_15m")}
+	assert.Nil(t, anomaly.Validate())
+
+	for name, tc := range map[string]struct {
+		change func(m *Monitor)
+		err    string
+	}{
+		"missing type":         {func(m *Monitor) { m.Type = nil }, "type is required"},
+		"unknown type":         {func(m *Monitor) { m.SetType("metric") }, `unknown type "metric"`},
+		"missing query":        {func(m *Monitor) { m.Query = nil }, "query is required"},
+		"no data":              {func(m *Monitor) { m.Options.SetNotifyNoData(false) }, "no_data_timeframe"},
+		"renotify":             {func(m *Monitor) { m.Options.SetRenotifyInterval(-1) }, "renotify_interval"},
+		"renotify status":      {func(m *Monitor) { m.Options.SetRenotifyStatuses([]string{"alert", "ok"}) }, `unknown renotify status "ok"`},
+		"renotify occurrences": {func(m *Monitor) { m.Options.SetRenotifyOccurrences(-1) }, "renotify_occurrences"},
+		"evaluationDelay":      {func(m *Monitor) { m.Options.SetEvaluationDelay(-60) }, "evaluation_delay"},
+		"threshold windows without anomalies": {func(m *Monitor) {
+			m.Options.ThresholdWindows = &ThresholdWindows{RecoveryWindow: String("last_15m"), TriggerWindow: String("last_15m")}
+		}, "threshold_windows is only used by anomaly monitors"},
+		"incomplete threshold windows": {func(m *Monitor) {
+			m.SetQuery("avg(last_4h):anomalies(avg:system.cpu.user{*}, 'basic', 2) >= 1")
+			m.Options.ThresholdWindows = &ThresholdWindows{TriggerWindow: String("last_15m")}
+		}, "needs both recovery_window and trigger_window"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := valid()
+			tc.change(m)
+			err := m.Validate()
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
+
+func TestCreateMonitorValidates(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl("http://127.0.0.1:0")
+
+	_, err := c.CreateMonitor(&Monitor{Query: String("avg(last_5m):avg:system.load.1{*} > 2")})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid monitor: type is required", err.Error())
+	}
+	_, err = c.CreateMonitor(&Monitor{Type: String("metrics alert"), Query: String("avg(last_5m):avg:system.load.1{*} > 2")})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, `invalid monitor: unknown type "metrics alert"`, err.Error())
+	}
+	err = c.UpdateMonitor(&Monitor{Id: Int(1), Type: String("")})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid monitor: type must not be empty", err.Error())
+	}
+}
+
+func TestUpdateMonitorAllowsNewTypes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl(ts.URL)
+
+	assert.Nil(t, c.UpdateMonitor(&Monitor{Id: Int(1), Type: String("cost alert")}))
+}
+
+func TestCreateDowntimeAndPostEventValidate(t *testing.T) {
+	c := NewClient("sample_api_key", "sample_app_key")
+	c.SetBaseUrl("http://127.0.0.1:0")
+
+	_, err := c.CreateDowntime(&Downtime{})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid downtime: scope or monitor_tags is required", err.Error())
+	}
+	_, err = c.PostEvent(&Event{Text: String("v1")})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid event: title is required", err.Error())
+	}
+}
+
+func TestDowntimeValidate(t *testing.T) {
+	assert.Nil(t, (&Downtime{Scope: []string{"env:prod"}, Start: Int(10), End: Int(20)}).Validate())
+	assert.Nil(t, (&Downtime{MonitorTags: []string{"service:web"}}).Validate())
+	assert.NotNil(t, (&Downtime{}).Validate())
+	assert.NotNil(t, (&Downtime{Scope: []string{"*"}, Start: Int(20), End: Int(10)}).Validate())
+}
+
+func TestEventValidate(t *testing.T) {
+	assert.Nil(t, (&Event{Title: String("Deploy"), Text: String("v1"), AlertType: String("info")}).Validate())
+	assert.NotNil(t, (&Event{Text: String("v1")}).Validate())
+	assert.NotNil(t, (&Event{Title: String("Deploy")}).Validate())
+	assert.NotNil(t, (&Event{Title: String("Deploy"), Text: String("v1"), Priority: String("high")}).Validate())
+}
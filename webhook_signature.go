@@ -0,0 +1,46 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2019 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// webhookSignaturePrefix optionally prefixes the signatures of webhooks,
+// naming the hash function.
+const webhookSignaturePrefix = "sha256="
+
+// VerifyWebhookSignature tells whether signatureHeader is the signature of
+// the body of a webhook request for the shared secret: the hex encoded
+// HMAC-SHA256 of the raw body, optionally prefixed by "sha256=". Datadog
+// doesn't document a signature scheme of its own for the Webhooks
+// integration, so the sender, e.g. a relay in front of the receiver, must
+// sign requests this way. An error is returned if the header isn't a
+// signature at all.
+func VerifyWebhookSignature(secret string, body []byte, signatureHeader string) (bool, error) {
+	signature := strings.TrimSpace(signatureHeader)
+	if strings.Contains(signature, "=") {
+		if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+			return false, fmt.Errorf("unsupported webhook signature %q", signature)
+		}
+		signature = strings.TrimPrefix(signature, webhookSignaturePrefix)
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(got) != sha256.Size {
+		return false, fmt.Errorf("invalid webhook signature %q", signatureHeader)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil)), nil
+}
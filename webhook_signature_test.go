@@ -0,0 +1,44 @@
+package datadog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	const (
+		secret = "webhook-secret"
+		body   = `{"alert_id":"1234","alert_transition":"Triggered"}`
+		valid  = "5f86b5adf567569425ea079a01e38062588a08340aa3202f5b4c25b2c3c92932"
+	)
+
+	for name, tc := range map[string]struct {
+		secret    string
+		body      string
+		signature string
+		ok        bool
+	}{
+		"valid":          {secret, body, valid, true},
+		"valid prefixed": {secret, body, "sha256=" + valid, true},
+		"uppercase":      {secret, body, "sha256=5F86B5ADF567569425EA079A01E38062588A08340AA3202F5B4C25B2C3C92932", true},
+		"known vector": {
+			"It's a Secret to Everybody", "Hello, World!",
+			"sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17", true,
+		},
+		"wrong secret":  {"other-secret", body, valid, false},
+		"modified body": {secret, body + " ", valid, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ok, err := VerifyWebhookSignature(tc.secret, []byte(tc.body), tc.signature)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+
+	for _, signature := range []string{"", "sha1=" + valid, "not-hex", valid[:32]} {
+		ok, err := VerifyWebhookSignature(secret, []byte(body), signature)
+		assert.NotNil(t, err, signature)
+		assert.False(t, ok)
+	}
+}